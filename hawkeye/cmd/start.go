@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/signal"
 	"sync"
+	"syscall"
 
 	"github.com/hawkv6/hawkeye/pkg/adapter"
 	"github.com/hawkv6/hawkeye/pkg/cache"
@@ -61,7 +62,7 @@ func startController(cache cache.Cache, graph graph.Graph, updateChan chan struc
 	messagingChannels := messaging.NewPathMessagingChannels()
 	calculationSetupProvider := calculation.NewCalculationSetupProvider(cache, graph)
 	calculationUpdaterService := calculation.NewCalculationUpdaterService(cache, graph)
-	calculationTransformerService := calculation.NewCalculationTransformerService(cache)
+	calculationTransformerService := calculation.NewCalculationTransformerService(cache, graph)
 	manager := calculation.NewCalculationManager(cache, graph, calculationSetupProvider, calculationTransformerService, calculationUpdaterService)
 	controller := controller.NewSessionController(manager, messagingChannels, updateChan)
 	wg.Add(1)
@@ -102,6 +103,18 @@ func startGrpcServer(adapter adapter.Adapter, config *config.FullConfig, messagi
 	return server
 }
 
+// SIGUSR1 reports the moves of a global optimization of all open sessions, SIGUSR2 applies them
+func listenForOptimizationSignals(controller *controller.SessionController) {
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, syscall.SIGUSR1, syscall.SIGUSR2)
+	go func() {
+		for receivedSignal := range signalChan {
+			log.Infof("Received %s signal, starting global optimization", receivedSignal)
+			controller.TriggerOptimization(receivedSignal == syscall.SIGUSR1)
+		}
+	}()
+}
+
 func listenForInterruptSignal(server *messaging.GrpcMessagingServer, subscriptionService *jagw.JagwSubscriptionService, serviceMonitor *service.ConsulServiceMonitor, networkProcessor *processor.NetworkProcessor, controller *controller.SessionController, wg *sync.WaitGroup) {
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -137,6 +150,7 @@ var startCmd = &cobra.Command{
 		wg := sync.WaitGroup{}
 		serviceMonitor := startServiceMonitoring(cache, updateChan, &wg)
 		messagingChannels, controller := startController(cache, graph, updateChan, &wg)
+		listenForOptimizationSignals(controller)
 		startNetworkProcessor(networkProcessor, &wg)
 
 		subscriptionService := startSubscriptionService(config, adapter, eventChan)
@@ -21,4 +21,6 @@ type Adapter interface {
 	ConvertSidEvent(*jagw.LsSrv6SidEvent) (domain.NetworkEvent, error)
 	ConvertPathRequest(*api.PathRequest, api.IntentController_GetIntentPathServer, context.Context) (domain.PathRequest, error)
 	ConvertPathResult(domain.PathResult) (*api.PathResult, error)
+	ConvertSessionError(*domain.SessionError) *api.PathResult
+	ConvertRequestError(*api.PathRequest, error) *api.PathResult
 }
@@ -162,6 +162,34 @@ func (mr *MockAdapterMockRecorder) ConvertPrefixEvent(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertPrefixEvent", reflect.TypeOf((*MockAdapter)(nil).ConvertPrefixEvent), arg0)
 }
 
+// ConvertRequestError mocks base method.
+func (m *MockAdapter) ConvertRequestError(arg0 *api.PathRequest, arg1 error) *api.PathResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertRequestError", arg0, arg1)
+	ret0, _ := ret[0].(*api.PathResult)
+	return ret0
+}
+
+// ConvertRequestError indicates an expected call of ConvertRequestError.
+func (mr *MockAdapterMockRecorder) ConvertRequestError(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertRequestError", reflect.TypeOf((*MockAdapter)(nil).ConvertRequestError), arg0, arg1)
+}
+
+// ConvertSessionError mocks base method.
+func (m *MockAdapter) ConvertSessionError(arg0 *domain.SessionError) *api.PathResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertSessionError", arg0)
+	ret0, _ := ret[0].(*api.PathResult)
+	return ret0
+}
+
+// ConvertSessionError indicates an expected call of ConvertSessionError.
+func (mr *MockAdapterMockRecorder) ConvertSessionError(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertSessionError", reflect.TypeOf((*MockAdapter)(nil).ConvertSessionError), arg0)
+}
+
 // ConvertSid mocks base method.
 func (m *MockAdapter) ConvertSid(arg0 *jagw.LsSrv6Sid) (domain.Sid, error) {
 	m.ctrl.T.Helper()
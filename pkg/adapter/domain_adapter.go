@@ -7,6 +7,7 @@ import (
 
 	"github.com/hawkv6/hawkeye/pkg/api"
 	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/graph"
 	"github.com/hawkv6/hawkeye/pkg/logging"
 	"github.com/jalapeno-api-gateway/jagw-go/jagw"
 	"github.com/sirupsen/logrus"
@@ -22,8 +23,25 @@ func NewDomainAdapter() *DomainAdapter {
 	}
 }
 
+// SRv6 Maximum H.Encaps MSD type (RFC 9352), the number of SIDs a headend can impose
+const srv6MaxHEncapsMsdType = 44
+
+func (adapter *DomainAdapter) getMaximumSidDepth(lsNode *jagw.LsNode) uint32 {
+	for _, nodeMsd := range lsNode.GetNodeMsd() {
+		if nodeMsd.GetMsdType() == srv6MaxHEncapsMsdType {
+			return nodeMsd.GetMsdValue()
+		}
+	}
+	return 0
+}
+
 func (adapter *DomainAdapter) ConvertNode(lsNode *jagw.LsNode) (domain.Node, error) {
-	return domain.NewDomainNode(lsNode.Key, lsNode.IgpRouterId, lsNode.Name, lsNode.SrAlgorithm)
+	node, err := domain.NewDomainNode(lsNode.Key, lsNode.IgpRouterId, lsNode.Name, lsNode.SrAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	node.SetMaximumSidDepth(adapter.getMaximumSidDepth(lsNode))
+	return node, nil
 }
 
 func (adapter *DomainAdapter) ConvertNodeEvent(lsNodeEvent *jagw.LsNodeEvent) (domain.NetworkEvent, error) {
@@ -50,8 +68,29 @@ func (adapter *DomainAdapter) ConvertNodeEvent(lsNodeEvent *jagw.LsNodeEvent) (d
 	}
 }
 
+// backup End.X SIDs (B-Flag) are only used if no unprotected End.X SID is advertised for the algorithm
+func (adapter *DomainAdapter) getAdjacencySids(lsLink *jagw.LsLink) map[uint32]string {
+	adjacencySids := make(map[uint32]string)
+	for _, endXSid := range lsLink.GetSrv6EndxSid() {
+		if endXSid.GetSid() == "" {
+			continue
+		}
+		algorithm := endXSid.GetAlgorithm()
+		if _, exists := adjacencySids[algorithm]; exists && endXSid.GetFlags().GetBFlag() {
+			continue
+		}
+		adjacencySids[algorithm] = endXSid.GetSid()
+	}
+	return adjacencySids
+}
+
 func (adapter *DomainAdapter) ConvertLink(lsLink *jagw.LsLink) (domain.Link, error) {
-	return domain.NewDomainLink(lsLink.Key, lsLink.IgpRouterId, lsLink.RemoteIgpRouterId, lsLink.IgpMetric, lsLink.UnidirLinkDelay, lsLink.UnidirDelayVariation, lsLink.MaxLinkBwKbps, lsLink.UnidirAvailableBw, lsLink.UnidirBwUtilization, lsLink.UnidirPacketLossPercentage, lsLink.NormalizedUnidirLinkDelay, lsLink.NormalizedUnidirDelayVariation, lsLink.NormalizedUnidirPacketLoss)
+	link, err := domain.NewDomainLink(lsLink.Key, lsLink.IgpRouterId, lsLink.RemoteIgpRouterId, lsLink.IgpMetric, lsLink.UnidirLinkDelay, lsLink.UnidirDelayVariation, lsLink.MaxLinkBwKbps, lsLink.UnidirAvailableBw, lsLink.UnidirBwUtilization, lsLink.UnidirPacketLossPercentage, lsLink.NormalizedUnidirLinkDelay, lsLink.NormalizedUnidirDelayVariation, lsLink.NormalizedUnidirPacketLoss)
+	if err != nil {
+		return nil, err
+	}
+	link.SetAdjacencySids(adapter.getAdjacencySids(lsLink))
+	return link, nil
 }
 
 func (adapter *DomainAdapter) ConvertLinkEvent(lsLinkEvent *jagw.LsLinkEvent) (domain.NetworkEvent, error) {
@@ -100,7 +139,13 @@ func (adapter *DomainAdapter) ConvertPrefixEvent(lsPrefixEvent *jagw.LsPrefixEve
 }
 
 func (adapter *DomainAdapter) ConvertSid(lsSrv6Sid *jagw.LsSrv6Sid) (domain.Sid, error) {
-	return domain.NewDomainSid(lsSrv6Sid.Key, lsSrv6Sid.IgpRouterId, lsSrv6Sid.Srv6Sid, lsSrv6Sid.Srv6EndpointBehavior.Algorithm)
+	sid, err := domain.NewDomainSid(lsSrv6Sid.Key, lsSrv6Sid.IgpRouterId, lsSrv6Sid.Srv6Sid, lsSrv6Sid.Srv6EndpointBehavior.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	sidStructure := lsSrv6Sid.GetSrv6SidStructure()
+	sid.SetSidStructure(sidStructure.GetLocatorBlockLength(), sidStructure.GetLocatorNodeLength(), sidStructure.GetFunctionLength())
+	return sid, nil
 }
 
 func (adapter *DomainAdapter) ConvertSidEvent(lsSrv6SidEvent *jagw.LsSrv6SidEvent) (domain.NetworkEvent, error) {
@@ -132,8 +177,14 @@ func (adapter *DomainAdapter) convertValuesToDomain(apiValues []*api.Value) ([]d
 			value, err = domain.NewNumberValue(domain.ValueTypeMaxValue, apiValue.NumberValue)
 		case api.ValueType_VALUE_TYPE_FLEX_ALGO_NR:
 			value, err = domain.NewNumberValue(domain.ValueTypeFlexAlgoNr, apiValue.NumberValue)
+		case api.ValueType_VALUE_TYPE_TOLERANCE:
+			value, err = domain.NewNumberValue(domain.ValueTypeTolerance, apiValue.NumberValue)
 		case api.ValueType_VALUE_TYPE_SFC:
 			value, err = domain.NewStringValue(domain.ValueTypeSFC, apiValue.StringValue)
+		case api.ValueType_VALUE_TYPE_NODE:
+			value, err = domain.NewStringValue(domain.ValueTypeNode, apiValue.StringValue)
+		case api.ValueType_VALUE_TYPE_LINK:
+			value, err = domain.NewStringValue(domain.ValueTypeLink, apiValue.StringValue)
 		default:
 			return nil, fmt.Errorf("Value type unspecified")
 		}
@@ -164,11 +215,89 @@ func (adapter *DomainAdapter) convertIntentTypeToDomain(apiIntentType api.Intent
 		return domain.IntentTypeSFC, nil
 	case api.IntentType_INTENT_TYPE_LOW_UTILIZATION:
 		return domain.IntentTypeLowUtilization, nil
+	case api.IntentType_INTENT_TYPE_EXCLUDE_NODES:
+		return domain.IntentTypeExcludeNodes, nil
+	case api.IntentType_INTENT_TYPE_INCLUDE_NODES:
+		return domain.IntentTypeIncludeNodes, nil
+	case api.IntentType_INTENT_TYPE_EXCLUDE_LINKS:
+		return domain.IntentTypeExcludeLinks, nil
+	case api.IntentType_INTENT_TYPE_INCLUDE_LINKS:
+		return domain.IntentTypeIncludeLinks, nil
 	default:
 		return domain.IntentTypeUnspecified, fmt.Errorf("Intent type unspecified")
 	}
 }
 
+func (adapter *DomainAdapter) convertDisjointnessTypeToDomain(apiDisjointnessType api.DisjointnessType) (domain.DisjointnessType, error) {
+	switch apiDisjointnessType {
+	case api.DisjointnessType_DISJOINTNESS_TYPE_UNSPECIFIED:
+		return domain.DisjointnessTypeNone, nil
+	case api.DisjointnessType_DISJOINTNESS_TYPE_LINK:
+		return domain.DisjointnessTypeLink, nil
+	case api.DisjointnessType_DISJOINTNESS_TYPE_NODE:
+		return domain.DisjointnessTypeNode, nil
+	default:
+		return domain.DisjointnessTypeNone, fmt.Errorf("Unknown disjointness type: %v", apiDisjointnessType)
+	}
+}
+
+func (adapter *DomainAdapter) convertPathAlgorithmToDomain(apiPathAlgorithm api.PathAlgorithm) (domain.PathAlgorithm, error) {
+	switch apiPathAlgorithm {
+	case api.PathAlgorithm_PATH_ALGORITHM_UNSPECIFIED:
+		return domain.PathAlgorithmDefault, nil
+	case api.PathAlgorithm_PATH_ALGORITHM_DIJKSTRA:
+		return domain.PathAlgorithmDijkstra, nil
+	case api.PathAlgorithm_PATH_ALGORITHM_CONSTRAINED:
+		return domain.PathAlgorithmConstrained, nil
+	default:
+		return domain.PathAlgorithmDefault, fmt.Errorf("Unknown path algorithm: %v", apiPathAlgorithm)
+	}
+}
+
+func (adapter *DomainAdapter) convertSelectionPolicyToDomain(paretoFront bool, apiSelectionPolicy api.SelectionPolicy) (domain.SelectionPolicy, error) {
+	if !paretoFront {
+		if apiSelectionPolicy != api.SelectionPolicy_SELECTION_POLICY_UNSPECIFIED {
+			return domain.SelectionPolicyNone, fmt.Errorf("Selection policy can only be set for Pareto front requests")
+		}
+		return domain.SelectionPolicyNone, nil
+	}
+	switch apiSelectionPolicy {
+	case api.SelectionPolicy_SELECTION_POLICY_UNSPECIFIED, api.SelectionPolicy_SELECTION_POLICY_WEIGHTED:
+		return domain.SelectionPolicyWeighted, nil
+	case api.SelectionPolicy_SELECTION_POLICY_LEXICOGRAPHIC:
+		return domain.SelectionPolicyLexicographic, nil
+	case api.SelectionPolicy_SELECTION_POLICY_KNEE:
+		return domain.SelectionPolicyKnee, nil
+	default:
+		return domain.SelectionPolicyNone, fmt.Errorf("Unknown selection policy: %v", apiSelectionPolicy)
+	}
+}
+
+// a path request without setup priority gets the lowest one, so it can not preempt any session, and without hold
+// priority it holds its resources with its setup priority
+func (adapter *DomainAdapter) convertPrioritiesToDomain(pathRequest *api.PathRequest) (uint32, uint32) {
+	setupPriority := domain.LowestPriority
+	if pathRequest.SetupPriority != nil {
+		setupPriority = pathRequest.GetSetupPriority()
+	}
+	holdPriority := setupPriority
+	if pathRequest.HoldPriority != nil {
+		holdPriority = pathRequest.GetHoldPriority()
+	}
+	return setupPriority, holdPriority
+}
+
+func (adapter *DomainAdapter) convertServiceOrderToDomain(apiServiceOrder []*api.ServiceOrder) []domain.ServiceOrder {
+	if len(apiServiceOrder) == 0 {
+		return nil
+	}
+	serviceOrder := make([]domain.ServiceOrder, 0, len(apiServiceOrder))
+	for _, order := range apiServiceOrder {
+		serviceOrder = append(serviceOrder, domain.NewServiceOrder(order.GetBefore(), order.GetAfter()))
+	}
+	return serviceOrder
+}
+
 func (adapter *DomainAdapter) convertIntentsToDomain(apiIntents []*api.Intent) ([]domain.Intent, error) {
 	intentList := make([]domain.Intent, 0)
 	for _, apiIntent := range apiIntents {
@@ -181,6 +310,10 @@ func (adapter *DomainAdapter) convertIntentsToDomain(apiIntents []*api.Intent) (
 			return nil, err
 		}
 		intent := domain.NewDomainIntent(intentType, values)
+		intent.SetWeight(apiIntent.GetWeight())
+		intent.SetUnordered(apiIntent.GetUnordered())
+		intent.SetServiceOrder(adapter.convertServiceOrderToDomain(apiIntent.GetServiceOrder()))
+		intent.SetOptionalServices(apiIntent.GetOptionalServices())
 		intentList = append(intentList, intent)
 	}
 	return intentList, nil
@@ -192,7 +325,55 @@ func (adapter *DomainAdapter) ConvertPathRequest(pathRequest *api.PathRequest, s
 		adapter.log.Errorln("Error converting intents: ", err)
 		return nil, err
 	}
-	return domain.NewDomainPathRequest(pathRequest.Ipv6SourceAddress, pathRequest.Ipv6DestinationAddress, intents, stream, ctx)
+	domainPathRequest, err := domain.NewDomainPathRequest(pathRequest.Ipv6SourceAddress, pathRequest.Ipv6DestinationAddress, intents, stream, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := domainPathRequest.SetAlternativePathCount(pathRequest.AlternativePathCount); err != nil {
+		adapter.log.Errorln("Error setting alternative path count: ", err)
+		return nil, err
+	}
+	disjointnessType, err := adapter.convertDisjointnessTypeToDomain(pathRequest.DisjointnessType)
+	if err != nil {
+		adapter.log.Errorln("Error converting disjointness type: ", err)
+		return nil, err
+	}
+	if err := domainPathRequest.SetDisjointnessType(disjointnessType); err != nil {
+		adapter.log.Errorln("Error setting disjointness type: ", err)
+		return nil, err
+	}
+	pathAlgorithm, err := adapter.convertPathAlgorithmToDomain(pathRequest.PathAlgorithm)
+	if err != nil {
+		adapter.log.Errorln("Error converting path algorithm: ", err)
+		return nil, err
+	}
+	if err := domainPathRequest.SetPathAlgorithm(pathAlgorithm); err != nil {
+		adapter.log.Errorln("Error setting path algorithm: ", err)
+		return nil, err
+	}
+	selectionPolicy, err := adapter.convertSelectionPolicyToDomain(pathRequest.ParetoFront, pathRequest.SelectionPolicy)
+	if err != nil {
+		adapter.log.Errorln("Error converting selection policy: ", err)
+		return nil, err
+	}
+	if err := domainPathRequest.SetSelectionPolicy(selectionPolicy); err != nil {
+		adapter.log.Errorln("Error setting selection policy: ", err)
+		return nil, err
+	}
+	domainPathRequest.SetMaxHopCount(pathRequest.MaxHopCount)
+	domainPathRequest.SetMaxSidDepth(pathRequest.MaxSidDepth)
+	domainPathRequest.SetStrictPath(pathRequest.StrictPath)
+	domainPathRequest.SetBandwidthDemand(pathRequest.BandwidthDemand)
+	if err := domainPathRequest.SetPriorities(adapter.convertPrioritiesToDomain(pathRequest)); err != nil {
+		adapter.log.Errorln("Error setting priorities: ", err)
+		return nil, err
+	}
+	if err := domainPathRequest.SetPathStability(pathRequest.HysteresisPercentage, pathRequest.HoldDownTime, pathRequest.FlapDampingHalfLife); err != nil {
+		adapter.log.Errorln("Error setting path stability: ", err)
+		return nil, err
+	}
+	domainPathRequest.SetRequestId(pathRequest.RequestId)
+	return domainPathRequest, nil
 }
 
 func (adapter *DomainAdapter) convertValuesToApi(values []domain.Value) []*api.Value {
@@ -218,12 +399,30 @@ func (adapter *DomainAdapter) convertValuesToApi(values []domain.Value) []*api.V
 				Type:        api.ValueType_VALUE_TYPE_FLEX_ALGO_NR,
 				NumberValue: &numberValue,
 			}
+		case domain.ValueTypeTolerance:
+			numberValue := value.GetNumberValue()
+			apiValue = &api.Value{
+				Type:        api.ValueType_VALUE_TYPE_TOLERANCE,
+				NumberValue: &numberValue,
+			}
 		case domain.ValueTypeSFC:
 			stringValue := value.GetStringValue()
 			apiValue = &api.Value{
 				Type:        api.ValueType_VALUE_TYPE_SFC,
 				StringValue: &stringValue,
 			}
+		case domain.ValueTypeNode:
+			stringValue := value.GetStringValue()
+			apiValue = &api.Value{
+				Type:        api.ValueType_VALUE_TYPE_NODE,
+				StringValue: &stringValue,
+			}
+		case domain.ValueTypeLink:
+			stringValue := value.GetStringValue()
+			apiValue = &api.Value{
+				Type:        api.ValueType_VALUE_TYPE_LINK,
+				StringValue: &stringValue,
+			}
 		}
 		apiValues = append(apiValues, apiValue)
 	}
@@ -238,11 +437,120 @@ func (adapter *DomainAdapter) convertIntentsToApi(intents []domain.Intent) []*ap
 			Type:   api.IntentType(intent.GetIntentType()),
 			Values: values,
 		}
+		if weight := intent.GetWeight(); weight != 0 {
+			apiIntent.Weight = &weight
+		}
+		apiIntent.Unordered = intent.GetUnordered()
+		for _, order := range intent.GetServiceOrder() {
+			apiIntent.ServiceOrder = append(apiIntent.ServiceOrder, &api.ServiceOrder{Before: order.GetBefore(), After: order.GetAfter()})
+		}
+		apiIntent.OptionalServices = intent.GetOptionalServices()
 		apiIntents[index] = apiIntent
 	}
 	return apiIntents
 }
 
+func (adapter *DomainAdapter) convertAlternativePathsToApi(alternativeResults []domain.PathResult) []*api.AlternativePath {
+	var alternativePaths []*api.AlternativePath
+	for _, alternativeResult := range alternativeResults {
+		alternativePaths = append(alternativePaths, &api.AlternativePath{
+			Ipv6SidAddresses: alternativeResult.GetIpv6SidAddresses(),
+			TotalCost:        alternativeResult.GetTotalCost(),
+		})
+	}
+	return alternativePaths
+}
+
+func (adapter *DomainAdapter) convertParetoPathsToApi(paretoResults []domain.PathResult) []*api.ParetoPath {
+	var paretoPaths []*api.ParetoPath
+	for _, paretoResult := range paretoResults {
+		paretoPaths = append(paretoPaths, &api.ParetoPath{
+			Ipv6SidAddresses:   paretoResult.GetIpv6SidAddresses(),
+			Latency:            paretoResult.GetTotalDelay(),
+			Jitter:             paretoResult.GetTotalJitter(),
+			PacketLoss:         paretoResult.GetTotalPacketLoss() * 100,
+			AvailableBandwidth: paretoResult.GetBottleneckValue(),
+		})
+	}
+	return paretoPaths
+}
+
+func (adapter *DomainAdapter) convertRouterToApi(node graph.Node) *api.Router {
+	return &api.Router{
+		RouterId: node.GetId(),
+		Name:     node.GetName(),
+	}
+}
+
+// the routers are listed hop by hop from the source to the destination router
+func (adapter *DomainAdapter) convertRoutersToApi(edges []graph.Edge) []*api.Router {
+	var routers []*api.Router
+	for index, edge := range edges {
+		if index == 0 {
+			routers = append(routers, adapter.convertRouterToApi(edge.From()))
+		}
+		routers = append(routers, adapter.convertRouterToApi(edge.To()))
+	}
+	return routers
+}
+
+func (adapter *DomainAdapter) convertLinkToApi(edge graph.Edge) *api.Link {
+	return &api.Link{
+		LinkId:     edge.GetId(),
+		FromRouter: adapter.convertRouterToApi(edge.From()),
+		ToRouter:   adapter.convertRouterToApi(edge.To()),
+	}
+}
+
+// the service instances are listed in the order they are traversed by the path
+func (adapter *DomainAdapter) convertServiceInstancesToApi(routers []*api.Router, routerServiceMap map[string]string) []*api.ServiceInstance {
+	var serviceInstances []*api.ServiceInstance
+	listedRouters := make(map[string]struct{}, len(routerServiceMap))
+	for _, router := range routers {
+		serviceSid, ok := routerServiceMap[router.RouterId]
+		if _, listed := listedRouters[router.RouterId]; !ok || listed {
+			continue
+		}
+		listedRouters[router.RouterId] = struct{}{}
+		serviceInstances = append(serviceInstances, &api.ServiceInstance{
+			Router:            router,
+			ServiceSidAddress: serviceSid,
+		})
+	}
+	return serviceInstances
+}
+
+func (adapter *DomainAdapter) convertUpdateReasonToApi(updateReason domain.UpdateReason) api.UpdateReason {
+	switch updateReason {
+	case domain.UpdateReasonInitial:
+		return api.UpdateReason_UPDATE_REASON_INITIAL
+	case domain.UpdateReasonNetworkChange:
+		return api.UpdateReason_UPDATE_REASON_NETWORK_CHANGE
+	case domain.UpdateReasonPreemption:
+		return api.UpdateReason_UPDATE_REASON_PREEMPTION
+	case domain.UpdateReasonOptimization:
+		return api.UpdateReason_UPDATE_REASON_OPTIMIZATION
+	case domain.UpdateReasonDisplaced:
+		return api.UpdateReason_UPDATE_REASON_DISPLACED
+	default:
+		return api.UpdateReason_UPDATE_REASON_UNSPECIFIED
+	}
+}
+
+// the end-to-end metrics of the path, latency and jitter in µs, packet loss in percent and the available bandwidth of the bottleneck link in kbit/s
+func (adapter *DomainAdapter) setPathMetrics(apiPathResult *api.PathResult, path graph.Path) {
+	apiPathResult.TotalCost = path.GetTotalCost()
+	apiPathResult.Latency = path.GetTotalDelay()
+	apiPathResult.Jitter = path.GetTotalJitter()
+	apiPathResult.PacketLoss = path.GetTotalPacketLoss() * 100
+	if bottleneckEdge := path.GetBottleneckEdge(); bottleneckEdge != nil {
+		apiPathResult.BottleneckLink = adapter.convertLinkToApi(bottleneckEdge)
+		apiPathResult.BottleneckValue = path.GetBottleneckValue()
+	}
+	apiPathResult.Routers = adapter.convertRoutersToApi(path.GetEdges())
+	apiPathResult.ServiceInstances = adapter.convertServiceInstancesToApi(apiPathResult.Routers, path.GetRouterServiceMap())
+}
+
 func (adapter *DomainAdapter) ConvertPathResult(pathResult domain.PathResult) (*api.PathResult, error) {
 	if pathResult == nil || reflect.ValueOf(pathResult).IsNil() {
 		return nil, fmt.Errorf("PathResult could not be calculated due to error")
@@ -253,6 +561,46 @@ func (adapter *DomainAdapter) ConvertPathResult(pathResult domain.PathResult) (*
 		Ipv6DestinationAddress: pathResult.GetIpv6DestinationAddress(),
 		Ipv6SidAddresses:       ipv6SidAddresses,
 		Intents:                adapter.convertIntentsToApi(pathResult.GetIntents()),
+		AlternativePaths:       adapter.convertAlternativePathsToApi(pathResult.GetAlternativePathResults()),
+		ParetoPaths:            adapter.convertParetoPathsToApi(pathResult.GetParetoPathResults()),
+		IncludedServices:       pathResult.GetIncludedServices(),
+		RequestId:              pathResult.GetRequestId(),
+		SessionId:              pathResult.GetSessionId(),
+		FlexAlgoNumber:         pathResult.GetFlexAlgoNumber(),
+		UpdateReason:           adapter.convertUpdateReasonToApi(pathResult.GetUpdateReason()),
+	}
+	if path := pathResult.GetPath(); path != nil {
+		adapter.setPathMetrics(apiPathResult, path)
+	}
+	if backupResult := pathResult.GetBackupPathResult(); backupResult != nil {
+		apiPathResult.BackupIpv6SidAddresses = backupResult.GetIpv6SidAddresses()
 	}
 	return apiPathResult, nil
 }
+
+// the error of a path request or its session is sent as a path result without SIDs, tagged with the request and the
+// session identifier, so the other path requests of the stream are not affected
+func (adapter *DomainAdapter) ConvertSessionError(sessionError *domain.SessionError) *api.PathResult {
+	pathRequest := sessionError.GetPathRequest()
+	return &api.PathResult{
+		Ipv6SourceAddress:      pathRequest.GetIpv6SourceAddress(),
+		Ipv6DestinationAddress: pathRequest.GetIpv6DestinationAddress(),
+		Intents:                adapter.convertIntentsToApi(pathRequest.GetIntents()),
+		RequestId:              pathRequest.GetRequestId(),
+		SessionId:              pathRequest.GetSessionId(),
+		ErrorMessage:           sessionError.Error(),
+	}
+}
+
+// a path request which can not be converted is answered with its own addresses and intents, so the client can match
+// the error by the request identifier and, for a modify message, the session identifier of the kept session
+func (adapter *DomainAdapter) ConvertRequestError(pathRequest *api.PathRequest, err error) *api.PathResult {
+	return &api.PathResult{
+		Ipv6SourceAddress:      pathRequest.GetIpv6SourceAddress(),
+		Ipv6DestinationAddress: pathRequest.GetIpv6DestinationAddress(),
+		Intents:                pathRequest.GetIntents(),
+		RequestId:              pathRequest.GetRequestId(),
+		SessionId:              pathRequest.GetSessionId(),
+		ErrorMessage:           err.Error(),
+	}
+}
@@ -2,6 +2,7 @@ package adapter
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -58,6 +59,20 @@ func setUpDomainNode(key string, igpRouterId string, name string, srAlgorithm []
 	return node
 }
 
+func setUpJagwNodeWithMsd(key string, igpRouterId string, name string, srAlgorithm []uint32, nodeMsds map[uint32]uint32) *jagw.LsNode {
+	lsNode := setUpJagwNode(key, igpRouterId, name, srAlgorithm)
+	for msdType, msdValue := range nodeMsds {
+		lsNode.NodeMsd = append(lsNode.NodeMsd, &jagw.NodeMsd{MsdType: proto.Uint32(msdType), MsdValue: proto.Uint32(msdValue)})
+	}
+	return lsNode
+}
+
+func setUpDomainNodeWithMsd(key string, igpRouterId string, name string, srAlgorithm []uint32, maximumSidDepth uint32) *domain.DomainNode {
+	node := setUpDomainNode(key, igpRouterId, name, srAlgorithm)
+	node.SetMaximumSidDepth(maximumSidDepth)
+	return node
+}
+
 func isNilInterface(value interface{}) bool {
 	return value == nil || reflect.ValueOf(value).IsNil()
 }
@@ -87,6 +102,17 @@ func TestDomainAdapter_ConvertNode(t *testing.T) {
 			want:    setUpDomainNode("key", "igpRouterId", "name", []uint32{1, 2, 3}),
 			wantErr: false,
 		},
+		{
+			name: "Convert LsNode to Node with maximum SID depth",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				lsNode: setUpJagwNodeWithMsd("key", "igpRouterId", "name", []uint32{1, 2, 3}, map[uint32]uint32{41: 10, srv6MaxHEncapsMsdType: 6}),
+			},
+			want:    setUpDomainNodeWithMsd("key", "igpRouterId", "name", []uint32{1, 2, 3}, 6),
+			wantErr: false,
+		},
 		{
 			name: "Convert LsNode to Node no key",
 			fields: fields{
@@ -255,6 +281,26 @@ func setUpDomainLink(key string, igpRouterId string, remoteIgpRouterId string, i
 	return link
 }
 
+func setUpJagwLinkWithEndXSids(endXSids []*jagw.Srv6EndXSidTlv) *jagw.LsLink {
+	lsLink := setUpJagwLink("key", "igpRouterId", "remoteIgpRouterId", 1, 2, 3, 1000, 100, 50, 0.5, 1.0, 1.0, 1.0)
+	lsLink.Srv6EndxSid = endXSids
+	return lsLink
+}
+
+func setUpDomainLinkWithAdjacencySids(adjacencySids map[uint32]string) *domain.DomainLink {
+	link := setUpDomainLink("key", "igpRouterId", "remoteIgpRouterId", 1, 2, 3, 1000, 100, 50, 0.5, 1.0, 1.0, 1.0)
+	link.SetAdjacencySids(adjacencySids)
+	return link
+}
+
+func setUpEndXSid(sid string, algorithm uint32, backup bool) *jagw.Srv6EndXSidTlv {
+	return &jagw.Srv6EndXSidTlv{
+		Sid:       proto.String(sid),
+		Algorithm: proto.Uint32(algorithm),
+		Flags:     &jagw.Srv6EndXSidFlags{BFlag: proto.Bool(backup)},
+	}
+}
+
 func TestDomainAdapter_ConvertLink(t *testing.T) {
 	type fields struct {
 		log *logrus.Entry
@@ -280,6 +326,28 @@ func TestDomainAdapter_ConvertLink(t *testing.T) {
 			want:    setUpDomainLink("key", "igpRouterId", "remoteIgpRouterId", 1, 2, 3, 1000, 100, 50, 0.5, 1.0, 1.0, 1.0),
 			wantErr: false,
 		},
+		{
+			name: "Convert LsLink with End.X SIDs to Link successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				lsLink: setUpJagwLinkWithEndXSids([]*jagw.Srv6EndXSidTlv{setUpEndXSid("fc00:0:1:e000::", 0, false), setUpEndXSid("fc00:0:1:e001::", 0, true), setUpEndXSid("fc00:0:81:e000::", 128, false)}),
+			},
+			want:    setUpDomainLinkWithAdjacencySids(map[uint32]string{0: "fc00:0:1:e000::", 128: "fc00:0:81:e000::"}),
+			wantErr: false,
+		},
+		{
+			name: "Convert LsLink with backup End.X SID only to Link successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				lsLink: setUpJagwLinkWithEndXSids([]*jagw.Srv6EndXSidTlv{setUpEndXSid("fc00:0:1:e001::", 0, true), setUpEndXSid("", 128, false)}),
+			},
+			want:    setUpDomainLinkWithAdjacencySids(map[uint32]string{0: "fc00:0:1:e001::"}),
+			wantErr: false,
+		},
 		{
 			name: "Convert LsLink to Link Error:Field validation for 'MaxLinkBWKbps' failed on the 'min",
 			fields: fields{
@@ -824,6 +892,22 @@ func setupDomainSid(key string, igpRouterId string, sidValue string, algorithm u
 	return sid
 }
 
+func setUpJagwSidWithStructure(key string, igpRouterId string, sid string, algorithm uint32, blockLength, nodeLength, functionLength uint32) *jagw.LsSrv6Sid {
+	srv6Sid := setUpJagwSid(key, igpRouterId, sid, "sidType", algorithm)
+	srv6Sid.Srv6SidStructure = &jagw.Srv6SidStructure{
+		LocatorBlockLength: proto.Uint32(blockLength),
+		LocatorNodeLength:  proto.Uint32(nodeLength),
+		FunctionLength:     proto.Uint32(functionLength),
+	}
+	return srv6Sid
+}
+
+func setupDomainSidWithStructure(key string, igpRouterId string, sidValue string, algorithm uint32, blockLength, nodeLength, functionLength uint32) *domain.DomainSid {
+	sid := setupDomainSid(key, igpRouterId, sidValue, algorithm)
+	sid.SetSidStructure(blockLength, nodeLength, functionLength)
+	return sid
+}
+
 func TestDomainAdapter_ConvertSid(t *testing.T) {
 	type fields struct {
 		log *logrus.Entry
@@ -849,6 +933,17 @@ func TestDomainAdapter_ConvertSid(t *testing.T) {
 			want:    setupDomainSid("key", "igpRouterId", "sid", 1),
 			wantErr: false,
 		},
+		{
+			name: "Convert LsSid with SID structure to Sid successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				lsSid: setUpJagwSidWithStructure("key", "igpRouterId", "fcbb:bb00:1::", 0, 32, 16, 0),
+			},
+			want:    setupDomainSidWithStructure("key", "igpRouterId", "fcbb:bb00:1::", 0, 32, 16, 0),
+			wantErr: false,
+		},
 		{
 			name: "Convert LsSid to Sid no key",
 			fields: fields{
@@ -988,11 +1083,48 @@ func getDomainFlexAlgoValue(value *int32) domain.Value {
 	return numberValue
 }
 
+func getDomainToleranceValue(value *int32) domain.Value {
+	numberValue, _ := domain.NewNumberValue(domain.ValueTypeTolerance, value)
+	return numberValue
+}
+
+func getDomainWeightedIntent(intentType domain.IntentType, weight float64) domain.Intent {
+	intent := domain.NewDomainIntent(intentType, []domain.Value{})
+	intent.SetWeight(weight)
+	return intent
+}
+
+func getDomainUnorderedSfcIntent(serviceOrder []domain.ServiceOrder, services ...string) domain.Intent {
+	values := make([]domain.Value, len(services))
+	for index, service := range services {
+		values[index] = getDomainSfcValue(proto.String(service))
+	}
+	intent := domain.NewDomainIntent(domain.IntentTypeSFC, values)
+	intent.SetUnordered(true)
+	intent.SetServiceOrder(serviceOrder)
+	return intent
+}
+
+func getDomainSfcIntentWithOptionalServices(optionalServices []string, services ...string) domain.Intent {
+	values := make([]domain.Value, len(services))
+	for index, service := range services {
+		values[index] = getDomainSfcValue(proto.String(service))
+	}
+	intent := domain.NewDomainIntent(domain.IntentTypeSFC, values)
+	intent.SetOptionalServices(optionalServices)
+	return intent
+}
+
 func getDomainSfcValue(value *string) domain.Value {
 	stringValue, _ := domain.NewStringValue(domain.ValueTypeSFC, value)
 	return stringValue
 }
 
+func getDomainStringValue(valueType domain.ValueType, value *string) domain.Value {
+	stringValue, _ := domain.NewStringValue(valueType, value)
+	return stringValue
+}
+
 func TestDomainAdapter_ConvertValuesToDomain(t *testing.T) {
 	type fields struct {
 		log *logrus.Entry
@@ -1095,6 +1227,21 @@ func TestDomainAdapter_ConvertValuesToDomain(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Convert tolerance value to domain value successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiValues: []*api.Value{
+					{Type: api.ValueType_VALUE_TYPE_TOLERANCE, NumberValue: proto.Int32(0)},
+				},
+			},
+			want: []domain.Value{
+				getDomainToleranceValue(proto.Int32(0)),
+			},
+			wantErr: false,
+		},
 		{
 			name: "Convert min, max and flex algo API value to domain values successfully",
 			fields: fields{
@@ -1146,6 +1293,23 @@ func TestDomainAdapter_ConvertValuesToDomain(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Convert node and link API values to domain values successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiValues: []*api.Value{
+					{Type: api.ValueType_VALUE_TYPE_NODE, StringValue: proto.String("XR-2")},
+					{Type: api.ValueType_VALUE_TYPE_LINK, StringValue: proto.String("link1")},
+				},
+			},
+			want: []domain.Value{
+				getDomainStringValue(domain.ValueTypeNode, proto.String("XR-2")),
+				getDomainStringValue(domain.ValueTypeLink, proto.String("link1")),
+			},
+			wantErr: false,
+		},
 		{
 			name: "Convert value API value to domain values - value unspecified",
 			fields: fields{
@@ -1282,6 +1446,50 @@ func TestDomainAdapter_ConvertIntentTypeToDomain(t *testing.T) {
 			want:    domain.IntentTypeLowUtilization,
 			wantErr: false,
 		},
+		{
+			name: "Convert exclude nodes API intent type to domain intent type successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiIntentType: api.IntentType_INTENT_TYPE_EXCLUDE_NODES,
+			},
+			want:    domain.IntentTypeExcludeNodes,
+			wantErr: false,
+		},
+		{
+			name: "Convert include nodes API intent type to domain intent type successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiIntentType: api.IntentType_INTENT_TYPE_INCLUDE_NODES,
+			},
+			want:    domain.IntentTypeIncludeNodes,
+			wantErr: false,
+		},
+		{
+			name: "Convert exclude links API intent type to domain intent type successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiIntentType: api.IntentType_INTENT_TYPE_EXCLUDE_LINKS,
+			},
+			want:    domain.IntentTypeExcludeLinks,
+			wantErr: false,
+		},
+		{
+			name: "Convert include links API intent type to domain intent type successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiIntentType: api.IntentType_INTENT_TYPE_INCLUDE_LINKS,
+			},
+			want:    domain.IntentTypeIncludeLinks,
+			wantErr: false,
+		},
 		{
 			name: "Convert nil API intent type to domain intent type error",
 			fields: fields{
@@ -1309,6 +1517,162 @@ func TestDomainAdapter_ConvertIntentTypeToDomain(t *testing.T) {
 	}
 }
 
+func TestDomainAdapter_convertDisjointnessTypeToDomain(t *testing.T) {
+	tests := []struct {
+		name                string
+		apiDisjointnessType api.DisjointnessType
+		want                domain.DisjointnessType
+		wantErr             bool
+	}{
+		{
+			name:                "Convert unspecified API disjointness type to domain disjointness type successfully",
+			apiDisjointnessType: api.DisjointnessType_DISJOINTNESS_TYPE_UNSPECIFIED,
+			want:                domain.DisjointnessTypeNone,
+		},
+		{
+			name:                "Convert link API disjointness type to domain disjointness type successfully",
+			apiDisjointnessType: api.DisjointnessType_DISJOINTNESS_TYPE_LINK,
+			want:                domain.DisjointnessTypeLink,
+		},
+		{
+			name:                "Convert node API disjointness type to domain disjointness type successfully",
+			apiDisjointnessType: api.DisjointnessType_DISJOINTNESS_TYPE_NODE,
+			want:                domain.DisjointnessTypeNode,
+		},
+		{
+			name:                "Convert unknown API disjointness type to domain disjointness type error",
+			apiDisjointnessType: api.DisjointnessType(999),
+			want:                domain.DisjointnessTypeNone,
+			wantErr:             true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewDomainAdapter()
+			got, err := adapter.convertDisjointnessTypeToDomain(tt.apiDisjointnessType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("convertDisjointnessTypeToDomain() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("convertDisjointnessTypeToDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainAdapter_convertPathAlgorithmToDomain(t *testing.T) {
+	tests := []struct {
+		name             string
+		apiPathAlgorithm api.PathAlgorithm
+		want             domain.PathAlgorithm
+		wantErr          bool
+	}{
+		{
+			name:             "Convert unspecified API path algorithm to domain path algorithm successfully",
+			apiPathAlgorithm: api.PathAlgorithm_PATH_ALGORITHM_UNSPECIFIED,
+			want:             domain.PathAlgorithmDefault,
+		},
+		{
+			name:             "Convert dijkstra API path algorithm to domain path algorithm successfully",
+			apiPathAlgorithm: api.PathAlgorithm_PATH_ALGORITHM_DIJKSTRA,
+			want:             domain.PathAlgorithmDijkstra,
+		},
+		{
+			name:             "Convert constrained API path algorithm to domain path algorithm successfully",
+			apiPathAlgorithm: api.PathAlgorithm_PATH_ALGORITHM_CONSTRAINED,
+			want:             domain.PathAlgorithmConstrained,
+		},
+		{
+			name:             "Convert unknown API path algorithm to domain path algorithm error",
+			apiPathAlgorithm: api.PathAlgorithm(999),
+			want:             domain.PathAlgorithmDefault,
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewDomainAdapter()
+			got, err := adapter.convertPathAlgorithmToDomain(tt.apiPathAlgorithm)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("convertPathAlgorithmToDomain() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("convertPathAlgorithmToDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainAdapter_convertSelectionPolicyToDomain(t *testing.T) {
+	tests := []struct {
+		name               string
+		paretoFront        bool
+		apiSelectionPolicy api.SelectionPolicy
+		want               domain.SelectionPolicy
+		wantErr            bool
+	}{
+		{
+			name:               "Convert API selection policy without pareto front to domain selection policy successfully",
+			paretoFront:        false,
+			apiSelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_UNSPECIFIED,
+			want:               domain.SelectionPolicyNone,
+		},
+		{
+			name:               "Convert API selection policy without pareto front to domain selection policy error",
+			paretoFront:        false,
+			apiSelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_KNEE,
+			want:               domain.SelectionPolicyNone,
+			wantErr:            true,
+		},
+		{
+			name:               "Convert unspecified API selection policy to domain selection policy successfully",
+			paretoFront:        true,
+			apiSelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_UNSPECIFIED,
+			want:               domain.SelectionPolicyWeighted,
+		},
+		{
+			name:               "Convert weighted API selection policy to domain selection policy successfully",
+			paretoFront:        true,
+			apiSelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_WEIGHTED,
+			want:               domain.SelectionPolicyWeighted,
+		},
+		{
+			name:               "Convert lexicographic API selection policy to domain selection policy successfully",
+			paretoFront:        true,
+			apiSelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_LEXICOGRAPHIC,
+			want:               domain.SelectionPolicyLexicographic,
+		},
+		{
+			name:               "Convert knee API selection policy to domain selection policy successfully",
+			paretoFront:        true,
+			apiSelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_KNEE,
+			want:               domain.SelectionPolicyKnee,
+		},
+		{
+			name:               "Convert unknown API selection policy to domain selection policy error",
+			paretoFront:        true,
+			apiSelectionPolicy: api.SelectionPolicy(999),
+			want:               domain.SelectionPolicyNone,
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewDomainAdapter()
+			got, err := adapter.convertSelectionPolicyToDomain(tt.paretoFront, tt.apiSelectionPolicy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("convertSelectionPolicyToDomain() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("convertSelectionPolicyToDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDomainAdapter_ConvertIntentsToDomain(t *testing.T) {
 	type fields struct {
 		log *logrus.Entry
@@ -1340,6 +1704,52 @@ func TestDomainAdapter_ConvertIntentsToDomain(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Convert weighted API intents to domain intents successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiIntents: []*api.Intent{
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						Weight: proto.Float64(0.8),
+					},
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_JITTER,
+						Weight: proto.Float64(0.2),
+					},
+				},
+			},
+			want: []domain.Intent{
+				getDomainWeightedIntent(domain.IntentTypeLowLatency, 0.8),
+				getDomainWeightedIntent(domain.IntentTypeLowJitter, 0.2),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Convert unordered API service function chain intent with service order to domain intent successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				apiIntents: []*api.Intent{
+					{
+						Type: api.IntentType_INTENT_TYPE_SFC,
+						Values: []*api.Value{
+							{Type: api.ValueType_VALUE_TYPE_SFC, StringValue: proto.String("fw")},
+							{Type: api.ValueType_VALUE_TYPE_SFC, StringValue: proto.String("ids")},
+						},
+						Unordered:    true,
+						ServiceOrder: []*api.ServiceOrder{{Before: "fw", After: "ids"}},
+					},
+				},
+			},
+			want: []domain.Intent{
+				getDomainUnorderedSfcIntent([]domain.ServiceOrder{domain.NewServiceOrder("fw", "ids")}, "fw", "ids"),
+			},
+			wantErr: false,
+		},
 		{
 			name: "Convert single API intent to domain intent value error",
 			fields: fields{
@@ -1420,17 +1830,82 @@ func getDomainPathRequest(source string, destination string, intents []domain.In
 	return pathRequest
 }
 
-func TestDomainAdapter_ConvertPathRequest(t *testing.T) {
-	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
-	type fields struct {
-		log *logrus.Entry
-	}
-	type args struct {
-		pathRequest *api.PathRequest
-		stream      api.IntentController_GetIntentPathServer
-		ctx         context.Context
+func getDomainPathRequestWithAlternativePaths(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, alternativePathCount uint32) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	_ = pathRequest.SetAlternativePathCount(alternativePathCount)
+	return pathRequest
+}
+
+func getDomainPathRequestWithDisjointness(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, disjointnessType domain.DisjointnessType) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	_ = pathRequest.SetDisjointnessType(disjointnessType)
+	return pathRequest
+}
+
+func getDomainPathRequestWithPathAlgorithm(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, pathAlgorithm domain.PathAlgorithm) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	_ = pathRequest.SetPathAlgorithm(pathAlgorithm)
+	return pathRequest
+}
+
+func getDomainPathRequestWithSelectionPolicy(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, selectionPolicy domain.SelectionPolicy) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	_ = pathRequest.SetSelectionPolicy(selectionPolicy)
+	return pathRequest
+}
+
+func getDomainPathRequestWithSidLimits(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, maxHopCount, maxSidDepth uint32) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	pathRequest.SetMaxHopCount(maxHopCount)
+	pathRequest.SetMaxSidDepth(maxSidDepth)
+	return pathRequest
+}
+
+func getDomainPathRequestWithStrictPath(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	pathRequest.SetStrictPath(true)
+	return pathRequest
+}
+
+func getDomainPathRequestWithBandwidthDemand(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, bandwidthDemand uint32) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	pathRequest.SetBandwidthDemand(bandwidthDemand)
+	return pathRequest
+}
+
+func getDomainPathRequestWithPriorities(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, setupPriority, holdPriority uint32) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	if err := pathRequest.SetPriorities(setupPriority, holdPriority); err != nil {
+		return nil
 	}
-	tests := []struct {
+	return pathRequest
+}
+
+func getDomainPathRequestWithPathStability(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, hysteresisPercentage float64, holdDownTime, flapDampingHalfLife uint32) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	if err := pathRequest.SetPathStability(hysteresisPercentage, holdDownTime, flapDampingHalfLife); err != nil {
+		return nil
+	}
+	return pathRequest
+}
+
+func getDomainPathRequestWithRequestId(source string, destination string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context, requestId string) domain.PathRequest {
+	pathRequest := getDomainPathRequest(source, destination, intents, stream, ctx)
+	pathRequest.SetRequestId(requestId)
+	return pathRequest
+}
+
+func TestDomainAdapter_ConvertPathRequest(t *testing.T) {
+	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+	type fields struct {
+		log *logrus.Entry
+	}
+	type args struct {
+		pathRequest *api.PathRequest
+		stream      api.IntentController_GetIntentPathServer
+		ctx         context.Context
+	}
+	tests := []struct {
 		name    string
 		fields  fields
 		args    args
@@ -1527,6 +2002,455 @@ func TestDomainAdapter_ConvertPathRequest(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Convert API path request to domain path request with alternative paths successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					AlternativePathCount: 2,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithAlternativePaths("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), 2),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request to domain path request error too many alternative paths",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					AlternativePathCount: domain.MaximumAlternativePathCount + 1,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Convert API path request to domain path request with disjoint paths successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					DisjointnessType: api.DisjointnessType_DISJOINTNESS_TYPE_NODE,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithDisjointness("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), domain.DisjointnessTypeNode),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request to domain path request error unknown disjointness type",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					DisjointnessType: api.DisjointnessType(999),
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Convert API path request with constrained path algorithm to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					PathAlgorithm: api.PathAlgorithm_PATH_ALGORITHM_CONSTRAINED,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithPathAlgorithm("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), domain.PathAlgorithmConstrained),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request to domain path request error unknown path algorithm",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					PathAlgorithm: api.PathAlgorithm(999),
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Convert API path request with pareto front to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_PACKET_LOSS,
+						},
+					},
+					ParetoFront:     true,
+					SelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_KNEE,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithSelectionPolicy("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}), domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{})}, stream, context.Background(), domain.SelectionPolicyKnee),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request with max hop count and max SID depth to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					MaxHopCount: 5,
+					MaxSidDepth: 4,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithSidLimits("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), 5, 4),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request with strict path to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					StrictPath: true,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithStrictPath("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background()),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request with bandwidth demand to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					BandwidthDemand: 100000,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithBandwidthDemand("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), 100000),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request with priorities to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					SetupPriority: proto.Uint32(5),
+					HoldPriority:  proto.Uint32(3),
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithPriorities("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), 5, 3),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request without priorities to domain path request with lowest priorities",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithPriorities("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), domain.LowestPriority, domain.LowestPriority),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request with highest priorities to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					SetupPriority: proto.Uint32(domain.HighestPriority),
+					HoldPriority:  proto.Uint32(domain.HighestPriority),
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithPriorities("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), domain.HighestPriority, domain.HighestPriority),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request with setup priority only to domain path request with equal hold priority",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					SetupPriority: proto.Uint32(3),
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithPriorities("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), 3, 3),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request to domain path request error hold priority lower than setup priority",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					SetupPriority: proto.Uint32(1),
+					HoldPriority:  proto.Uint32(6),
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Convert API path request with path stability to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					HysteresisPercentage: 20,
+					HoldDownTime:         30,
+					FlapDampingHalfLife:  300,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithPathStability("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), 20, 30, 300),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request with request id to domain path request successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					RequestId: "request-1",
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    getDomainPathRequestWithRequestId("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background(), "request-1"),
+			wantErr: false,
+		},
+		{
+			name: "Convert API path request to domain path request error hysteresis above 100 percent",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					HysteresisPercentage: 150,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Convert API path request to domain path request error selection policy without pareto front",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						},
+					},
+					SelectionPolicy: api.SelectionPolicy_SELECTION_POLICY_LEXICOGRAPHIC,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Convert API path request to domain path request error unsupported pareto front intent",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				pathRequest: &api.PathRequest{
+					Ipv6SourceAddress:      "fc:a::10",
+					Ipv6DestinationAddress: "fc:b::10",
+					Intents: []*api.Intent{
+						{
+							Type: api.IntentType_INTENT_TYPE_LOW_UTILIZATION,
+						},
+					},
+					ParetoFront: true,
+				},
+				stream: stream,
+				ctx:    context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1618,6 +2542,23 @@ func TestDomainAdapter_ConvertValuesToApi(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Convert single tolerance domain value to API values successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				values: []domain.Value{
+					getDomainToleranceValue(proto.Int32(10)),
+				},
+			},
+			want: []*api.Value{
+				{
+					Type:        api.ValueType_VALUE_TYPE_TOLERANCE,
+					NumberValue: proto.Int32(10),
+				},
+			},
+		},
 		{
 			name: "Convert number domain values to API values successfully",
 			fields: fields{
@@ -1679,6 +2620,28 @@ func TestDomainAdapter_ConvertValuesToApi(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Convert node and link domain values to API values successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				values: []domain.Value{
+					getDomainStringValue(domain.ValueTypeNode, proto.String("XR-2")),
+					getDomainStringValue(domain.ValueTypeLink, proto.String("link1")),
+				},
+			},
+			want: []*api.Value{
+				{
+					Type:        api.ValueType_VALUE_TYPE_NODE,
+					StringValue: proto.String("XR-2"),
+				},
+				{
+					Type:        api.ValueType_VALUE_TYPE_LINK,
+					StringValue: proto.String("link1"),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1712,62 +2675,108 @@ func TestDomainAdapter_ConvertIntentsToApi(t *testing.T) {
 			},
 			args: args{
 				intents: []domain.Intent{
-					domain.NewDomainIntent(domain.IntentTypeHighBandwidth, []domain.Value{}),
+					domain.NewDomainIntent(domain.IntentTypeHighBandwidth, []domain.Value{}),
+				},
+			},
+			want: []*api.Intent{
+				{
+					Type:   api.IntentType_INTENT_TYPE_HIGH_BANDWIDTH,
+					Values: []*api.Value{},
+				},
+			},
+		},
+		{
+			name: "Convert multiple domain intents to API intents successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				intents: []domain.Intent{
+					domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+					domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{}),
+				},
+			},
+			want: []*api.Intent{
+				{
+					Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+					Values: []*api.Value{},
+				},
+				{
+					Type:   api.IntentType_INTENT_TYPE_LOW_PACKET_LOSS,
+					Values: []*api.Value{},
+				},
+			},
+		},
+		{
+			name: "Convert multiple domain intents with values to API intents successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			args: args{
+				intents: []domain.Intent{
+					domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+					domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{getDomainMinValue(proto.Int32(10))}),
 				},
 			},
 			want: []*api.Intent{
 				{
-					Type:   api.IntentType_INTENT_TYPE_HIGH_BANDWIDTH,
+					Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
 					Values: []*api.Value{},
 				},
+				{
+					Type: api.IntentType_INTENT_TYPE_LOW_PACKET_LOSS,
+					Values: []*api.Value{
+						{
+							Type:        api.ValueType_VALUE_TYPE_MIN_VALUE,
+							NumberValue: proto.Int32(10),
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "Convert multiple domain intents to API intents successfully",
+			name: "Convert weighted domain intents to API intents successfully",
 			fields: fields{
 				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
 			},
 			args: args{
 				intents: []domain.Intent{
-					domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
-					domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{}),
+					getDomainWeightedIntent(domain.IntentTypeLowLatency, 0.8),
+					getDomainWeightedIntent(domain.IntentTypeLowJitter, 0.2),
 				},
 			},
 			want: []*api.Intent{
 				{
 					Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
 					Values: []*api.Value{},
+					Weight: proto.Float64(0.8),
 				},
 				{
-					Type:   api.IntentType_INTENT_TYPE_LOW_PACKET_LOSS,
+					Type:   api.IntentType_INTENT_TYPE_LOW_JITTER,
 					Values: []*api.Value{},
+					Weight: proto.Float64(0.2),
 				},
 			},
 		},
 		{
-			name: "Convert multiple domain intents with values to API intents successfully",
+			name: "Convert unordered domain service function chain intent with service order to API intent successfully",
 			fields: fields{
 				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
 			},
 			args: args{
 				intents: []domain.Intent{
-					domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
-					domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{getDomainMinValue(proto.Int32(10))}),
+					getDomainUnorderedSfcIntent([]domain.ServiceOrder{domain.NewServiceOrder("fw", "ids")}, "fw", "ids"),
 				},
 			},
 			want: []*api.Intent{
 				{
-					Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
-					Values: []*api.Value{},
-				},
-				{
-					Type: api.IntentType_INTENT_TYPE_LOW_PACKET_LOSS,
+					Type: api.IntentType_INTENT_TYPE_SFC,
 					Values: []*api.Value{
-						{
-							Type:        api.ValueType_VALUE_TYPE_MIN_VALUE,
-							NumberValue: proto.Int32(10),
-						},
+						{Type: api.ValueType_VALUE_TYPE_SFC, StringValue: proto.String("fw")},
+						{Type: api.ValueType_VALUE_TYPE_SFC, StringValue: proto.String("ids")},
 					},
+					Unordered:    true,
+					ServiceOrder: []*api.ServiceOrder{{Before: "fw", After: "ids"}},
 				},
 			},
 		},
@@ -1791,9 +2800,56 @@ func getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress string, ipv6S
 	return pathResult
 }
 
+func getDomainPathResultWithParetoPaths(ipv6SourceAddress, ipv6DestinationAddress string, ipv6SidAddresses []string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, path graph.Path, paretoPaths []graph.Path, paretoIpv6SidAddresses [][]string) domain.PathResult {
+	pathResult := getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress, ipv6SidAddresses, intents, stream, path)
+	paretoResults := make([]domain.PathResult, 0, len(paretoPaths))
+	for index, paretoPath := range paretoPaths {
+		paretoResults = append(paretoResults, getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress, paretoIpv6SidAddresses[index], intents, stream, paretoPath))
+	}
+	pathResult.SetParetoPathResults(paretoResults)
+	return pathResult
+}
+
+func getDomainPathResultWithIncludedServices(ipv6SourceAddress, ipv6DestinationAddress string, ipv6SidAddresses []string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, path graph.Path, includedServices []string) domain.PathResult {
+	pathResult := getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress, ipv6SidAddresses, intents, stream, path)
+	pathResult.SetIncludedServices(includedServices)
+	return pathResult
+}
+
+func getDomainPathResultWithBackup(ipv6SourceAddress, ipv6DestinationAddress string, ipv6SidAddresses, backupIpv6SidAddresses []string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, path graph.Path) domain.PathResult {
+	pathResult := getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress, ipv6SidAddresses, intents, stream, path)
+	pathResult.SetBackupPathResult(getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress, backupIpv6SidAddresses, intents, stream, path))
+	return pathResult
+}
+
+func getDomainPathResultWithIdentifiers(ipv6SourceAddress, ipv6DestinationAddress string, ipv6SidAddresses []string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer, path graph.Path, requestId, sessionId string) domain.PathResult {
+	pathResult := getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress, ipv6SidAddresses, intents, stream, path)
+	pathResult.SetRequestId(requestId)
+	pathResult.SetSessionId(sessionId)
+	return pathResult
+}
+
+func getDomainPathResultWithMetrics(ipv6SourceAddress, ipv6DestinationAddress string, ipv6SidAddresses []string, intents []domain.Intent, stream api.IntentController_GetIntentPathServer) domain.PathResult {
+	nodes := []graph.Node{
+		graph.NewNetworkNode("XR-1", "XR-1-Name", nil),
+		graph.NewNetworkNode("XR-2", "XR-2-Name", nil),
+		graph.NewNetworkNode("XR-3", "XR-3-Name", nil),
+	}
+	edges := []graph.Edge{
+		graph.NewNetworkEdge("1", nodes[0], nodes[1], nil),
+		graph.NewNetworkEdge("2", nodes[1], nodes[2], nil),
+	}
+	path := graph.NewShortestPath(edges, 3000, 3000, 30, 0.01, 1000, edges[1])
+	path.SetRouterServiceMap(map[string]string{"XR-2": "fc00:0:2:e000::"})
+	pathResult := getDomainPathResult(ipv6SourceAddress, ipv6DestinationAddress, ipv6SidAddresses, intents, stream, path)
+	pathResult.SetFlexAlgoNumber(128)
+	pathResult.SetUpdateReason(domain.UpdateReasonNetworkChange)
+	return pathResult
+}
+
 func TestDomainAdapter_ConvertPathResult(t *testing.T) {
 	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
-	path := graph.NewMockPath(gomock.NewController(t))
+	path := graph.NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
 	type fields struct {
 		log *logrus.Entry
 	}
@@ -1820,6 +2876,7 @@ func TestDomainAdapter_ConvertPathResult(t *testing.T) {
 						Values: []*api.Value{},
 					},
 				},
+				UpdateReason: api.UpdateReason_UPDATE_REASON_INITIAL,
 			},
 			wantErr: false,
 		},
@@ -1844,6 +2901,7 @@ func TestDomainAdapter_ConvertPathResult(t *testing.T) {
 						},
 					},
 				},
+				UpdateReason: api.UpdateReason_UPDATE_REASON_INITIAL,
 			},
 			wantErr: false,
 		},
@@ -1872,6 +2930,154 @@ func TestDomainAdapter_ConvertPathResult(t *testing.T) {
 						Values: []*api.Value{},
 					},
 				},
+				UpdateReason: api.UpdateReason_UPDATE_REASON_INITIAL,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Convert domain path result with backup path to API path result successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			pathResult: getDomainPathResultWithBackup("fc:a::10", "fc:b::10", []string{"fc:c::10", "fc:d::10"}, []string{"fc:e::10", "fc:d::10"}, []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, path),
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Ipv6SidAddresses:       []string{"fc:c::10", "fc:d::10"},
+				BackupIpv6SidAddresses: []string{"fc:e::10", "fc:d::10"},
+				Intents: []*api.Intent{
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						Values: []*api.Value{},
+					},
+				},
+				UpdateReason: api.UpdateReason_UPDATE_REASON_INITIAL,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Convert domain path result with pareto paths to API path result successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			pathResult: getDomainPathResultWithParetoPaths("fc:a::10", "fc:b::10", []string{"fc:c::10", "fc:d::10"}, []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}), domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{})}, stream, path, []graph.Path{graph.NewShortestPath(nil, 0, 2000, 20, 0.01, 1000, nil), graph.NewShortestPath(nil, 0, 3000, 30, 0.001, 2000, nil)}, [][]string{{"fc:c::10", "fc:d::10"}, {"fc:e::10", "fc:d::10"}}),
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Ipv6SidAddresses:       []string{"fc:c::10", "fc:d::10"},
+				Intents: []*api.Intent{
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						Values: []*api.Value{},
+					},
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_PACKET_LOSS,
+						Values: []*api.Value{},
+					},
+				},
+				ParetoPaths: []*api.ParetoPath{
+					{
+						Ipv6SidAddresses:   []string{"fc:c::10", "fc:d::10"},
+						Latency:            2000,
+						Jitter:             20,
+						PacketLoss:         1,
+						AvailableBandwidth: 1000,
+					},
+					{
+						Ipv6SidAddresses:   []string{"fc:e::10", "fc:d::10"},
+						Latency:            3000,
+						Jitter:             30,
+						PacketLoss:         0.1,
+						AvailableBandwidth: 2000,
+					},
+				},
+				UpdateReason: api.UpdateReason_UPDATE_REASON_INITIAL,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Convert domain path result with skipped optional service to API path result successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			pathResult: getDomainPathResultWithIncludedServices("fc:a::10", "fc:b::10", []string{"fc:c::10", "fc:d::10"}, []domain.Intent{getDomainSfcIntentWithOptionalServices([]string{"ids"}, "fw", "ids")}, stream, path, []string{"fw"}),
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Ipv6SidAddresses:       []string{"fc:c::10", "fc:d::10"},
+				Intents: []*api.Intent{
+					{
+						Type: api.IntentType_INTENT_TYPE_SFC,
+						Values: []*api.Value{
+							{Type: api.ValueType_VALUE_TYPE_SFC, StringValue: proto.String("fw")},
+							{Type: api.ValueType_VALUE_TYPE_SFC, StringValue: proto.String("ids")},
+						},
+						OptionalServices: []string{"ids"},
+					},
+				},
+				IncludedServices: []string{"fw"},
+				UpdateReason:     api.UpdateReason_UPDATE_REASON_INITIAL,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Convert domain path result with request and session id to API path result successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			pathResult: getDomainPathResultWithIdentifiers("fc:a::10", "fc:b::10", []string{"fc:c::10", "fc:d::10"}, []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, path, "request-1", "1"),
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Ipv6SidAddresses:       []string{"fc:c::10", "fc:d::10"},
+				Intents: []*api.Intent{
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						Values: []*api.Value{},
+					},
+				},
+				RequestId:    "request-1",
+				SessionId:    "1",
+				UpdateReason: api.UpdateReason_UPDATE_REASON_INITIAL,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Convert domain path result with path metrics to API path result successfully",
+			fields: fields{
+				log: logging.DefaultLogger.WithField("subsystem", Subsystem),
+			},
+			pathResult: getDomainPathResultWithMetrics("fc:a::10", "fc:b::10", []string{"fc:c::10", "fc:d::10"}, []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream),
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Ipv6SidAddresses:       []string{"fc:c::10", "fc:d::10"},
+				Intents: []*api.Intent{
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						Values: []*api.Value{},
+					},
+				},
+				TotalCost:  3000,
+				Latency:    3000,
+				Jitter:     30,
+				PacketLoss: 1,
+				BottleneckLink: &api.Link{
+					LinkId:     "2",
+					FromRouter: &api.Router{RouterId: "XR-2", Name: "XR-2-Name"},
+					ToRouter:   &api.Router{RouterId: "XR-3", Name: "XR-3-Name"},
+				},
+				BottleneckValue: 1000,
+				Routers: []*api.Router{
+					{RouterId: "XR-1", Name: "XR-1-Name"},
+					{RouterId: "XR-2", Name: "XR-2-Name"},
+					{RouterId: "XR-3", Name: "XR-3-Name"},
+				},
+				ServiceInstances: []*api.ServiceInstance{
+					{Router: &api.Router{RouterId: "XR-2", Name: "XR-2-Name"}, ServiceSidAddress: "fc00:0:2:e000::"},
+				},
+				FlexAlgoNumber: 128,
+				UpdateReason:   api.UpdateReason_UPDATE_REASON_NETWORK_CHANGE,
 			},
 			wantErr: false,
 		},
@@ -1899,3 +3105,221 @@ func TestDomainAdapter_ConvertPathResult(t *testing.T) {
 		}
 	}
 }
+
+func TestDomainAdapter_convertAlternativePathsToApi(t *testing.T) {
+	controller := gomock.NewController(t)
+	alternativeResult := domain.NewMockPathResult(controller)
+	alternativeResult.EXPECT().GetIpv6SidAddresses().Return([]string{"fc:c::10", "fc:d::10"})
+	alternativeResult.EXPECT().GetTotalCost().Return(float64(7000))
+	adapter := NewDomainAdapter()
+	if got := adapter.convertAlternativePathsToApi([]domain.PathResult{}); got != nil {
+		t.Errorf("convertAlternativePathsToApi() = %v, want nil", got)
+	}
+	want := []*api.AlternativePath{
+		{
+			Ipv6SidAddresses: []string{"fc:c::10", "fc:d::10"},
+			TotalCost:        7000,
+		},
+	}
+	if got := adapter.convertAlternativePathsToApi([]domain.PathResult{alternativeResult}); !reflect.DeepEqual(got, want) {
+		t.Errorf("convertAlternativePathsToApi() = %v, want %v", got, want)
+	}
+}
+
+func TestDomainAdapter_ConvertSessionError(t *testing.T) {
+	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+	tests := []struct {
+		name      string
+		requestId string
+		sessionId string
+		want      *api.PathResult
+	}{
+		{
+			name:      "Convert error of path request without session to API path result",
+			requestId: "request-1",
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Intents: []*api.Intent{
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						Values: []*api.Value{},
+					},
+				},
+				RequestId:    "request-1",
+				ErrorMessage: "No path found",
+			},
+		},
+		{
+			name:      "Convert error of session to API path result",
+			requestId: "request-2",
+			sessionId: "session-2",
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Intents: []*api.Intent{
+					{
+						Type:   api.IntentType_INTENT_TYPE_LOW_LATENCY,
+						Values: []*api.Value{},
+					},
+				},
+				RequestId:    "request-2",
+				SessionId:    "session-2",
+				ErrorMessage: "No path found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewDomainAdapter()
+			pathRequest := getDomainPathRequest("fc:a::10", "fc:b::10", []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}, stream, context.Background())
+			pathRequest.SetRequestId(tt.requestId)
+			pathRequest.SetSessionId(tt.sessionId)
+			got := adapter.ConvertSessionError(domain.NewSessionError(pathRequest, errors.New("No path found")))
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("ConvertSessionError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainAdapter_ConvertRequestError(t *testing.T) {
+	intents := []*api.Intent{
+		{
+			Type: api.IntentType_INTENT_TYPE_LOW_LATENCY,
+		},
+	}
+	tests := []struct {
+		name       string
+		apiRequest *api.PathRequest
+		want       *api.PathResult
+	}{
+		{
+			name: "Convert error of invalid path request to API path result",
+			apiRequest: &api.PathRequest{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Intents:                intents,
+				RequestId:              "request-1",
+			},
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Intents:                intents,
+				RequestId:              "request-1",
+				ErrorMessage:           "invalid path request",
+			},
+		},
+		{
+			name: "Convert error of invalid modify message to API path result",
+			apiRequest: &api.PathRequest{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Intents:                intents,
+				RequestId:              "request-2",
+				SessionId:              "session-2",
+				Action:                 api.PathRequestAction_PATH_REQUEST_ACTION_MODIFY,
+			},
+			want: &api.PathResult{
+				Ipv6SourceAddress:      "fc:a::10",
+				Ipv6DestinationAddress: "fc:b::10",
+				Intents:                intents,
+				RequestId:              "request-2",
+				SessionId:              "session-2",
+				ErrorMessage:           "invalid path request",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewDomainAdapter()
+			got := adapter.ConvertRequestError(tt.apiRequest, errors.New("invalid path request"))
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("ConvertRequestError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainAdapter_convertUpdateReasonToApi(t *testing.T) {
+	tests := []struct {
+		name         string
+		updateReason domain.UpdateReason
+		want         api.UpdateReason
+	}{
+		{
+			name:         "Convert initial domain update reason to API update reason",
+			updateReason: domain.UpdateReasonInitial,
+			want:         api.UpdateReason_UPDATE_REASON_INITIAL,
+		},
+		{
+			name:         "Convert network change domain update reason to API update reason",
+			updateReason: domain.UpdateReasonNetworkChange,
+			want:         api.UpdateReason_UPDATE_REASON_NETWORK_CHANGE,
+		},
+		{
+			name:         "Convert preemption domain update reason to API update reason",
+			updateReason: domain.UpdateReasonPreemption,
+			want:         api.UpdateReason_UPDATE_REASON_PREEMPTION,
+		},
+		{
+			name:         "Convert optimization domain update reason to API update reason",
+			updateReason: domain.UpdateReasonOptimization,
+			want:         api.UpdateReason_UPDATE_REASON_OPTIMIZATION,
+		},
+		{
+			name:         "Convert displaced domain update reason to API update reason",
+			updateReason: domain.UpdateReasonDisplaced,
+			want:         api.UpdateReason_UPDATE_REASON_DISPLACED,
+		},
+		{
+			name:         "Convert unknown domain update reason to unspecified API update reason",
+			updateReason: domain.UpdateReason(999),
+			want:         api.UpdateReason_UPDATE_REASON_UNSPECIFIED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewDomainAdapter()
+			if got := adapter.convertUpdateReasonToApi(tt.updateReason); got != tt.want {
+				t.Errorf("convertUpdateReasonToApi() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainAdapter_convertServiceInstancesToApi(t *testing.T) {
+	routers := []*api.Router{
+		{RouterId: "XR-1", Name: "XR-1-Name"},
+		{RouterId: "XR-2", Name: "XR-2-Name"},
+		{RouterId: "XR-1", Name: "XR-1-Name"},
+		{RouterId: "XR-3", Name: "XR-3-Name"},
+	}
+	tests := []struct {
+		name             string
+		routerServiceMap map[string]string
+		want             []*api.ServiceInstance
+	}{
+		{
+			name:             "Convert path without services",
+			routerServiceMap: nil,
+			want:             nil,
+		},
+		{
+			name:             "Convert services in the order of the path, routers traversed twice are listed once",
+			routerServiceMap: map[string]string{"XR-3": "fc00:0:3:e000::", "XR-1": "fc00:0:1:e000::"},
+			want: []*api.ServiceInstance{
+				{Router: routers[0], ServiceSidAddress: "fc00:0:1:e000::"},
+				{Router: routers[3], ServiceSidAddress: "fc00:0:3:e000::"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := NewDomainAdapter()
+			if got := adapter.convertServiceInstancesToApi(routers, tt.routerServiceMap); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("convertServiceInstancesToApi() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -32,20 +32,28 @@ const (
 	IntentType_INTENT_TYPE_FLEX_ALGO       IntentType = 6
 	IntentType_INTENT_TYPE_SFC             IntentType = 7
 	IntentType_INTENT_TYPE_LOW_UTILIZATION IntentType = 8
+	IntentType_INTENT_TYPE_EXCLUDE_NODES   IntentType = 9
+	IntentType_INTENT_TYPE_INCLUDE_NODES   IntentType = 10
+	IntentType_INTENT_TYPE_EXCLUDE_LINKS   IntentType = 11
+	IntentType_INTENT_TYPE_INCLUDE_LINKS   IntentType = 12
 )
 
 // Enum value maps for IntentType.
 var (
 	IntentType_name = map[int32]string{
-		0: "INTENT_TYPE_UNSPECIFIED",
-		1: "INTENT_TYPE_HIGH_BANDWIDTH",
-		2: "INTENT_TYPE_LOW_BANDWIDTH",
-		3: "INTENT_TYPE_LOW_LATENCY",
-		4: "INTENT_TYPE_LOW_PACKET_LOSS",
-		5: "INTENT_TYPE_LOW_JITTER",
-		6: "INTENT_TYPE_FLEX_ALGO",
-		7: "INTENT_TYPE_SFC",
-		8: "INTENT_TYPE_LOW_UTILIZATION",
+		0:  "INTENT_TYPE_UNSPECIFIED",
+		1:  "INTENT_TYPE_HIGH_BANDWIDTH",
+		2:  "INTENT_TYPE_LOW_BANDWIDTH",
+		3:  "INTENT_TYPE_LOW_LATENCY",
+		4:  "INTENT_TYPE_LOW_PACKET_LOSS",
+		5:  "INTENT_TYPE_LOW_JITTER",
+		6:  "INTENT_TYPE_FLEX_ALGO",
+		7:  "INTENT_TYPE_SFC",
+		8:  "INTENT_TYPE_LOW_UTILIZATION",
+		9:  "INTENT_TYPE_EXCLUDE_NODES",
+		10: "INTENT_TYPE_INCLUDE_NODES",
+		11: "INTENT_TYPE_EXCLUDE_LINKS",
+		12: "INTENT_TYPE_INCLUDE_LINKS",
 	}
 	IntentType_value = map[string]int32{
 		"INTENT_TYPE_UNSPECIFIED":     0,
@@ -57,6 +65,10 @@ var (
 		"INTENT_TYPE_FLEX_ALGO":       6,
 		"INTENT_TYPE_SFC":             7,
 		"INTENT_TYPE_LOW_UTILIZATION": 8,
+		"INTENT_TYPE_EXCLUDE_NODES":   9,
+		"INTENT_TYPE_INCLUDE_NODES":   10,
+		"INTENT_TYPE_EXCLUDE_LINKS":   11,
+		"INTENT_TYPE_INCLUDE_LINKS":   12,
 	}
 )
 
@@ -95,6 +107,9 @@ const (
 	ValueType_VALUE_TYPE_MAX_VALUE    ValueType = 2
 	ValueType_VALUE_TYPE_SFC          ValueType = 3
 	ValueType_VALUE_TYPE_FLEX_ALGO_NR ValueType = 4
+	ValueType_VALUE_TYPE_TOLERANCE    ValueType = 5
+	ValueType_VALUE_TYPE_NODE         ValueType = 6
+	ValueType_VALUE_TYPE_LINK         ValueType = 7
 )
 
 // Enum value maps for ValueType.
@@ -105,6 +120,9 @@ var (
 		2: "VALUE_TYPE_MAX_VALUE",
 		3: "VALUE_TYPE_SFC",
 		4: "VALUE_TYPE_FLEX_ALGO_NR",
+		5: "VALUE_TYPE_TOLERANCE",
+		6: "VALUE_TYPE_NODE",
+		7: "VALUE_TYPE_LINK",
 	}
 	ValueType_value = map[string]int32{
 		"VALUE_TYPE_UNSPECIFIED":  0,
@@ -112,6 +130,9 @@ var (
 		"VALUE_TYPE_MAX_VALUE":    2,
 		"VALUE_TYPE_SFC":          3,
 		"VALUE_TYPE_FLEX_ALGO_NR": 4,
+		"VALUE_TYPE_TOLERANCE":    5,
+		"VALUE_TYPE_NODE":         6,
+		"VALUE_TYPE_LINK":         7,
 	}
 )
 
@@ -142,33 +163,827 @@ func (ValueType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_intent_proto_rawDescGZIP(), []int{1}
 }
 
+type DisjointnessType int32
+
+const (
+	DisjointnessType_DISJOINTNESS_TYPE_UNSPECIFIED DisjointnessType = 0
+	DisjointnessType_DISJOINTNESS_TYPE_LINK        DisjointnessType = 1
+	DisjointnessType_DISJOINTNESS_TYPE_NODE        DisjointnessType = 2
+)
+
+// Enum value maps for DisjointnessType.
+var (
+	DisjointnessType_name = map[int32]string{
+		0: "DISJOINTNESS_TYPE_UNSPECIFIED",
+		1: "DISJOINTNESS_TYPE_LINK",
+		2: "DISJOINTNESS_TYPE_NODE",
+	}
+	DisjointnessType_value = map[string]int32{
+		"DISJOINTNESS_TYPE_UNSPECIFIED": 0,
+		"DISJOINTNESS_TYPE_LINK":        1,
+		"DISJOINTNESS_TYPE_NODE":        2,
+	}
+)
+
+func (x DisjointnessType) Enum() *DisjointnessType {
+	p := new(DisjointnessType)
+	*p = x
+	return p
+}
+
+func (x DisjointnessType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisjointnessType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_intent_proto_enumTypes[2].Descriptor()
+}
+
+func (DisjointnessType) Type() protoreflect.EnumType {
+	return &file_proto_intent_proto_enumTypes[2]
+}
+
+func (x DisjointnessType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisjointnessType.Descriptor instead.
+func (DisjointnessType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{2}
+}
+
+type PathAlgorithm int32
+
+const (
+	PathAlgorithm_PATH_ALGORITHM_UNSPECIFIED PathAlgorithm = 0
+	PathAlgorithm_PATH_ALGORITHM_DIJKSTRA    PathAlgorithm = 1
+	PathAlgorithm_PATH_ALGORITHM_CONSTRAINED PathAlgorithm = 2
+)
+
+// Enum value maps for PathAlgorithm.
+var (
+	PathAlgorithm_name = map[int32]string{
+		0: "PATH_ALGORITHM_UNSPECIFIED",
+		1: "PATH_ALGORITHM_DIJKSTRA",
+		2: "PATH_ALGORITHM_CONSTRAINED",
+	}
+	PathAlgorithm_value = map[string]int32{
+		"PATH_ALGORITHM_UNSPECIFIED": 0,
+		"PATH_ALGORITHM_DIJKSTRA":    1,
+		"PATH_ALGORITHM_CONSTRAINED": 2,
+	}
+)
+
+func (x PathAlgorithm) Enum() *PathAlgorithm {
+	p := new(PathAlgorithm)
+	*p = x
+	return p
+}
+
+func (x PathAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PathAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_intent_proto_enumTypes[3].Descriptor()
+}
+
+func (PathAlgorithm) Type() protoreflect.EnumType {
+	return &file_proto_intent_proto_enumTypes[3]
+}
+
+func (x PathAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PathAlgorithm.Descriptor instead.
+func (PathAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{3}
+}
+
+type SelectionPolicy int32
+
+const (
+	SelectionPolicy_SELECTION_POLICY_UNSPECIFIED   SelectionPolicy = 0
+	SelectionPolicy_SELECTION_POLICY_WEIGHTED      SelectionPolicy = 1
+	SelectionPolicy_SELECTION_POLICY_LEXICOGRAPHIC SelectionPolicy = 2
+	SelectionPolicy_SELECTION_POLICY_KNEE          SelectionPolicy = 3
+)
+
+// Enum value maps for SelectionPolicy.
+var (
+	SelectionPolicy_name = map[int32]string{
+		0: "SELECTION_POLICY_UNSPECIFIED",
+		1: "SELECTION_POLICY_WEIGHTED",
+		2: "SELECTION_POLICY_LEXICOGRAPHIC",
+		3: "SELECTION_POLICY_KNEE",
+	}
+	SelectionPolicy_value = map[string]int32{
+		"SELECTION_POLICY_UNSPECIFIED":   0,
+		"SELECTION_POLICY_WEIGHTED":      1,
+		"SELECTION_POLICY_LEXICOGRAPHIC": 2,
+		"SELECTION_POLICY_KNEE":          3,
+	}
+)
+
+func (x SelectionPolicy) Enum() *SelectionPolicy {
+	p := new(SelectionPolicy)
+	*p = x
+	return p
+}
+
+func (x SelectionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelectionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_intent_proto_enumTypes[4].Descriptor()
+}
+
+func (SelectionPolicy) Type() protoreflect.EnumType {
+	return &file_proto_intent_proto_enumTypes[4]
+}
+
+func (x SelectionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelectionPolicy.Descriptor instead.
+func (SelectionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{4}
+}
+
+type PathRequestAction int32
+
+const (
+	PathRequestAction_PATH_REQUEST_ACTION_UNSPECIFIED PathRequestAction = 0
+	PathRequestAction_PATH_REQUEST_ACTION_CANCEL      PathRequestAction = 1
+	PathRequestAction_PATH_REQUEST_ACTION_MODIFY      PathRequestAction = 2
+)
+
+// Enum value maps for PathRequestAction.
+var (
+	PathRequestAction_name = map[int32]string{
+		0: "PATH_REQUEST_ACTION_UNSPECIFIED",
+		1: "PATH_REQUEST_ACTION_CANCEL",
+		2: "PATH_REQUEST_ACTION_MODIFY",
+	}
+	PathRequestAction_value = map[string]int32{
+		"PATH_REQUEST_ACTION_UNSPECIFIED": 0,
+		"PATH_REQUEST_ACTION_CANCEL":      1,
+		"PATH_REQUEST_ACTION_MODIFY":      2,
+	}
+)
+
+func (x PathRequestAction) Enum() *PathRequestAction {
+	p := new(PathRequestAction)
+	*p = x
+	return p
+}
+
+func (x PathRequestAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PathRequestAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_intent_proto_enumTypes[5].Descriptor()
+}
+
+func (PathRequestAction) Type() protoreflect.EnumType {
+	return &file_proto_intent_proto_enumTypes[5]
+}
+
+func (x PathRequestAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PathRequestAction.Descriptor instead.
+func (PathRequestAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{5}
+}
+
+type UpdateReason int32
+
+const (
+	UpdateReason_UPDATE_REASON_UNSPECIFIED    UpdateReason = 0
+	UpdateReason_UPDATE_REASON_INITIAL        UpdateReason = 1
+	UpdateReason_UPDATE_REASON_NETWORK_CHANGE UpdateReason = 2
+	UpdateReason_UPDATE_REASON_PREEMPTION     UpdateReason = 3
+	UpdateReason_UPDATE_REASON_OPTIMIZATION   UpdateReason = 4
+	UpdateReason_UPDATE_REASON_DISPLACED      UpdateReason = 5
+)
+
+// Enum value maps for UpdateReason.
+var (
+	UpdateReason_name = map[int32]string{
+		0: "UPDATE_REASON_UNSPECIFIED",
+		1: "UPDATE_REASON_INITIAL",
+		2: "UPDATE_REASON_NETWORK_CHANGE",
+		3: "UPDATE_REASON_PREEMPTION",
+		4: "UPDATE_REASON_OPTIMIZATION",
+		5: "UPDATE_REASON_DISPLACED",
+	}
+	UpdateReason_value = map[string]int32{
+		"UPDATE_REASON_UNSPECIFIED":    0,
+		"UPDATE_REASON_INITIAL":        1,
+		"UPDATE_REASON_NETWORK_CHANGE": 2,
+		"UPDATE_REASON_PREEMPTION":     3,
+		"UPDATE_REASON_OPTIMIZATION":   4,
+		"UPDATE_REASON_DISPLACED":      5,
+	}
+)
+
+func (x UpdateReason) Enum() *UpdateReason {
+	p := new(UpdateReason)
+	*p = x
+	return p
+}
+
+func (x UpdateReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_intent_proto_enumTypes[6].Descriptor()
+}
+
+func (UpdateReason) Type() protoreflect.EnumType {
+	return &file_proto_intent_proto_enumTypes[6]
+}
+
+func (x UpdateReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateReason.Descriptor instead.
+func (UpdateReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{6}
+}
+
 type Value struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type        ValueType `protobuf:"varint,1,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
-	NumberValue *int32    `protobuf:"varint,2,opt,name=number_value,json=numberValue,proto3,oneof" json:"number_value,omitempty"`
-	StringValue *string   `protobuf:"bytes,3,opt,name=string_value,json=stringValue,proto3,oneof" json:"string_value,omitempty"`
+	Type        ValueType `protobuf:"varint,1,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
+	NumberValue *int32    `protobuf:"varint,2,opt,name=number_value,json=numberValue,proto3,oneof" json:"number_value,omitempty"`
+	StringValue *string   `protobuf:"bytes,3,opt,name=string_value,json=stringValue,proto3,oneof" json:"string_value,omitempty"`
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_intent_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Value) GetType() ValueType {
+	if x != nil {
+		return x.Type
+	}
+	return ValueType_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *Value) GetNumberValue() int32 {
+	if x != nil && x.NumberValue != nil {
+		return *x.NumberValue
+	}
+	return 0
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil && x.StringValue != nil {
+		return *x.StringValue
+	}
+	return ""
+}
+
+type ServiceOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Before string `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *ServiceOrder) Reset() {
+	*x = ServiceOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_intent_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceOrder) ProtoMessage() {}
+
+func (x *ServiceOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceOrder.ProtoReflect.Descriptor instead.
+func (*ServiceOrder) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceOrder) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *ServiceOrder) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type Intent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             IntentType      `protobuf:"varint,1,opt,name=type,proto3,enum=api.IntentType" json:"type,omitempty"`
+	Values           []*Value        `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Weight           *float64        `protobuf:"fixed64,3,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Unordered        bool            `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	ServiceOrder     []*ServiceOrder `protobuf:"bytes,5,rep,name=service_order,json=serviceOrder,proto3" json:"service_order,omitempty"`
+	OptionalServices []string        `protobuf:"bytes,6,rep,name=optional_services,json=optionalServices,proto3" json:"optional_services,omitempty"`
+}
+
+func (x *Intent) Reset() {
+	*x = Intent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_intent_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Intent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Intent) ProtoMessage() {}
+
+func (x *Intent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Intent.ProtoReflect.Descriptor instead.
+func (*Intent) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Intent) GetType() IntentType {
+	if x != nil {
+		return x.Type
+	}
+	return IntentType_INTENT_TYPE_UNSPECIFIED
+}
+
+func (x *Intent) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Intent) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *Intent) GetUnordered() bool {
+	if x != nil {
+		return x.Unordered
+	}
+	return false
+}
+
+func (x *Intent) GetServiceOrder() []*ServiceOrder {
+	if x != nil {
+		return x.ServiceOrder
+	}
+	return nil
+}
+
+func (x *Intent) GetOptionalServices() []string {
+	if x != nil {
+		return x.OptionalServices
+	}
+	return nil
+}
+
+type PathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ipv6SourceAddress      string            `protobuf:"bytes,1,opt,name=ipv6_source_address,json=ipv6SourceAddress,proto3" json:"ipv6_source_address,omitempty"`
+	Ipv6DestinationAddress string            `protobuf:"bytes,2,opt,name=ipv6_destination_address,json=ipv6DestinationAddress,proto3" json:"ipv6_destination_address,omitempty"`
+	Intents                []*Intent         `protobuf:"bytes,3,rep,name=intents,proto3" json:"intents,omitempty"`
+	AlternativePathCount   uint32            `protobuf:"varint,4,opt,name=alternative_path_count,json=alternativePathCount,proto3" json:"alternative_path_count,omitempty"`
+	DisjointnessType       DisjointnessType  `protobuf:"varint,5,opt,name=disjointness_type,json=disjointnessType,proto3,enum=api.DisjointnessType" json:"disjointness_type,omitempty"`
+	PathAlgorithm          PathAlgorithm     `protobuf:"varint,6,opt,name=path_algorithm,json=pathAlgorithm,proto3,enum=api.PathAlgorithm" json:"path_algorithm,omitempty"`
+	ParetoFront            bool              `protobuf:"varint,7,opt,name=pareto_front,json=paretoFront,proto3" json:"pareto_front,omitempty"`
+	SelectionPolicy        SelectionPolicy   `protobuf:"varint,8,opt,name=selection_policy,json=selectionPolicy,proto3,enum=api.SelectionPolicy" json:"selection_policy,omitempty"`
+	MaxHopCount            uint32            `protobuf:"varint,9,opt,name=max_hop_count,json=maxHopCount,proto3" json:"max_hop_count,omitempty"`
+	MaxSidDepth            uint32            `protobuf:"varint,10,opt,name=max_sid_depth,json=maxSidDepth,proto3" json:"max_sid_depth,omitempty"`
+	StrictPath             bool              `protobuf:"varint,11,opt,name=strict_path,json=strictPath,proto3" json:"strict_path,omitempty"`
+	BandwidthDemand        uint32            `protobuf:"varint,12,opt,name=bandwidth_demand,json=bandwidthDemand,proto3" json:"bandwidth_demand,omitempty"`
+	SetupPriority          *uint32           `protobuf:"varint,13,opt,name=setup_priority,json=setupPriority,proto3,oneof" json:"setup_priority,omitempty"`
+	HoldPriority           *uint32           `protobuf:"varint,14,opt,name=hold_priority,json=holdPriority,proto3,oneof" json:"hold_priority,omitempty"`
+	HysteresisPercentage   float64           `protobuf:"fixed64,15,opt,name=hysteresis_percentage,json=hysteresisPercentage,proto3" json:"hysteresis_percentage,omitempty"`
+	HoldDownTime           uint32            `protobuf:"varint,16,opt,name=hold_down_time,json=holdDownTime,proto3" json:"hold_down_time,omitempty"`
+	FlapDampingHalfLife    uint32            `protobuf:"varint,17,opt,name=flap_damping_half_life,json=flapDampingHalfLife,proto3" json:"flap_damping_half_life,omitempty"`
+	RequestId              string            `protobuf:"bytes,18,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SessionId              string            `protobuf:"bytes,19,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Action                 PathRequestAction `protobuf:"varint,20,opt,name=action,proto3,enum=api.PathRequestAction" json:"action,omitempty"`
+}
+
+func (x *PathRequest) Reset() {
+	*x = PathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_intent_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathRequest) ProtoMessage() {}
+
+func (x *PathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathRequest.ProtoReflect.Descriptor instead.
+func (*PathRequest) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PathRequest) GetIpv6SourceAddress() string {
+	if x != nil {
+		return x.Ipv6SourceAddress
+	}
+	return ""
+}
+
+func (x *PathRequest) GetIpv6DestinationAddress() string {
+	if x != nil {
+		return x.Ipv6DestinationAddress
+	}
+	return ""
+}
+
+func (x *PathRequest) GetIntents() []*Intent {
+	if x != nil {
+		return x.Intents
+	}
+	return nil
+}
+
+func (x *PathRequest) GetAlternativePathCount() uint32 {
+	if x != nil {
+		return x.AlternativePathCount
+	}
+	return 0
+}
+
+func (x *PathRequest) GetDisjointnessType() DisjointnessType {
+	if x != nil {
+		return x.DisjointnessType
+	}
+	return DisjointnessType_DISJOINTNESS_TYPE_UNSPECIFIED
+}
+
+func (x *PathRequest) GetPathAlgorithm() PathAlgorithm {
+	if x != nil {
+		return x.PathAlgorithm
+	}
+	return PathAlgorithm_PATH_ALGORITHM_UNSPECIFIED
+}
+
+func (x *PathRequest) GetParetoFront() bool {
+	if x != nil {
+		return x.ParetoFront
+	}
+	return false
+}
+
+func (x *PathRequest) GetSelectionPolicy() SelectionPolicy {
+	if x != nil {
+		return x.SelectionPolicy
+	}
+	return SelectionPolicy_SELECTION_POLICY_UNSPECIFIED
+}
+
+func (x *PathRequest) GetMaxHopCount() uint32 {
+	if x != nil {
+		return x.MaxHopCount
+	}
+	return 0
+}
+
+func (x *PathRequest) GetMaxSidDepth() uint32 {
+	if x != nil {
+		return x.MaxSidDepth
+	}
+	return 0
+}
+
+func (x *PathRequest) GetStrictPath() bool {
+	if x != nil {
+		return x.StrictPath
+	}
+	return false
+}
+
+func (x *PathRequest) GetBandwidthDemand() uint32 {
+	if x != nil {
+		return x.BandwidthDemand
+	}
+	return 0
+}
+
+func (x *PathRequest) GetSetupPriority() uint32 {
+	if x != nil && x.SetupPriority != nil {
+		return *x.SetupPriority
+	}
+	return 0
+}
+
+func (x *PathRequest) GetHoldPriority() uint32 {
+	if x != nil && x.HoldPriority != nil {
+		return *x.HoldPriority
+	}
+	return 0
+}
+
+func (x *PathRequest) GetHysteresisPercentage() float64 {
+	if x != nil {
+		return x.HysteresisPercentage
+	}
+	return 0
+}
+
+func (x *PathRequest) GetHoldDownTime() uint32 {
+	if x != nil {
+		return x.HoldDownTime
+	}
+	return 0
+}
+
+func (x *PathRequest) GetFlapDampingHalfLife() uint32 {
+	if x != nil {
+		return x.FlapDampingHalfLife
+	}
+	return 0
+}
+
+func (x *PathRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PathRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PathRequest) GetAction() PathRequestAction {
+	if x != nil {
+		return x.Action
+	}
+	return PathRequestAction_PATH_REQUEST_ACTION_UNSPECIFIED
+}
+
+type AlternativePath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ipv6SidAddresses []string `protobuf:"bytes,1,rep,name=ipv6_sid_addresses,json=ipv6SidAddresses,proto3" json:"ipv6_sid_addresses,omitempty"`
+	TotalCost        float64  `protobuf:"fixed64,2,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+}
+
+func (x *AlternativePath) Reset() {
+	*x = AlternativePath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_intent_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlternativePath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlternativePath) ProtoMessage() {}
+
+func (x *AlternativePath) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlternativePath.ProtoReflect.Descriptor instead.
+func (*AlternativePath) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AlternativePath) GetIpv6SidAddresses() []string {
+	if x != nil {
+		return x.Ipv6SidAddresses
+	}
+	return nil
+}
+
+func (x *AlternativePath) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+type ParetoPath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ipv6SidAddresses   []string `protobuf:"bytes,1,rep,name=ipv6_sid_addresses,json=ipv6SidAddresses,proto3" json:"ipv6_sid_addresses,omitempty"`
+	Latency            float64  `protobuf:"fixed64,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	Jitter             float64  `protobuf:"fixed64,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	PacketLoss         float64  `protobuf:"fixed64,4,opt,name=packet_loss,json=packetLoss,proto3" json:"packet_loss,omitempty"`
+	AvailableBandwidth float64  `protobuf:"fixed64,5,opt,name=available_bandwidth,json=availableBandwidth,proto3" json:"available_bandwidth,omitempty"`
+}
+
+func (x *ParetoPath) Reset() {
+	*x = ParetoPath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_intent_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParetoPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParetoPath) ProtoMessage() {}
+
+func (x *ParetoPath) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParetoPath.ProtoReflect.Descriptor instead.
+func (*ParetoPath) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParetoPath) GetIpv6SidAddresses() []string {
+	if x != nil {
+		return x.Ipv6SidAddresses
+	}
+	return nil
+}
+
+func (x *ParetoPath) GetLatency() float64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *ParetoPath) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *ParetoPath) GetPacketLoss() float64 {
+	if x != nil {
+		return x.PacketLoss
+	}
+	return 0
+}
+
+func (x *ParetoPath) GetAvailableBandwidth() float64 {
+	if x != nil {
+		return x.AvailableBandwidth
+	}
+	return 0
+}
+
+type Router struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterId string `protobuf:"bytes,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Value) Reset() {
-	*x = Value{}
+func (x *Router) Reset() {
+	*x = Router{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_intent_proto_msgTypes[0]
+		mi := &file_proto_intent_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Value) String() string {
+func (x *Router) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value) ProtoMessage() {}
+func (*Router) ProtoMessage() {}
 
-func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_intent_proto_msgTypes[0]
+func (x *Router) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -179,58 +994,52 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value.ProtoReflect.Descriptor instead.
-func (*Value) Descriptor() ([]byte, []int) {
-	return file_proto_intent_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Router.ProtoReflect.Descriptor instead.
+func (*Router) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Value) GetType() ValueType {
+func (x *Router) GetRouterId() string {
 	if x != nil {
-		return x.Type
-	}
-	return ValueType_VALUE_TYPE_UNSPECIFIED
-}
-
-func (x *Value) GetNumberValue() int32 {
-	if x != nil && x.NumberValue != nil {
-		return *x.NumberValue
+		return x.RouterId
 	}
-	return 0
+	return ""
 }
 
-func (x *Value) GetStringValue() string {
-	if x != nil && x.StringValue != nil {
-		return *x.StringValue
+func (x *Router) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type Intent struct {
+type Link struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   IntentType `protobuf:"varint,1,opt,name=type,proto3,enum=api.IntentType" json:"type,omitempty"`
-	Values []*Value   `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	LinkId     string  `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	FromRouter *Router `protobuf:"bytes,2,opt,name=from_router,json=fromRouter,proto3" json:"from_router,omitempty"`
+	ToRouter   *Router `protobuf:"bytes,3,opt,name=to_router,json=toRouter,proto3" json:"to_router,omitempty"`
 }
 
-func (x *Intent) Reset() {
-	*x = Intent{}
+func (x *Link) Reset() {
+	*x = Link{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_intent_proto_msgTypes[1]
+		mi := &file_proto_intent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Intent) String() string {
+func (x *Link) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Intent) ProtoMessage() {}
+func (*Link) ProtoMessage() {}
 
-func (x *Intent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_intent_proto_msgTypes[1]
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,52 +1050,58 @@ func (x *Intent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Intent.ProtoReflect.Descriptor instead.
-func (*Intent) Descriptor() ([]byte, []int) {
-	return file_proto_intent_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Intent) GetType() IntentType {
+func (x *Link) GetLinkId() string {
 	if x != nil {
-		return x.Type
+		return x.LinkId
 	}
-	return IntentType_INTENT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *Intent) GetValues() []*Value {
+func (x *Link) GetFromRouter() *Router {
 	if x != nil {
-		return x.Values
+		return x.FromRouter
 	}
 	return nil
 }
 
-type PathRequest struct {
+func (x *Link) GetToRouter() *Router {
+	if x != nil {
+		return x.ToRouter
+	}
+	return nil
+}
+
+type ServiceInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ipv6SourceAddress      string    `protobuf:"bytes,1,opt,name=ipv6_source_address,json=ipv6SourceAddress,proto3" json:"ipv6_source_address,omitempty"`
-	Ipv6DestinationAddress string    `protobuf:"bytes,2,opt,name=ipv6_destination_address,json=ipv6DestinationAddress,proto3" json:"ipv6_destination_address,omitempty"`
-	Intents                []*Intent `protobuf:"bytes,3,rep,name=intents,proto3" json:"intents,omitempty"`
+	Router            *Router `protobuf:"bytes,1,opt,name=router,proto3" json:"router,omitempty"`
+	ServiceSidAddress string  `protobuf:"bytes,2,opt,name=service_sid_address,json=serviceSidAddress,proto3" json:"service_sid_address,omitempty"`
 }
 
-func (x *PathRequest) Reset() {
-	*x = PathRequest{}
+func (x *ServiceInstance) Reset() {
+	*x = ServiceInstance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_intent_proto_msgTypes[2]
+		mi := &file_proto_intent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PathRequest) String() string {
+func (x *ServiceInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PathRequest) ProtoMessage() {}
+func (*ServiceInstance) ProtoMessage() {}
 
-func (x *PathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_intent_proto_msgTypes[2]
+func (x *ServiceInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_intent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,47 +1112,57 @@ func (x *PathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PathRequest.ProtoReflect.Descriptor instead.
-func (*PathRequest) Descriptor() ([]byte, []int) {
-	return file_proto_intent_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ServiceInstance.ProtoReflect.Descriptor instead.
+func (*ServiceInstance) Descriptor() ([]byte, []int) {
+	return file_proto_intent_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PathRequest) GetIpv6SourceAddress() string {
+func (x *ServiceInstance) GetRouter() *Router {
 	if x != nil {
-		return x.Ipv6SourceAddress
+		return x.Router
 	}
-	return ""
+	return nil
 }
 
-func (x *PathRequest) GetIpv6DestinationAddress() string {
+func (x *ServiceInstance) GetServiceSidAddress() string {
 	if x != nil {
-		return x.Ipv6DestinationAddress
+		return x.ServiceSidAddress
 	}
 	return ""
 }
 
-func (x *PathRequest) GetIntents() []*Intent {
-	if x != nil {
-		return x.Intents
-	}
-	return nil
-}
-
 type PathResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ipv6SourceAddress      string    `protobuf:"bytes,1,opt,name=ipv6_source_address,json=ipv6SourceAddress,proto3" json:"ipv6_source_address,omitempty"`
-	Ipv6DestinationAddress string    `protobuf:"bytes,2,opt,name=ipv6_destination_address,json=ipv6DestinationAddress,proto3" json:"ipv6_destination_address,omitempty"`
-	Intents                []*Intent `protobuf:"bytes,3,rep,name=intents,proto3" json:"intents,omitempty"`
-	Ipv6SidAddresses       []string  `protobuf:"bytes,4,rep,name=ipv6_sid_addresses,json=ipv6SidAddresses,proto3" json:"ipv6_sid_addresses,omitempty"`
+	Ipv6SourceAddress      string             `protobuf:"bytes,1,opt,name=ipv6_source_address,json=ipv6SourceAddress,proto3" json:"ipv6_source_address,omitempty"`
+	Ipv6DestinationAddress string             `protobuf:"bytes,2,opt,name=ipv6_destination_address,json=ipv6DestinationAddress,proto3" json:"ipv6_destination_address,omitempty"`
+	Intents                []*Intent          `protobuf:"bytes,3,rep,name=intents,proto3" json:"intents,omitempty"`
+	Ipv6SidAddresses       []string           `protobuf:"bytes,4,rep,name=ipv6_sid_addresses,json=ipv6SidAddresses,proto3" json:"ipv6_sid_addresses,omitempty"`
+	AlternativePaths       []*AlternativePath `protobuf:"bytes,5,rep,name=alternative_paths,json=alternativePaths,proto3" json:"alternative_paths,omitempty"`
+	BackupIpv6SidAddresses []string           `protobuf:"bytes,6,rep,name=backup_ipv6_sid_addresses,json=backupIpv6SidAddresses,proto3" json:"backup_ipv6_sid_addresses,omitempty"`
+	ParetoPaths            []*ParetoPath      `protobuf:"bytes,7,rep,name=pareto_paths,json=paretoPaths,proto3" json:"pareto_paths,omitempty"`
+	IncludedServices       []string           `protobuf:"bytes,8,rep,name=included_services,json=includedServices,proto3" json:"included_services,omitempty"`
+	RequestId              string             `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SessionId              string             `protobuf:"bytes,10,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	TotalCost              float64            `protobuf:"fixed64,11,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	Latency                float64            `protobuf:"fixed64,12,opt,name=latency,proto3" json:"latency,omitempty"`
+	Jitter                 float64            `protobuf:"fixed64,13,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	PacketLoss             float64            `protobuf:"fixed64,14,opt,name=packet_loss,json=packetLoss,proto3" json:"packet_loss,omitempty"`
+	BottleneckLink         *Link              `protobuf:"bytes,15,opt,name=bottleneck_link,json=bottleneckLink,proto3" json:"bottleneck_link,omitempty"`
+	BottleneckValue        float64            `protobuf:"fixed64,16,opt,name=bottleneck_value,json=bottleneckValue,proto3" json:"bottleneck_value,omitempty"`
+	Routers                []*Router          `protobuf:"bytes,17,rep,name=routers,proto3" json:"routers,omitempty"`
+	ServiceInstances       []*ServiceInstance `protobuf:"bytes,18,rep,name=service_instances,json=serviceInstances,proto3" json:"service_instances,omitempty"`
+	FlexAlgoNumber         uint32             `protobuf:"varint,19,opt,name=flex_algo_number,json=flexAlgoNumber,proto3" json:"flex_algo_number,omitempty"`
+	UpdateReason           UpdateReason       `protobuf:"varint,20,opt,name=update_reason,json=updateReason,proto3,enum=api.UpdateReason" json:"update_reason,omitempty"`
+	ErrorMessage           string             `protobuf:"bytes,21,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 }
 
 func (x *PathResult) Reset() {
 	*x = PathResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_intent_proto_msgTypes[3]
+		mi := &file_proto_intent_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -350,7 +1175,7 @@ func (x *PathResult) String() string {
 func (*PathResult) ProtoMessage() {}
 
 func (x *PathResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_intent_proto_msgTypes[3]
+	mi := &file_proto_intent_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +1188,7 @@ func (x *PathResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathResult.ProtoReflect.Descriptor instead.
 func (*PathResult) Descriptor() ([]byte, []int) {
-	return file_proto_intent_proto_rawDescGZIP(), []int{3}
+	return file_proto_intent_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PathResult) GetIpv6SourceAddress() string {
@@ -394,6 +1219,125 @@ func (x *PathResult) GetIpv6SidAddresses() []string {
 	return nil
 }
 
+func (x *PathResult) GetAlternativePaths() []*AlternativePath {
+	if x != nil {
+		return x.AlternativePaths
+	}
+	return nil
+}
+
+func (x *PathResult) GetBackupIpv6SidAddresses() []string {
+	if x != nil {
+		return x.BackupIpv6SidAddresses
+	}
+	return nil
+}
+
+func (x *PathResult) GetParetoPaths() []*ParetoPath {
+	if x != nil {
+		return x.ParetoPaths
+	}
+	return nil
+}
+
+func (x *PathResult) GetIncludedServices() []string {
+	if x != nil {
+		return x.IncludedServices
+	}
+	return nil
+}
+
+func (x *PathResult) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PathResult) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PathResult) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *PathResult) GetLatency() float64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *PathResult) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *PathResult) GetPacketLoss() float64 {
+	if x != nil {
+		return x.PacketLoss
+	}
+	return 0
+}
+
+func (x *PathResult) GetBottleneckLink() *Link {
+	if x != nil {
+		return x.BottleneckLink
+	}
+	return nil
+}
+
+func (x *PathResult) GetBottleneckValue() float64 {
+	if x != nil {
+		return x.BottleneckValue
+	}
+	return 0
+}
+
+func (x *PathResult) GetRouters() []*Router {
+	if x != nil {
+		return x.Routers
+	}
+	return nil
+}
+
+func (x *PathResult) GetServiceInstances() []*ServiceInstance {
+	if x != nil {
+		return x.ServiceInstances
+	}
+	return nil
+}
+
+func (x *PathResult) GetFlexAlgoNumber() uint32 {
+	if x != nil {
+		return x.FlexAlgoNumber
+	}
+	return 0
+}
+
+func (x *PathResult) GetUpdateReason() UpdateReason {
+	if x != nil {
+		return x.UpdateReason
+	}
+	return UpdateReason_UPDATE_REASON_UNSPECIFIED
+}
+
+func (x *PathResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
 var File_proto_intent_proto protoreflect.FileDescriptor
 
 var file_proto_intent_proto_rawDesc = []byte{
@@ -408,67 +1352,271 @@ var file_proto_intent_proto_rawDesc = []byte{
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
 	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x51, 0x0a, 0x06, 0x49, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a,
-	0x0b, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13,
-	0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x70, 0x76, 0x36, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x18,
-	0x69, 0x70, 0x76, 0x36, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
-	0x69, 0x70, 0x76, 0x36, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcb, 0x01,
-	0x0a, 0x0a, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x13,
-	0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x70, 0x76, 0x36, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x18,
-	0x69, 0x70, 0x76, 0x36, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
-	0x69, 0x70, 0x76, 0x36, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a,
-	0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36, 0x53,
-	0x69, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2a, 0x93, 0x02, 0x0a, 0x0a,
-	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e,
-	0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x54, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x42, 0x41, 0x4e, 0x44,
-	0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x54, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57,
-	0x49, 0x44, 0x54, 0x48, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43,
-	0x59, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4c, 0x4f,
-	0x53, 0x53, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x4a, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x05,
-	0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x46, 0x4c, 0x45, 0x58, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x49,
-	0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x46, 0x43, 0x10, 0x07,
-	0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4c, 0x4f, 0x57, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x08, 0x2a, 0x8c, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x16, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x56,
-	0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x56, 0x41,
-	0x4c, 0x55, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x02, 0x12,
-	0x12, 0x0a, 0x0e, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x46,
-	0x43, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x46, 0x4c, 0x45, 0x58, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x4e, 0x52, 0x10, 0x04,
-	0x32, 0x4a, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x28, 0x01, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xfc, 0x01, 0x0a, 0x06, 0x49, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc4, 0x07, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x70, 0x76, 0x36, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x70, 0x76, 0x36, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a,
+	0x11, 0x64, 0x69, 0x73, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x69, 0x73, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x10, 0x64, 0x69, 0x73, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x61, 0x74, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0d, 0x70,
+	0x61, 0x74, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x12,
+	0x3f, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x70, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x64, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x53, 0x69, 0x64, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x44, 0x65,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x75, 0x70, 0x5f, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d,
+	0x73, 0x65, 0x74, 0x75, 0x70, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x15, 0x68, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x68, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x69, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x44, 0x6f, 0x77,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x61,
+	0x6d, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x66, 0x6c, 0x61, 0x70, 0x44, 0x61, 0x6d, 0x70, 0x69,
+	0x6e, 0x67, 0x48, 0x61, 0x6c, 0x66, 0x4c, 0x69, 0x66, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x65, 0x74,
+	0x75, 0x70, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x5e, 0x0a,
+	0x0f, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70,
+	0x76, 0x36, 0x53, 0x69, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x22, 0xbe, 0x01,
+	0x0a, 0x0a, 0x50, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12,
+	0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36, 0x53, 0x69,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x2f, 0x0a,
+	0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0x39,
+	0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x04, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x52, 0x08, 0x74, 0x6f, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x22, 0x66, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x69, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xaa, 0x07, 0x0a, 0x0a, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x70, 0x76,
+	0x36, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x70, 0x76, 0x36, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x70, 0x76,
+	0x36, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x70, 0x76,
+	0x36, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70,
+	0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36, 0x53, 0x69, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x10, 0x61, 0x6c, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x70, 0x76, 0x36, 0x53, 0x69, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x74, 0x6f,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0b, 0x70,
+	0x61, 0x72, 0x65, 0x74, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0f, 0x62, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x6e, 0x65, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0e, 0x62, 0x6f, 0x74,
+	0x74, 0x6c, 0x65, 0x6e, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x62,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x6e, 0x65, 0x63, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x62, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x6e, 0x65, 0x63,
+	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a,
+	0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x6c, 0x65, 0x78, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x6c, 0x65, 0x78,
+	0x41, 0x6c, 0x67, 0x6f, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0d, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x8f, 0x03, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54,
+	0x48, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48,
+	0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x03, 0x12,
+	0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c,
+	0x4f, 0x57, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x04,
+	0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4c, 0x4f, 0x57, 0x5f, 0x4a, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15,
+	0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x45, 0x58,
+	0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x54, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x46, 0x43, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b,
+	0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f,
+	0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x1d, 0x0a,
+	0x19, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x43,
+	0x4c, 0x55, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x53, 0x10, 0x09, 0x12, 0x1d, 0x0a, 0x19,
+	0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x4c,
+	0x55, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x53, 0x10, 0x0a, 0x12, 0x1d, 0x0a, 0x19, 0x49,
+	0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55,
+	0x44, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x10, 0x0b, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e,
+	0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44,
+	0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x10, 0x0c, 0x2a, 0xd0, 0x01, 0x0a, 0x09, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x4c, 0x55, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
+	0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x41, 0x4c, 0x55, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x46, 0x43, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x56,
+	0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x45, 0x58, 0x5f, 0x41,
+	0x4c, 0x47, 0x4f, 0x5f, 0x4e, 0x52, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x4c, 0x55,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f, 0x4c, 0x45, 0x52, 0x41, 0x4e, 0x43, 0x45,
+	0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x41, 0x4c, 0x55, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x07, 0x2a, 0x8b, 0x01, 0x0a,
+	0x10, 0x44, 0x69, 0x73, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x49, 0x53, 0x4a, 0x4f, 0x49, 0x4e, 0x54, 0x4e, 0x45, 0x53,
+	0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4a, 0x4f, 0x49, 0x4e, 0x54,
+	0x4e, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4a, 0x4f, 0x49, 0x4e, 0x54, 0x4e, 0x45, 0x53, 0x53,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x22, 0x04, 0x08, 0x03,
+	0x10, 0x03, 0x2a, 0x16, 0x44, 0x49, 0x53, 0x4a, 0x4f, 0x49, 0x4e, 0x54, 0x4e, 0x45, 0x53, 0x53,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x52, 0x4c, 0x47, 0x2a, 0x6c, 0x0a, 0x0d, 0x50, 0x61,
+	0x74, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x41, 0x54, 0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50,
+	0x41, 0x54, 0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x44, 0x49,
+	0x4a, 0x4b, 0x53, 0x54, 0x52, 0x41, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x54, 0x48,
+	0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x54,
+	0x52, 0x41, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x91, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a,
+	0x1e, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x49, 0x43, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4b, 0x4e, 0x45, 0x45, 0x10, 0x03, 0x2a, 0x78, 0x0a, 0x11,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x44, 0x49, 0x46, 0x59, 0x10, 0x02, 0x2a, 0xc5, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x45, 0x4d, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x04, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x05, 0x32, 0x4a,
+	0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x28, 0x01, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -483,29 +1631,54 @@ func file_proto_intent_proto_rawDescGZIP() []byte {
 	return file_proto_intent_proto_rawDescData
 }
 
-var file_proto_intent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_intent_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_intent_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_intent_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_proto_intent_proto_goTypes = []interface{}{
-	(IntentType)(0),     // 0: api.IntentType
-	(ValueType)(0),      // 1: api.ValueType
-	(*Value)(nil),       // 2: api.Value
-	(*Intent)(nil),      // 3: api.Intent
-	(*PathRequest)(nil), // 4: api.PathRequest
-	(*PathResult)(nil),  // 5: api.PathResult
+	(IntentType)(0),         // 0: api.IntentType
+	(ValueType)(0),          // 1: api.ValueType
+	(DisjointnessType)(0),   // 2: api.DisjointnessType
+	(PathAlgorithm)(0),      // 3: api.PathAlgorithm
+	(SelectionPolicy)(0),    // 4: api.SelectionPolicy
+	(PathRequestAction)(0),  // 5: api.PathRequestAction
+	(UpdateReason)(0),       // 6: api.UpdateReason
+	(*Value)(nil),           // 7: api.Value
+	(*ServiceOrder)(nil),    // 8: api.ServiceOrder
+	(*Intent)(nil),          // 9: api.Intent
+	(*PathRequest)(nil),     // 10: api.PathRequest
+	(*AlternativePath)(nil), // 11: api.AlternativePath
+	(*ParetoPath)(nil),      // 12: api.ParetoPath
+	(*Router)(nil),          // 13: api.Router
+	(*Link)(nil),            // 14: api.Link
+	(*ServiceInstance)(nil), // 15: api.ServiceInstance
+	(*PathResult)(nil),      // 16: api.PathResult
 }
 var file_proto_intent_proto_depIdxs = []int32{
-	1, // 0: api.Value.type:type_name -> api.ValueType
-	0, // 1: api.Intent.type:type_name -> api.IntentType
-	2, // 2: api.Intent.values:type_name -> api.Value
-	3, // 3: api.PathRequest.intents:type_name -> api.Intent
-	3, // 4: api.PathResult.intents:type_name -> api.Intent
-	4, // 5: api.IntentController.GetIntentPath:input_type -> api.PathRequest
-	5, // 6: api.IntentController.GetIntentPath:output_type -> api.PathResult
-	6, // [6:7] is the sub-list for method output_type
-	5, // [5:6] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: api.Value.type:type_name -> api.ValueType
+	0,  // 1: api.Intent.type:type_name -> api.IntentType
+	7,  // 2: api.Intent.values:type_name -> api.Value
+	8,  // 3: api.Intent.service_order:type_name -> api.ServiceOrder
+	9,  // 4: api.PathRequest.intents:type_name -> api.Intent
+	2,  // 5: api.PathRequest.disjointness_type:type_name -> api.DisjointnessType
+	3,  // 6: api.PathRequest.path_algorithm:type_name -> api.PathAlgorithm
+	4,  // 7: api.PathRequest.selection_policy:type_name -> api.SelectionPolicy
+	5,  // 8: api.PathRequest.action:type_name -> api.PathRequestAction
+	13, // 9: api.Link.from_router:type_name -> api.Router
+	13, // 10: api.Link.to_router:type_name -> api.Router
+	13, // 11: api.ServiceInstance.router:type_name -> api.Router
+	9,  // 12: api.PathResult.intents:type_name -> api.Intent
+	11, // 13: api.PathResult.alternative_paths:type_name -> api.AlternativePath
+	12, // 14: api.PathResult.pareto_paths:type_name -> api.ParetoPath
+	14, // 15: api.PathResult.bottleneck_link:type_name -> api.Link
+	13, // 16: api.PathResult.routers:type_name -> api.Router
+	15, // 17: api.PathResult.service_instances:type_name -> api.ServiceInstance
+	6,  // 18: api.PathResult.update_reason:type_name -> api.UpdateReason
+	10, // 19: api.IntentController.GetIntentPath:input_type -> api.PathRequest
+	16, // 20: api.IntentController.GetIntentPath:output_type -> api.PathResult
+	20, // [20:21] is the sub-list for method output_type
+	19, // [19:20] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_proto_intent_proto_init() }
@@ -527,7 +1700,7 @@ func file_proto_intent_proto_init() {
 			}
 		}
 		file_proto_intent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Intent); i {
+			switch v := v.(*ServiceOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -539,7 +1712,7 @@ func file_proto_intent_proto_init() {
 			}
 		}
 		file_proto_intent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathRequest); i {
+			switch v := v.(*Intent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -551,6 +1724,78 @@ func file_proto_intent_proto_init() {
 			}
 		}
 		file_proto_intent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_intent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlternativePath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_intent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParetoPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_intent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Router); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_intent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Link); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_intent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_intent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PathResult); i {
 			case 0:
 				return &v.state
@@ -564,13 +1809,15 @@ func file_proto_intent_proto_init() {
 		}
 	}
 	file_proto_intent_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_proto_intent_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_proto_intent_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_intent_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
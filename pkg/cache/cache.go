@@ -15,6 +15,10 @@ type Cache interface {
 	GetSidByKey(string) domain.Sid
 	GetRouterIdFromNetworkAddress(string) string
 	GetSrAlgorithmSid(string, uint32) string
+	GetSrAlgorithmDomainSid(string, uint32) domain.Sid
+	StoreAdjacencySids(string, map[uint32]string)
+	RemoveAdjacencySids(string)
+	GetAdjacencySid(string, uint32) string
 	StoreNode(node domain.Node)
 	RemoveNode(node domain.Node)
 	GetNodeByKey(string) domain.Node
@@ -23,4 +27,13 @@ type Cache interface {
 	RemoveServiceSid(string, string)
 	GetServiceSids(string) []string
 	DoesServiceSidExist(string) bool
+	StoreServiceMetadata(string, domain.ServiceMetadata)
+	RemoveServiceMetadata(string)
+	GetServiceMetadata(string) domain.ServiceMetadata
+	AddServiceSession(string)
+	RemoveServiceSession(string)
+	GetServiceSessionCount(string) int
+	AddBandwidthReservation(string, float64)
+	RemoveBandwidthReservation(string, float64)
+	GetBandwidthReservations() map[string]float64
 }
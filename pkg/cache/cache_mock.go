@@ -39,6 +39,30 @@ func (m *MockCache) EXPECT() *MockCacheMockRecorder {
 	return m.recorder
 }
 
+// AddBandwidthReservation mocks base method.
+func (m *MockCache) AddBandwidthReservation(arg0 string, arg1 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddBandwidthReservation", arg0, arg1)
+}
+
+// AddBandwidthReservation indicates an expected call of AddBandwidthReservation.
+func (mr *MockCacheMockRecorder) AddBandwidthReservation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBandwidthReservation", reflect.TypeOf((*MockCache)(nil).AddBandwidthReservation), arg0, arg1)
+}
+
+// AddServiceSession mocks base method.
+func (m *MockCache) AddServiceSession(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddServiceSession", arg0)
+}
+
+// AddServiceSession indicates an expected call of AddServiceSession.
+func (mr *MockCacheMockRecorder) AddServiceSession(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceSession", reflect.TypeOf((*MockCache)(nil).AddServiceSession), arg0)
+}
+
 // DoesServiceSidExist mocks base method.
 func (m *MockCache) DoesServiceSidExist(arg0 string) bool {
 	m.ctrl.T.Helper()
@@ -53,6 +77,34 @@ func (mr *MockCacheMockRecorder) DoesServiceSidExist(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoesServiceSidExist", reflect.TypeOf((*MockCache)(nil).DoesServiceSidExist), arg0)
 }
 
+// GetAdjacencySid mocks base method.
+func (m *MockCache) GetAdjacencySid(arg0 string, arg1 uint32) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdjacencySid", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetAdjacencySid indicates an expected call of GetAdjacencySid.
+func (mr *MockCacheMockRecorder) GetAdjacencySid(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdjacencySid", reflect.TypeOf((*MockCache)(nil).GetAdjacencySid), arg0, arg1)
+}
+
+// GetBandwidthReservations mocks base method.
+func (m *MockCache) GetBandwidthReservations() map[string]float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBandwidthReservations")
+	ret0, _ := ret[0].(map[string]float64)
+	return ret0
+}
+
+// GetBandwidthReservations indicates an expected call of GetBandwidthReservations.
+func (mr *MockCacheMockRecorder) GetBandwidthReservations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBandwidthReservations", reflect.TypeOf((*MockCache)(nil).GetBandwidthReservations))
+}
+
 // GetClientNetworkByKey mocks base method.
 func (m *MockCache) GetClientNetworkByKey(arg0 string) domain.Prefix {
 	m.ctrl.T.Helper()
@@ -109,6 +161,34 @@ func (mr *MockCacheMockRecorder) GetRouterIdFromNetworkAddress(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterIdFromNetworkAddress", reflect.TypeOf((*MockCache)(nil).GetRouterIdFromNetworkAddress), arg0)
 }
 
+// GetServiceMetadata mocks base method.
+func (m *MockCache) GetServiceMetadata(arg0 string) domain.ServiceMetadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceMetadata", arg0)
+	ret0, _ := ret[0].(domain.ServiceMetadata)
+	return ret0
+}
+
+// GetServiceMetadata indicates an expected call of GetServiceMetadata.
+func (mr *MockCacheMockRecorder) GetServiceMetadata(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceMetadata", reflect.TypeOf((*MockCache)(nil).GetServiceMetadata), arg0)
+}
+
+// GetServiceSessionCount mocks base method.
+func (m *MockCache) GetServiceSessionCount(arg0 string) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceSessionCount", arg0)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetServiceSessionCount indicates an expected call of GetServiceSessionCount.
+func (mr *MockCacheMockRecorder) GetServiceSessionCount(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSessionCount", reflect.TypeOf((*MockCache)(nil).GetServiceSessionCount), arg0)
+}
+
 // GetServiceSids mocks base method.
 func (m *MockCache) GetServiceSids(arg0 string) []string {
 	m.ctrl.T.Helper()
@@ -137,6 +217,20 @@ func (mr *MockCacheMockRecorder) GetSidByKey(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSidByKey", reflect.TypeOf((*MockCache)(nil).GetSidByKey), arg0)
 }
 
+// GetSrAlgorithmDomainSid mocks base method.
+func (m *MockCache) GetSrAlgorithmDomainSid(arg0 string, arg1 uint32) domain.Sid {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSrAlgorithmDomainSid", arg0, arg1)
+	ret0, _ := ret[0].(domain.Sid)
+	return ret0
+}
+
+// GetSrAlgorithmDomainSid indicates an expected call of GetSrAlgorithmDomainSid.
+func (mr *MockCacheMockRecorder) GetSrAlgorithmDomainSid(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSrAlgorithmDomainSid", reflect.TypeOf((*MockCache)(nil).GetSrAlgorithmDomainSid), arg0, arg1)
+}
+
 // GetSrAlgorithmSid mocks base method.
 func (m *MockCache) GetSrAlgorithmSid(arg0 string, arg1 uint32) string {
 	m.ctrl.T.Helper()
@@ -163,6 +257,30 @@ func (mr *MockCacheMockRecorder) Lock() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockCache)(nil).Lock))
 }
 
+// RemoveAdjacencySids mocks base method.
+func (m *MockCache) RemoveAdjacencySids(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveAdjacencySids", arg0)
+}
+
+// RemoveAdjacencySids indicates an expected call of RemoveAdjacencySids.
+func (mr *MockCacheMockRecorder) RemoveAdjacencySids(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAdjacencySids", reflect.TypeOf((*MockCache)(nil).RemoveAdjacencySids), arg0)
+}
+
+// RemoveBandwidthReservation mocks base method.
+func (m *MockCache) RemoveBandwidthReservation(arg0 string, arg1 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveBandwidthReservation", arg0, arg1)
+}
+
+// RemoveBandwidthReservation indicates an expected call of RemoveBandwidthReservation.
+func (mr *MockCacheMockRecorder) RemoveBandwidthReservation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBandwidthReservation", reflect.TypeOf((*MockCache)(nil).RemoveBandwidthReservation), arg0, arg1)
+}
+
 // RemoveClientNetwork mocks base method.
 func (m *MockCache) RemoveClientNetwork(arg0 domain.Prefix) {
 	m.ctrl.T.Helper()
@@ -187,6 +305,30 @@ func (mr *MockCacheMockRecorder) RemoveNode(node any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNode", reflect.TypeOf((*MockCache)(nil).RemoveNode), node)
 }
 
+// RemoveServiceMetadata mocks base method.
+func (m *MockCache) RemoveServiceMetadata(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveServiceMetadata", arg0)
+}
+
+// RemoveServiceMetadata indicates an expected call of RemoveServiceMetadata.
+func (mr *MockCacheMockRecorder) RemoveServiceMetadata(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceMetadata", reflect.TypeOf((*MockCache)(nil).RemoveServiceMetadata), arg0)
+}
+
+// RemoveServiceSession mocks base method.
+func (m *MockCache) RemoveServiceSession(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveServiceSession", arg0)
+}
+
+// RemoveServiceSession indicates an expected call of RemoveServiceSession.
+func (mr *MockCacheMockRecorder) RemoveServiceSession(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceSession", reflect.TypeOf((*MockCache)(nil).RemoveServiceSession), arg0)
+}
+
 // RemoveServiceSid mocks base method.
 func (m *MockCache) RemoveServiceSid(arg0, arg1 string) {
 	m.ctrl.T.Helper()
@@ -211,6 +353,18 @@ func (mr *MockCacheMockRecorder) RemoveSid(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSid", reflect.TypeOf((*MockCache)(nil).RemoveSid), arg0)
 }
 
+// StoreAdjacencySids mocks base method.
+func (m *MockCache) StoreAdjacencySids(arg0 string, arg1 map[uint32]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StoreAdjacencySids", arg0, arg1)
+}
+
+// StoreAdjacencySids indicates an expected call of StoreAdjacencySids.
+func (mr *MockCacheMockRecorder) StoreAdjacencySids(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAdjacencySids", reflect.TypeOf((*MockCache)(nil).StoreAdjacencySids), arg0, arg1)
+}
+
 // StoreClientNetwork mocks base method.
 func (m *MockCache) StoreClientNetwork(arg0 domain.Prefix) {
 	m.ctrl.T.Helper()
@@ -235,6 +389,18 @@ func (mr *MockCacheMockRecorder) StoreNode(node any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNode", reflect.TypeOf((*MockCache)(nil).StoreNode), node)
 }
 
+// StoreServiceMetadata mocks base method.
+func (m *MockCache) StoreServiceMetadata(arg0 string, arg1 domain.ServiceMetadata) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StoreServiceMetadata", arg0, arg1)
+}
+
+// StoreServiceMetadata indicates an expected call of StoreServiceMetadata.
+func (mr *MockCacheMockRecorder) StoreServiceMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreServiceMetadata", reflect.TypeOf((*MockCache)(nil).StoreServiceMetadata), arg0, arg1)
+}
+
 // StoreServiceSid mocks base method.
 func (m *MockCache) StoreServiceSid(arg0, arg1 string) {
 	m.ctrl.T.Helper()
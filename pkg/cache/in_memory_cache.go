@@ -14,9 +14,13 @@ type InMemoryCache struct {
 	prefixToRouterIdMap         map[string]string
 	sidStore                    map[string]domain.Sid
 	igpRouterIdToSrAlgoToSidMap map[string]map[uint32]string
+	linkKeyToSrAlgoToSidMap     map[string]map[uint32]string
 	nodeStore                   map[string]domain.Node
 	igpRouterIdToRouterKeyMap   map[string]string
 	serviceSidStore             map[string]map[string]struct{}
+	serviceMetadataStore        map[string]domain.ServiceMetadata
+	serviceSessionCounts        map[string]int
+	bandwidthReservations       map[string]float64
 	mu                          sync.Mutex
 }
 
@@ -27,9 +31,13 @@ func NewInMemoryCache() *InMemoryCache {
 		prefixToRouterIdMap:         make(map[string]string),
 		sidStore:                    make(map[string]domain.Sid),
 		igpRouterIdToSrAlgoToSidMap: make(map[string]map[uint32]string),
+		linkKeyToSrAlgoToSidMap:     make(map[string]map[uint32]string),
 		nodeStore:                   make(map[string]domain.Node),
 		igpRouterIdToRouterKeyMap:   make(map[string]string),
 		serviceSidStore:             make(map[string]map[string]struct{}),
+		serviceMetadataStore:        make(map[string]domain.ServiceMetadata),
+		serviceSessionCounts:        make(map[string]int),
+		bandwidthReservations:       make(map[string]float64),
 		mu:                          sync.Mutex{},
 	}
 }
@@ -91,6 +99,33 @@ func (cache *InMemoryCache) GetSrAlgorithmSid(igpRouterId string, srAlgorithm ui
 	}
 }
 
+func (cache *InMemoryCache) GetSrAlgorithmDomainSid(igpRouterId string, srAlgorithm uint32) domain.Sid {
+	if _, ok := cache.igpRouterIdToSrAlgoToSidMap[igpRouterId]; !ok {
+		return nil
+	}
+	if sidKey, ok := cache.igpRouterIdToSrAlgoToSidMap[igpRouterId][srAlgorithm]; !ok {
+		return nil
+	} else {
+		return cache.sidStore[sidKey]
+	}
+}
+
+func (cache *InMemoryCache) StoreAdjacencySids(linkKey string, adjacencySids map[uint32]string) {
+	if len(adjacencySids) == 0 {
+		delete(cache.linkKeyToSrAlgoToSidMap, linkKey)
+		return
+	}
+	cache.linkKeyToSrAlgoToSidMap[linkKey] = adjacencySids
+}
+
+func (cache *InMemoryCache) RemoveAdjacencySids(linkKey string) {
+	delete(cache.linkKeyToSrAlgoToSidMap, linkKey)
+}
+
+func (cache *InMemoryCache) GetAdjacencySid(linkKey string, srAlgorithm uint32) string {
+	return cache.linkKeyToSrAlgoToSidMap[linkKey][srAlgorithm]
+}
+
 func (cache *InMemoryCache) StoreNode(node domain.Node) {
 	cache.nodeStore[node.GetKey()] = node
 	cache.igpRouterIdToRouterKeyMap[node.GetIgpRouterId()] = node.GetKey()
@@ -143,3 +178,54 @@ func (cache *InMemoryCache) DoesServiceSidExist(servicePrefixSid string) bool {
 	}
 	return false
 }
+
+func (cache *InMemoryCache) StoreServiceMetadata(servicePrefixSid string, metadata domain.ServiceMetadata) {
+	cache.serviceMetadataStore[servicePrefixSid] = metadata
+}
+
+func (cache *InMemoryCache) RemoveServiceMetadata(servicePrefixSid string) {
+	delete(cache.serviceMetadataStore, servicePrefixSid)
+}
+
+func (cache *InMemoryCache) GetServiceMetadata(servicePrefixSid string) domain.ServiceMetadata {
+	if metadata, ok := cache.serviceMetadataStore[servicePrefixSid]; ok {
+		return metadata
+	}
+	return nil
+}
+
+func (cache *InMemoryCache) AddServiceSession(servicePrefixSid string) {
+	cache.serviceSessionCounts[servicePrefixSid]++
+}
+
+func (cache *InMemoryCache) RemoveServiceSession(servicePrefixSid string) {
+	if cache.serviceSessionCounts[servicePrefixSid] <= 1 {
+		delete(cache.serviceSessionCounts, servicePrefixSid)
+		return
+	}
+	cache.serviceSessionCounts[servicePrefixSid]--
+}
+
+func (cache *InMemoryCache) GetServiceSessionCount(servicePrefixSid string) int {
+	return cache.serviceSessionCounts[servicePrefixSid]
+}
+
+func (cache *InMemoryCache) AddBandwidthReservation(edgeId string, bandwidth float64) {
+	cache.bandwidthReservations[edgeId] += bandwidth
+}
+
+func (cache *InMemoryCache) RemoveBandwidthReservation(edgeId string, bandwidth float64) {
+	if cache.bandwidthReservations[edgeId] <= bandwidth {
+		delete(cache.bandwidthReservations, edgeId)
+		return
+	}
+	cache.bandwidthReservations[edgeId] -= bandwidth
+}
+
+func (cache *InMemoryCache) GetBandwidthReservations() map[string]float64 {
+	reservations := make(map[string]float64, len(cache.bandwidthReservations))
+	for edgeId, bandwidth := range cache.bandwidthReservations {
+		reservations[edgeId] = bandwidth
+	}
+	return reservations
+}
@@ -355,6 +355,107 @@ func TestInMemoryCache_GetSrAlgorithmSid(t *testing.T) {
 	}
 }
 
+func TestInMemoryCache_GetSrAlgorithmDomainSid(t *testing.T) {
+	tests := []struct {
+		name        string
+		sid         domain.Sid
+		routerIgpId string
+		algorithm   uint32
+		wantFound   bool
+	}{
+		{
+			name:        "Test GetSrAlgorithmDomainSid successfully",
+			sid:         setUpDomainSid("0_0000.0000.0007_fc00:0:7:0:1::", "0000.0000.0007", "fc00:0:7:0:1::", 0),
+			routerIgpId: "0000.0000.0007",
+			algorithm:   0,
+			wantFound:   true,
+		},
+		{
+			name:        "Test GetSrAlgorithmDomainSid routerId not in cache",
+			sid:         setUpDomainSid("1_0000.0000.0008_fc00:0:8:0:1::", "0000.0000.0008", "fc00:0:8:0:1::", 1),
+			routerIgpId: "0000.0000.0007",
+			algorithm:   0,
+		},
+		{
+			name:        "Test GetSrAlgorithmDomainSid wrong algorithm",
+			sid:         setUpDomainSid("1_0000.0000.0008_fc00:0:8:0:1::", "0000.0000.0008", "fc00:0:8:0:1::", 1),
+			routerIgpId: "0000.0000.0008",
+			algorithm:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewInMemoryCache()
+			cache.StoreSid(tt.sid)
+			sid := cache.GetSrAlgorithmDomainSid(tt.routerIgpId, tt.algorithm)
+			if tt.wantFound && !reflect.DeepEqual(sid, tt.sid) {
+				t.Errorf("Got %v, want %v", sid, tt.sid)
+			}
+			if !tt.wantFound && sid != nil {
+				t.Errorf("Got %v, want nil", sid)
+			}
+		})
+	}
+}
+
+func TestInMemoryCache_AdjacencySids(t *testing.T) {
+	tests := []struct {
+		name          string
+		linkKey       string
+		adjacencySids map[uint32]string
+		lookupKey     string
+		algorithm     uint32
+		remove        bool
+		want          string
+	}{
+		{
+			name:          "Test GetAdjacencySid successfully",
+			linkKey:       "2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6",
+			adjacencySids: map[uint32]string{0: "fc00:0:b:e000::", 128: "fc00:0:8b:e000::"},
+			lookupKey:     "2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6",
+			algorithm:     128,
+			want:          "fc00:0:8b:e000::",
+		},
+		{
+			name:          "Test GetAdjacencySid link not in cache",
+			linkKey:       "2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6",
+			adjacencySids: map[uint32]string{0: "fc00:0:b:e000::"},
+			lookupKey:     "2_0_2_0_0000.0000.0006_2001:db8:b6::6_0000.0000.000b_2001:db8:b6::b",
+			algorithm:     0,
+			want:          "",
+		},
+		{
+			name:          "Test GetAdjacencySid wrong algorithm",
+			linkKey:       "2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6",
+			adjacencySids: map[uint32]string{0: "fc00:0:b:e000::"},
+			lookupKey:     "2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6",
+			algorithm:     128,
+			want:          "",
+		},
+		{
+			name:          "Test GetAdjacencySid removed",
+			linkKey:       "2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6",
+			adjacencySids: map[uint32]string{0: "fc00:0:b:e000::"},
+			lookupKey:     "2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6",
+			algorithm:     0,
+			remove:        true,
+			want:          "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewInMemoryCache()
+			cache.StoreAdjacencySids(tt.linkKey, tt.adjacencySids)
+			if tt.remove {
+				cache.RemoveAdjacencySids(tt.linkKey)
+			}
+			if sid := cache.GetAdjacencySid(tt.lookupKey, tt.algorithm); sid != tt.want {
+				t.Errorf("Got %v, want %v", sid, tt.want)
+			}
+		})
+	}
+}
+
 func setUpDomainNode(key string, igpRouterId string, name string, srAlgorithm []uint32) *domain.DomainNode {
 	node, _ := domain.NewDomainNode(&key, &igpRouterId, &name, srAlgorithm)
 	return node
@@ -664,3 +765,124 @@ func TestInMemoryCache_DoesServiceSidExist(t *testing.T) {
 		})
 	}
 }
+func TestInMemoryCache_StoreServiceMetadata(t *testing.T) {
+	cache := NewInMemoryCache()
+	metadata, err := domain.NewDomainServiceMetadata(50, 1, 100, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("Error creating service metadata: %v", err)
+	}
+	tests := []struct {
+		name             string
+		servicePrefixSid string
+		metadata         domain.ServiceMetadata
+		remove           bool
+		want             domain.ServiceMetadata
+	}{
+		{
+			name:             "Test StoreServiceMetadata - fw",
+			servicePrefixSid: "fc00:0:2f::",
+			metadata:         metadata,
+			want:             metadata,
+		},
+		{
+			name:             "Test RemoveServiceMetadata - fw",
+			servicePrefixSid: "fc00:0:2f::",
+			metadata:         metadata,
+			remove:           true,
+			want:             nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache.StoreServiceMetadata(tt.servicePrefixSid, tt.metadata)
+			if tt.remove {
+				cache.RemoveServiceMetadata(tt.servicePrefixSid)
+			}
+			if got := cache.GetServiceMetadata(tt.servicePrefixSid); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInMemoryCache_ServiceSessions(t *testing.T) {
+	tests := []struct {
+		name           string
+		addedSessions  int
+		removeSessions int
+		want           int
+	}{
+		{
+			name:          "Test ServiceSessions - add sessions",
+			addedSessions: 3,
+			want:          3,
+		},
+		{
+			name:           "Test ServiceSessions - add and remove sessions",
+			addedSessions:  3,
+			removeSessions: 2,
+			want:           1,
+		},
+		{
+			name:           "Test ServiceSessions - remove more sessions than added",
+			addedSessions:  1,
+			removeSessions: 2,
+			want:           0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewInMemoryCache()
+			for i := 0; i < tt.addedSessions; i++ {
+				cache.AddServiceSession("fc00:0:2f::")
+			}
+			for i := 0; i < tt.removeSessions; i++ {
+				cache.RemoveServiceSession("fc00:0:2f::")
+			}
+			if got := cache.GetServiceSessionCount("fc00:0:2f::"); got != tt.want {
+				t.Errorf("Got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInMemoryCache_BandwidthReservations(t *testing.T) {
+	tests := []struct {
+		name                string
+		addedReservations   []float64
+		removedReservations []float64
+		want                map[string]float64
+	}{
+		{
+			name:              "Test BandwidthReservations - add reservations",
+			addedReservations: []float64{1000, 2000},
+			want:              map[string]float64{"1": 3000},
+		},
+		{
+			name:                "Test BandwidthReservations - add and remove reservations",
+			addedReservations:   []float64{1000, 2000},
+			removedReservations: []float64{1000},
+			want:                map[string]float64{"1": 2000},
+		},
+		{
+			name:                "Test BandwidthReservations - remove all reservations",
+			addedReservations:   []float64{1000, 2000},
+			removedReservations: []float64{2000, 1000},
+			want:                map[string]float64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewInMemoryCache()
+			for _, bandwidth := range tt.addedReservations {
+				cache.AddBandwidthReservation("1", bandwidth)
+			}
+			for _, bandwidth := range tt.removedReservations {
+				cache.RemoveBandwidthReservation("1", bandwidth)
+			}
+			if got := cache.GetBandwidthReservations(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package calculation
 
 import (
+	"cmp"
+	"math"
+	"slices"
+
 	"github.com/hawkv6/hawkeye/pkg/graph"
 	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/hawkv6/hawkeye/pkg/logging"
@@ -32,30 +36,289 @@ func (nodeType NodeType) String() string {
 	}
 }
 
+// tie-breaking policy between paths of equal cost, so identical requests always result in the same path
+type TieBreakingPolicy int
+
+const (
+	TieBreakingLowestRouterId TieBreakingPolicy = iota
+	TieBreakingFewestHops
+	TieBreakingIncumbent
+)
+
+func getTieBreakingPolicy(name string) TieBreakingPolicy {
+	switch name {
+	case "fewest-hops":
+		return TieBreakingFewestHops
+	case "incumbent":
+		return TieBreakingIncumbent
+	default:
+		return TieBreakingLowestRouterId
+	}
+}
+
+// relative tolerance for costs which only differ by the rounding of the summation order
+const equalCostTolerance = 1e-9
+
+func isEqualCost(cost, otherCost float64) bool {
+	if math.IsInf(cost, 0) || math.IsInf(otherCost, 0) {
+		return cost == otherCost
+	}
+	return math.Abs(cost-otherCost) <= equalCostTolerance*math.Max(1, math.Abs(otherCost))
+}
+
+// the edges of a node are kept in a map, iterating them by ID makes the calculation independent of the map order
+func getSortedEdges(node graph.Node) []graph.Edge {
+	edges := make([]graph.Edge, 0, len(node.GetEdges()))
+	for _, edge := range node.GetEdges() {
+		edges = append(edges, edge)
+	}
+	slices.SortFunc(edges, func(first, second graph.Edge) int {
+		return cmp.Compare(first.GetId(), second.GetId())
+	})
+	return edges
+}
+
+// calculations which prefer the current path of a session among paths of equal cost
+type incumbentPathCalculation interface {
+	setIncumbentPath(graph.Path)
+}
+
 type Calculation interface {
 	Execute() (graph.Path, error)
 }
 
 type BaseCalculation struct {
-	log             *logrus.Entry
-	graph           graph.Graph
-	source          graph.Node
-	destination     graph.Node
-	weightKeys      []helper.WeightKey
-	calculationMode CalculationMode
-	maxConstraints  map[helper.WeightKey]float64
-	minConstraints  map[helper.WeightKey]float64
+	log                 *logrus.Entry
+	graph               graph.Graph
+	source              graph.Node
+	destination         graph.Node
+	weightKeys          []helper.WeightKey
+	calculationMode     CalculationMode
+	maxConstraints      map[helper.WeightKey]float64
+	minConstraints      map[helper.WeightKey]float64
+	weights             []float64
+	topologyConstraints *TopologyConstraints
+	tieBreakingPolicy   TieBreakingPolicy
+	incumbentEdges      map[string]struct{}
 }
 
 func NewBaseCalculation(options *CalculationOptions) *BaseCalculation {
 	return &BaseCalculation{
-		log:             logging.DefaultLogger.WithField("subsystem", subsystem),
-		graph:           options.graph,
-		source:          options.sourceNode,
-		destination:     options.destinationNode,
-		weightKeys:      options.weightKeys,
-		calculationMode: options.calculationMode,
-		maxConstraints:  options.maxConstraints,
-		minConstraints:  options.minConstraints,
+		log:                 logging.DefaultLogger.WithField("subsystem", subsystem),
+		graph:               options.graph,
+		source:              options.sourceNode,
+		destination:         options.destinationNode,
+		weightKeys:          options.weightKeys,
+		calculationMode:     options.calculationMode,
+		maxConstraints:      options.maxConstraints,
+		minConstraints:      options.minConstraints,
+		weights:             options.weights,
+		topologyConstraints: options.topologyConstraints,
+		tieBreakingPolicy:   getTieBreakingPolicy(helper.TieBreakingPolicy),
+		incumbentEdges:      make(map[string]struct{}),
+	}
+}
+
+func (calculation *BaseCalculation) setIncumbentPath(path graph.Path) {
+	if calculation.tieBreakingPolicy != TieBreakingIncumbent {
+		return
+	}
+	for _, edge := range path.GetEdges() {
+		calculation.incumbentEdges[edge.GetId()] = struct{}{}
+	}
+}
+
+func getObjectiveKey(weightKey helper.WeightKey) helper.WeightKey {
+	switch weightKey {
+	case helper.LatencyKey, helper.NormalizedLatencyKey:
+		return helper.LatencyKey
+	case helper.JitterKey, helper.NormalizedJitterKey:
+		return helper.JitterKey
+	case helper.PacketLossKey, helper.NormalizedPacketLossKey:
+		return helper.PacketLossKey
+	case helper.AvailableBandwidthKey:
+		return helper.AvailableBandwidthKey
+	default:
+		return helper.UndefinedKey
+	}
+}
+
+func getPathObjectiveValue(path graph.Path, objective helper.WeightKey) float64 {
+	switch objective {
+	case helper.LatencyKey:
+		return path.GetTotalDelay()
+	case helper.JitterKey:
+		return path.GetTotalJitter()
+	case helper.PacketLossKey:
+		return path.GetTotalPacketLoss()
+	default:
+		// bandwidth is maximized, the negation keeps "smaller is better" for all objectives
+		return -path.GetBottleneckValue()
+	}
+}
+
+func getDefaultWeights(count int) []float64 {
+	if count == 2 && len(helper.TwoFactorWeights) == 2 {
+		return helper.TwoFactorWeights
+	}
+	if count == 3 && len(helper.ThreeFactorWeights) == 3 {
+		return helper.ThreeFactorWeights
+	}
+	weights := make([]float64, count)
+	for index := range weights {
+		weights[index] = 1 / float64(count)
+	}
+	return weights
+}
+
+func getWeightedEdgeValue(edge graph.Edge, weightKeys []helper.WeightKey, weights []float64) float64 {
+	if len(weightKeys) == 1 {
+		return edge.GetWeight(weightKeys[0])
+	}
+	if len(weights) != len(weightKeys) {
+		weights = getDefaultWeights(len(weightKeys))
+	}
+	weight := 0.0
+	for index, weightKey := range weightKeys {
+		weight += edge.GetWeight(weightKey) * weights[index]
+	}
+	return weight
+}
+
+func (calculation *BaseCalculation) getEdgeWeight(edge graph.Edge) float64 {
+	return getWeightedEdgeValue(edge, calculation.weightKeys, calculation.weights)
+}
+
+func (calculation *BaseCalculation) getEdgeCost(edge graph.Edge) float64 {
+	edgeWeight := calculation.getEdgeWeight(edge)
+	if calculation.calculationMode == CalculationModeSum && calculation.weightKeys[0] == helper.PacketLossKey {
+		return -math.Log(1 - edgeWeight/100)
+	}
+	return edgeWeight
+}
+
+func (calculation *BaseCalculation) getViolatedMaxConstraint(latency, jitter, packetLoss float64) (helper.WeightKey, bool) {
+	metrics := map[helper.WeightKey]float64{
+		helper.NormalizedLatencyKey:    latency,
+		helper.NormalizedJitterKey:     jitter,
+		helper.NormalizedPacketLossKey: packetLoss,
+	}
+	for key, value := range metrics {
+		if maxValue, ok := calculation.maxConstraints[key]; ok {
+			if maxValue < value {
+				return key, true
+			}
+		}
+	}
+	return helper.UndefinedKey, false
+}
+
+func (calculation *BaseCalculation) violatesMaxConstraints(edge graph.Edge, latency, jitter, packetLoss float64) bool {
+	if key, ok := calculation.getViolatedMaxConstraint(latency, jitter, packetLoss); ok {
+		calculation.log.Debugf("Edge from %s to %s violates %s constraint, returning", edge.From().GetName(), edge.To().GetName(), key)
+		return true
+	}
+	return false
+}
+
+// every hop adds the SID of the next node to the SID list, so the SID depth limits the hop count as well
+func (calculation *BaseCalculation) getMaxHopCount() (int, bool) {
+	maxHopCount, hopCountOk := calculation.maxConstraints[helper.HopCountKey]
+	maxSidDepth, sidDepthOk := calculation.maxConstraints[helper.SidDepthKey]
+	if sidDepthOk && (!hopCountOk || maxSidDepth < maxHopCount) {
+		maxHopCount = maxSidDepth
+	}
+	return int(maxHopCount), hopCountOk || sidDepthOk
+}
+
+func (calculation *BaseCalculation) isHopCountConstrained() bool {
+	_, ok := calculation.getMaxHopCount()
+	return ok
+}
+
+func (calculation *BaseCalculation) violatesHopCountConstraint(edge graph.Edge, hopCount int) bool {
+	if maxHopCount, ok := calculation.getMaxHopCount(); ok && hopCount > maxHopCount {
+		calculation.log.Debugf("Edge from %s to %s exceeds the maximum of %d hops, returning", edge.From().GetName(), edge.To().GetName(), maxHopCount)
+		return true
+	}
+	return false
+}
+
+func (calculation *BaseCalculation) violatesBandwidthMinConstraint(edge graph.Edge) bool {
+	if minValue, ok := calculation.minConstraints[helper.AvailableBandwidthKey]; ok {
+		bandwidth := edge.GetWeight(helper.AvailableBandwidthKey)
+		if minValue > bandwidth {
+			calculation.log.Debugf("Edge from %s to %s violates %s constraint, returning", edge.From().GetName(), edge.To().GetName(), helper.AvailableBandwidthKey)
+			return true
+		}
+	}
+	return false
+}
+
+func (calculation *BaseCalculation) isBetterCost(cost, otherCost float64) bool {
+	if calculation.calculationMode == CalculationModeMax {
+		return cost > otherCost
+	}
+	return cost < otherCost
+}
+
+// the lowest router ID prefers the edge from the router with the lower ID and among parallel links the lower link ID
+func hasLowerRouterId(edge, otherEdge graph.Edge) bool {
+	if edge.From().GetId() != otherEdge.From().GetId() {
+		return edge.From().GetId() < otherEdge.From().GetId()
+	}
+	return edge.GetId() < otherEdge.GetId()
+}
+
+// decides whether the edge replaces the current edge to a node reached with the same cost,
+// the fewest hops and the incumbent policy fall back to the lowest router ID if they do not decide
+func (calculation *BaseCalculation) isPreferredOnTie(edge, currentEdge graph.Edge, hopCount, currentHopCount int) bool {
+	if currentEdge == nil {
+		return false
+	}
+	switch calculation.tieBreakingPolicy {
+	case TieBreakingFewestHops:
+		if hopCount != currentHopCount {
+			return hopCount < currentHopCount
+		}
+	case TieBreakingIncumbent:
+		_, isIncumbent := calculation.incumbentEdges[edge.GetId()]
+		_, isCurrentIncumbent := calculation.incumbentEdges[currentEdge.GetId()]
+		if isIncumbent != isCurrentIncumbent {
+			return isIncumbent
+		}
+	}
+	return hasLowerRouterId(edge, currentEdge)
+}
+
+func (calculation *BaseCalculation) isBetterCandidate(cost, currentCost float64, edge, currentEdge graph.Edge, hopCount, currentHopCount int) bool {
+	if isEqualCost(cost, currentCost) {
+		return calculation.isPreferredOnTie(edge, currentEdge, hopCount, currentHopCount)
+	}
+	return calculation.isBetterCost(cost, currentCost)
+}
+
+func (calculation *BaseCalculation) createPathFromEdges(edges []graph.Edge) graph.Path {
+	totalCost := 0.0
+	if calculation.calculationMode != CalculationModeSum {
+		totalCost = math.Inf(1)
+	}
+	latency, jitter, packetLoss := 0.0, 0.0, 0.0
+	bottleneckValue := math.Inf(1)
+	var bottleneckEdge graph.Edge
+	for _, edge := range edges {
+		if calculation.calculationMode == CalculationModeSum {
+			totalCost += calculation.getEdgeCost(edge)
+		} else {
+			totalCost = math.Min(totalCost, calculation.getEdgeCost(edge))
+		}
+		latency += edge.GetWeight(helper.LatencyKey)
+		jitter += edge.GetWeight(helper.JitterKey)
+		packetLoss = 1 - ((1 - packetLoss) * (1 - edge.GetWeight(helper.PacketLossKey)/100))
+		if edge.GetWeight(helper.AvailableBandwidthKey) < bottleneckValue {
+			bottleneckValue = edge.GetWeight(helper.AvailableBandwidthKey)
+			bottleneckEdge = edge
+		}
 	}
+	return graph.NewShortestPath(edges, totalCost, latency, jitter, packetLoss, bottleneckValue, bottleneckEdge)
 }
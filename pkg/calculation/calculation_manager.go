@@ -1,15 +1,22 @@
 package calculation
 
 import (
+	"errors"
 	"fmt"
+	"math"
 
 	"github.com/hawkv6/hawkeye/pkg/cache"
 	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/hawkv6/hawkeye/pkg/logging"
 	"github.com/sirupsen/logrus"
 )
 
+// returned when the path request can not be placed because of the resources used by the active sessions, i.e. there is
+// only a path with the measured bandwidth instead of the bandwidth left by the reservations or the service instances reached their session cap
+var ErrInsufficientCapacity = errors.New("insufficient capacity")
+
 type CalculationManager struct {
 	log                    *logrus.Entry
 	cache                  cache.Cache
@@ -19,6 +26,10 @@ type CalculationManager struct {
 	calculationUpdater     CalculationUpdater
 	calculation            Calculation
 	algorithm              uint32
+	includedServices       []string
+	sourceNode             graph.Node
+	// current path of the session during a path update, preferred among paths of equal cost
+	incumbentPath graph.Path
 }
 
 func NewCalculationManager(cache cache.Cache, graph graph.Graph, calculationSetup CalculationSetup, calcultionTransformer CalculationTransformer, calculationUpdater CalculationUpdater) *CalculationManager {
@@ -61,17 +72,57 @@ func (manager *CalculationManager) setUpCalculation(pathRequest domain.PathReque
 
 	intents := pathRequest.GetIntents()
 	calculationOptions.graph, manager.algorithm = manager.getGraphAndAlgorithm(manager.graph, manager.getFirstNonSfcIntent(intents))
+	manager.includedServices = nil
+	manager.sourceNode = calculationOptions.sourceNode
 
 	firstIntent := intents[0]
 	switch firstIntent.GetIntentType() {
 	case domain.IntentTypeSFC:
 		return manager.setupServiceFunctionChainCalculation(firstIntent, calculationOptions)
 	default:
-		manager.calculation = NewShortestPathCalculation(calculationOptions)
+		manager.setupShortestPathCalculation(pathRequest, calculationOptions)
 	}
 	return nil
 }
 
+func (manager *CalculationManager) setupShortestPathCalculation(pathRequest domain.PathRequest, calculationOptions *CalculationOptions) {
+	alternativePathCount := pathRequest.GetAlternativePathCount()
+	disjointnessType := pathRequest.GetDisjointnessType()
+	selectionPolicy := pathRequest.GetSelectionPolicy()
+	if selectionPolicy != domain.SelectionPolicyNone {
+		manager.log.Debugf("Calculating the Pareto front with %s selection", selectionPolicy)
+		manager.calculation = NewParetoPathCalculation(calculationOptions, selectionPolicy)
+	} else if alternativePathCount > 0 {
+		manager.log.Debugf("Calculating the best path and %d alternative paths", alternativePathCount)
+		manager.calculation = NewKShortestPathCalculation(calculationOptions, int(alternativePathCount)+1)
+	} else if disjointnessType != domain.DisjointnessTypeNone {
+		manager.log.Debugf("Calculating %s-disjoint primary and backup path", disjointnessType)
+		manager.calculation = NewDisjointPathCalculation(calculationOptions, disjointnessType)
+	} else if tolerances := manager.calculationSetup.GetLexicographicTolerances(pathRequest.GetIntents()); tolerances != nil {
+		manager.log.Debugf("Calculating the lexicographically best path with tolerances %v", tolerances)
+		manager.calculation = NewLexicographicPathCalculation(calculationOptions, tolerances)
+	} else if manager.useConstrainedPathCalculation(pathRequest.GetPathAlgorithm(), calculationOptions) {
+		manager.log.Debugln("Calculating the shortest path with exact constrained path search")
+		manager.calculation = NewConstrainedShortestPathCalculation(calculationOptions)
+	} else {
+		manager.calculation = NewShortestPathCalculation(calculationOptions)
+	}
+}
+
+func (manager *CalculationManager) useConstrainedPathCalculation(pathAlgorithm domain.PathAlgorithm, calculationOptions *CalculationOptions) bool {
+	if calculationOptions.calculationMode != CalculationModeSum {
+		return false
+	}
+	switch pathAlgorithm {
+	case domain.PathAlgorithmConstrained:
+		return true
+	case domain.PathAlgorithmDijkstra:
+		return false
+	default:
+		return helper.ExactConstrainedPathSearch && len(calculationOptions.maxConstraints) > 0
+	}
+}
+
 func (manager *CalculationManager) getFirstNonSfcIntent(intents []domain.Intent) domain.Intent {
 	if len(intents) > 1 && intents[0].GetIntentType() == domain.IntentTypeSFC {
 		return intents[1]
@@ -85,23 +136,109 @@ func (manager *CalculationManager) setupServiceFunctionChainCalculation(intent d
 		return fmt.Errorf("error setting up service function chain: %w", err)
 	}
 	manager.calculation = NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions)
+	manager.includedServices = sfcCalculationOptions.services
 	return nil
 }
 
+// the bandwidth reserved by the active sessions is subtracted from the available bandwidth of the links for the
+// duration of the calculation, the returned function restores the measured values
+func (manager *CalculationManager) applyBandwidthReservations() func() {
+	availableBandwidths := make(map[graph.Edge]float64)
+	for edgeId, reservedBandwidth := range manager.cache.GetBandwidthReservations() {
+		edge := manager.graph.GetEdge(edgeId)
+		if edge == nil {
+			continue
+		}
+		availableBandwidth := edge.GetWeight(helper.AvailableBandwidthKey)
+		availableBandwidths[edge] = availableBandwidth
+		edge.SetWeight(helper.AvailableBandwidthKey, math.Max(0, availableBandwidth-reservedBandwidth))
+	}
+	return func() {
+		for edge, availableBandwidth := range availableBandwidths {
+			edge.SetWeight(helper.AvailableBandwidthKey, availableBandwidth)
+		}
+	}
+}
+
+// a path request with a bandwidth demand which fails only because of the reservations of the active sessions is
+// calculated again with the measured bandwidth, so the caller can tell a lack of capacity from a request without any path
 func (manager *CalculationManager) CalculateBestPath(pathRequest domain.PathRequest) (domain.PathResult, error) {
 	manager.lockElements()
 	defer manager.unlockElements()
+	restoreAvailableBandwidths := manager.applyBandwidthReservations()
+	pathResult, err := manager.calculateBestPath(pathRequest)
+	restoreAvailableBandwidths()
+	if err == nil || errors.Is(err, ErrInsufficientCapacity) || pathRequest.GetBandwidthDemand() == 0 || len(manager.cache.GetBandwidthReservations()) == 0 {
+		return pathResult, err
+	}
+	if _, unreservedErr := manager.calculateBestPath(pathRequest); unreservedErr != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: %w", ErrInsufficientCapacity, err)
+}
 
+func (manager *CalculationManager) calculateBestPath(pathRequest domain.PathRequest) (domain.PathResult, error) {
 	err := manager.setUpCalculation(pathRequest)
 	if err != nil {
 		return nil, err
 	}
+	if calculation, ok := manager.calculation.(incumbentPathCalculation); ok && manager.incumbentPath != nil {
+		calculation.setIncumbentPath(manager.incumbentPath)
+	}
 
 	path, err := manager.calculation.Execute()
 	if err != nil {
 		return nil, err
 	}
-	return manager.calculationTransformer.TransformResult(path, pathRequest, manager.algorithm), nil
+	pathResult := manager.calculationTransformer.TransformResult(path, pathRequest, manager.algorithm)
+	if err := manager.enforceMaxSidDepth(pathRequest, pathResult); err != nil {
+		return nil, err
+	}
+	if pathResult != nil && manager.includedServices != nil {
+		pathResult.SetIncludedServices(manager.includedServices)
+	}
+	return pathResult, nil
+}
+
+func (manager *CalculationManager) exceedsMaxSidDepth(pathResult domain.PathResult, maxSidDepth uint32) bool {
+	return len(pathResult.GetIpv6SidAddresses()) > int(maxSidDepth)
+}
+
+func (manager *CalculationManager) getPathResultsWithinMaxSidDepth(pathResults []domain.PathResult, maxSidDepth uint32) []domain.PathResult {
+	if pathResults == nil {
+		return nil
+	}
+	remainingPathResults := make([]domain.PathResult, 0, len(pathResults))
+	for _, pathResult := range pathResults {
+		if manager.exceedsMaxSidDepth(pathResult, maxSidDepth) {
+			manager.log.Debugf("Path with %d SIDs exceeds the maximum SID depth of %d, dropping it", len(pathResult.GetIpv6SidAddresses()), maxSidDepth)
+			continue
+		}
+		remainingPathResults = append(remainingPathResults, pathResult)
+	}
+	return remainingPathResults
+}
+
+// the search limits the hop count only, a path can still need one SID more than hops, e.g. an End.X SID
+// followed by the node SID of the destination for a parallel last hop, so the final SID lists are checked as well
+func (manager *CalculationManager) enforceMaxSidDepth(pathRequest domain.PathRequest, pathResult domain.PathResult) error {
+	if pathResult == nil || manager.sourceNode == nil {
+		return nil
+	}
+	maxSidDepth := getMaxSidDepth(manager.cache, pathRequest, manager.sourceNode)
+	if maxSidDepth == 0 {
+		return nil
+	}
+	if manager.exceedsMaxSidDepth(pathResult, maxSidDepth) {
+		return fmt.Errorf("Path requires %d SIDs, which exceeds the maximum SID depth of %d", len(pathResult.GetIpv6SidAddresses()), maxSidDepth)
+	}
+	pathResult.SetAlternativePathResults(manager.getPathResultsWithinMaxSidDepth(pathResult.GetAlternativePathResults(), maxSidDepth))
+	pathResult.SetParetoPathResults(manager.getPathResultsWithinMaxSidDepth(pathResult.GetParetoPathResults(), maxSidDepth))
+	if backupPathResult := pathResult.GetBackupPathResult(); backupPathResult != nil && manager.exceedsMaxSidDepth(backupPathResult, maxSidDepth) {
+		manager.log.Warnf("Backup path exceeds the maximum SID depth of %d, dropping it", maxSidDepth)
+		pathResult.SetBackupPathResult(nil)
+	}
+	return nil
 }
 
 func (manager *CalculationManager) getCalculationUpdateOptions(streamSession domain.StreamSession) *CalculationUpdateOptions {
@@ -115,14 +252,98 @@ func (manager *CalculationManager) getCalculationUpdateOptions(streamSession dom
 		currentPathResult:     currentPathResult,
 		currentAppliedSidList: currentAppliedSidList,
 		weightKeys:            weightKeys,
+		weights:               manager.calculationSetup.GetWeights(intents),
 		calculationMode:       calculationMode,
+		tolerances:            manager.calculationSetup.GetLexicographicTolerances(intents),
 		pathRequest:           pathRequest,
 	}
 }
+
+// every session counts once on each service instance of its current path
+func (manager *CalculationManager) AddServiceSessions(pathResult domain.PathResult) {
+	manager.cache.Lock()
+	defer manager.cache.Unlock()
+	manager.addServiceSessions(pathResult)
+}
+
+func (manager *CalculationManager) addServiceSessions(pathResult domain.PathResult) {
+	for _, sid := range pathResult.GetServiceSidList() {
+		manager.cache.AddServiceSession(sid)
+	}
+}
+
+func (manager *CalculationManager) RemoveServiceSessions(pathResult domain.PathResult) {
+	manager.cache.Lock()
+	defer manager.cache.Unlock()
+	manager.removeServiceSessions(pathResult)
+}
+
+func (manager *CalculationManager) removeServiceSessions(pathResult domain.PathResult) {
+	for _, sid := range pathResult.GetServiceSidList() {
+		manager.cache.RemoveServiceSession(sid)
+	}
+}
+
+// every session with a bandwidth demand reserves it on each link of its current path
+func (manager *CalculationManager) ReserveBandwidth(pathResult domain.PathResult) {
+	bandwidthDemand := float64(pathResult.GetBandwidthDemand())
+	if bandwidthDemand == 0 {
+		return
+	}
+	manager.cache.Lock()
+	defer manager.cache.Unlock()
+	manager.reserveBandwidth(pathResult, bandwidthDemand)
+}
+
+func (manager *CalculationManager) reserveBandwidth(pathResult domain.PathResult, bandwidthDemand float64) {
+	if bandwidthDemand == 0 {
+		return
+	}
+	for _, edge := range pathResult.GetEdges() {
+		manager.cache.AddBandwidthReservation(edge.GetId(), bandwidthDemand)
+	}
+}
+
+func (manager *CalculationManager) ReleaseBandwidth(pathResult domain.PathResult) {
+	bandwidthDemand := float64(pathResult.GetBandwidthDemand())
+	if bandwidthDemand == 0 {
+		return
+	}
+	manager.cache.Lock()
+	defer manager.cache.Unlock()
+	manager.releaseBandwidth(pathResult, bandwidthDemand)
+}
+
+func (manager *CalculationManager) releaseBandwidth(pathResult domain.PathResult, bandwidthDemand float64) {
+	if bandwidthDemand == 0 {
+		return
+	}
+	for _, edge := range pathResult.GetEdges() {
+		manager.cache.RemoveBandwidthReservation(edge.GetId(), bandwidthDemand)
+	}
+}
+
+// the sessions and the bandwidth reservation of the current path are removed during the recalculation, so the session
+// does not compete with itself for the service instances and the bandwidth it already uses. The update is evaluated
+// while the reservations of the other sessions are applied, so the current and the new path are compared on the same bandwidth.
+// Releasing, recalculating and reserving again happen while the cache and the graph are locked, so sessions recalculated
+// concurrently never see the resources of another session as free.
 func (manager *CalculationManager) CalculatePathUpdate(streamSession domain.StreamSession) (domain.PathResult, error) {
 	calculationUpdateOptions := manager.getCalculationUpdateOptions(streamSession)
 	manager.log.Debugln("Recalculate path with new network state")
-	newPathResult, err := manager.CalculateBestPath(calculationUpdateOptions.pathRequest)
+	manager.lockElements()
+	defer manager.unlockElements()
+	manager.removeServiceSessions(calculationUpdateOptions.currentPathResult)
+	manager.releaseBandwidth(calculationUpdateOptions.currentPathResult, float64(calculationUpdateOptions.currentPathResult.GetBandwidthDemand()))
+	defer func() {
+		manager.addServiceSessions(streamSession.GetPathResult())
+		manager.reserveBandwidth(streamSession.GetPathResult(), float64(streamSession.GetPathResult().GetBandwidthDemand()))
+	}()
+	restoreAvailableBandwidths := manager.applyBandwidthReservations()
+	defer restoreAvailableBandwidths()
+	manager.incumbentPath = calculationUpdateOptions.currentPathResult
+	defer func() { manager.incumbentPath = nil }()
+	newPathResult, err := manager.calculateBestPath(calculationUpdateOptions.pathRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package calculation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -200,6 +201,9 @@ func TestCalculationManager_setUpCalculation(t *testing.T) {
 				if tt.firstIntentType == domain.IntentTypeFlexAlgo {
 					graphMock.EXPECT().GetSubGraph(gomock.Any()).Return(graphMock)
 				}
+				if tt.firstIntentType != domain.IntentTypeSFC {
+					calculationSetup.EXPECT().GetLexicographicTolerances(intents).Return(nil)
+				}
 				err := manager.setUpCalculation(pathRequest)
 				assert.NoError(t, err)
 				assert.Equal(t, uint32(tt.algorithm), manager.algorithm)
@@ -208,6 +212,153 @@ func TestCalculationManager_setUpCalculation(t *testing.T) {
 	}
 }
 
+func TestCalculationManager_setupShortestPathCalculation(t *testing.T) {
+	tests := []struct {
+		name                 string
+		alternativePathCount uint32
+		disjointnessType     domain.DisjointnessType
+		pathAlgorithm        domain.PathAlgorithm
+		maxConstraints       map[helper.WeightKey]float64
+		selectionPolicy      domain.SelectionPolicy
+		tolerances           []float64
+		wantConstrained      bool
+	}{
+		{
+			name:                 "TestCalculationManager_setupShortestPathCalculation without alternative paths",
+			alternativePathCount: 0,
+		},
+		{
+			name:                 "TestCalculationManager_setupShortestPathCalculation with alternative paths",
+			alternativePathCount: 2,
+		},
+		{
+			name:             "TestCalculationManager_setupShortestPathCalculation with disjoint paths",
+			disjointnessType: domain.DisjointnessTypeNode,
+		},
+		{
+			name:            "TestCalculationManager_setupShortestPathCalculation constrained path algorithm",
+			pathAlgorithm:   domain.PathAlgorithmConstrained,
+			wantConstrained: true,
+		},
+		{
+			name:            "TestCalculationManager_setupShortestPathCalculation default with max constraints",
+			maxConstraints:  map[helper.WeightKey]float64{helper.NormalizedLatencyKey: 1000},
+			wantConstrained: true,
+		},
+		{
+			name:           "TestCalculationManager_setupShortestPathCalculation dijkstra with max constraints",
+			pathAlgorithm:  domain.PathAlgorithmDijkstra,
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedLatencyKey: 1000},
+		},
+		{
+			name:            "TestCalculationManager_setupShortestPathCalculation with pareto front",
+			selectionPolicy: domain.SelectionPolicyKnee,
+		},
+		{
+			name:       "TestCalculationManager_setupShortestPathCalculation with lexicographic intent ordering",
+			tolerances: []float64{0.1, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			calculationSetup := NewMockCalculationSetup(controller)
+			manager := NewCalculationManager(cache.NewMockCache(controller), graph.NewMockGraph(controller), calculationSetup, NewMockCalculationTransformer(controller), NewMockCalculationUpdater(controller))
+			pathRequest := domain.NewMockPathRequest(controller)
+			pathRequest.EXPECT().GetIntents().Return([]domain.Intent{}).AnyTimes()
+			calculationSetup.EXPECT().GetLexicographicTolerances(gomock.Any()).Return(tt.tolerances).AnyTimes()
+			pathRequest.EXPECT().GetAlternativePathCount().Return(tt.alternativePathCount)
+			pathRequest.EXPECT().GetDisjointnessType().Return(tt.disjointnessType)
+			pathRequest.EXPECT().GetPathAlgorithm().Return(tt.pathAlgorithm).AnyTimes()
+			pathRequest.EXPECT().GetSelectionPolicy().Return(tt.selectionPolicy)
+			manager.setupShortestPathCalculation(pathRequest, &CalculationOptions{calculationMode: CalculationModeSum, maxConstraints: tt.maxConstraints})
+			if tt.selectionPolicy != domain.SelectionPolicyNone {
+				calculation, ok := manager.calculation.(*ParetoPathCalculation)
+				assert.True(t, ok)
+				assert.Equal(t, tt.selectionPolicy, calculation.selectionPolicy)
+			} else if tt.alternativePathCount > 0 {
+				calculation, ok := manager.calculation.(*KShortestPathCalculation)
+				assert.True(t, ok)
+				assert.Equal(t, int(tt.alternativePathCount)+1, calculation.numberOfPaths)
+			} else if tt.disjointnessType != domain.DisjointnessTypeNone {
+				calculation, ok := manager.calculation.(*DisjointPathCalculation)
+				assert.True(t, ok)
+				assert.Equal(t, tt.disjointnessType, calculation.disjointnessType)
+			} else if tt.tolerances != nil {
+				calculation, ok := manager.calculation.(*LexicographicPathCalculation)
+				assert.True(t, ok)
+				assert.Equal(t, tt.tolerances, calculation.tolerances)
+			} else if tt.wantConstrained {
+				_, ok := manager.calculation.(*ConstrainedShortestPathCalculation)
+				assert.True(t, ok)
+			} else {
+				_, ok := manager.calculation.(*ShortestPathCalculation)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestCalculationManager_useConstrainedPathCalculation(t *testing.T) {
+	tests := []struct {
+		name                string
+		pathAlgorithm       domain.PathAlgorithm
+		calculationMode     CalculationMode
+		maxConstraints      map[helper.WeightKey]float64
+		topologyConstraints *TopologyConstraints
+		want                bool
+	}{
+		{
+			name:            "TestCalculationManager_useConstrainedPathCalculation default without max constraints",
+			pathAlgorithm:   domain.PathAlgorithmDefault,
+			calculationMode: CalculationModeSum,
+			want:            false,
+		},
+		{
+			name:            "TestCalculationManager_useConstrainedPathCalculation default with max constraints",
+			pathAlgorithm:   domain.PathAlgorithmDefault,
+			calculationMode: CalculationModeSum,
+			maxConstraints:  map[helper.WeightKey]float64{helper.NormalizedJitterKey: 100},
+			want:            true,
+		},
+		{
+			name:            "TestCalculationManager_useConstrainedPathCalculation dijkstra with max constraints",
+			pathAlgorithm:   domain.PathAlgorithmDijkstra,
+			calculationMode: CalculationModeSum,
+			maxConstraints:  map[helper.WeightKey]float64{helper.NormalizedJitterKey: 100},
+			want:            false,
+		},
+		{
+			name:            "TestCalculationManager_useConstrainedPathCalculation constrained without max constraints",
+			pathAlgorithm:   domain.PathAlgorithmConstrained,
+			calculationMode: CalculationModeSum,
+			want:            true,
+		},
+		{
+			name:            "TestCalculationManager_useConstrainedPathCalculation constrained high bandwidth",
+			pathAlgorithm:   domain.PathAlgorithmConstrained,
+			calculationMode: CalculationModeMax,
+			want:            false,
+		},
+		{
+			name:                "TestCalculationManager_useConstrainedPathCalculation default with max and topology constraints",
+			pathAlgorithm:       domain.PathAlgorithmDefault,
+			calculationMode:     CalculationModeSum,
+			maxConstraints:      map[helper.WeightKey]float64{helper.NormalizedJitterKey: 100},
+			topologyConstraints: NewTopologyConstraints(),
+			want:                true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			manager := NewCalculationManager(cache.NewMockCache(controller), graph.NewMockGraph(controller), NewMockCalculationSetup(controller), NewMockCalculationTransformer(controller), NewMockCalculationUpdater(controller))
+			calculationOptions := &CalculationOptions{calculationMode: tt.calculationMode, maxConstraints: tt.maxConstraints, topologyConstraints: tt.topologyConstraints}
+			assert.Equal(t, tt.want, manager.useConstrainedPathCalculation(tt.pathAlgorithm, calculationOptions))
+		})
+	}
+}
+
 func TestCalculationManager_getFirstNonSfcIntent(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -375,6 +526,7 @@ func TestCalculationManager_CalculateBestPath(t *testing.T) {
 			graphMock.EXPECT().Unlock().Return().AnyTimes()
 			cacheMock.EXPECT().Lock().Return().AnyTimes()
 			cacheMock.EXPECT().Unlock().Return().AnyTimes()
+			cacheMock.EXPECT().GetBandwidthReservations().Return(map[string]float64{}).AnyTimes()
 
 			if tt.wantSetupErr {
 				calculationSetup.EXPECT().PerformSetup(pathRequest).Return(nil, fmt.Errorf("setup failed"))
@@ -394,6 +546,7 @@ func TestCalculationManager_CalculateBestPath(t *testing.T) {
 					calculationMode: CalculationModeSum,
 				}
 				calculationSetup.EXPECT().PerformSetup(pathRequest).Return(calculationOptions, nil)
+				calculationSetup.EXPECT().GetLexicographicTolerances(intents).Return(nil)
 				_, err = manager.CalculateBestPath(pathRequest)
 				assert.Error(t, err)
 			} else {
@@ -408,6 +561,7 @@ func TestCalculationManager_CalculateBestPath(t *testing.T) {
 					calculationMode: CalculationModeSum,
 				}
 				calculationSetup.EXPECT().PerformSetup(pathRequest).Return(calculationOptions, nil)
+				calculationSetup.EXPECT().GetLexicographicTolerances(intents).Return(nil)
 				calculationTransformer.EXPECT().TransformResult(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				_, err = manager.CalculateBestPath(pathRequest)
 				assert.NoError(t, err)
@@ -416,6 +570,152 @@ func TestCalculationManager_CalculateBestPath(t *testing.T) {
 	}
 }
 
+func TestCalculationManager_CalculateBestPath_MaxSidDepth(t *testing.T) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+	}
+	//  [1]--1-->[2]==2/3==>[3]
+	// the last hop is one of two parallel links with the same IGP metric and needs an End.X SID
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.IgpMetricKey: 10}),
+		2: graph.NewNetworkEdge("2", nodes[2], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.IgpMetricKey: 10}),
+		3: graph.NewNetworkEdge("3", nodes[2], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 2000, helper.IgpMetricKey: 10}),
+	}
+	tests := []struct {
+		name        string
+		maxSidDepth uint32
+		wantSidList []string
+		wantErr     bool
+	}{
+		{
+			name:        "Test CalculateBestPath parallel last hop at the maximum SID depth",
+			maxSidDepth: 2,
+			wantErr:     true,
+		},
+		{
+			name:        "Test CalculateBestPath parallel last hop within the maximum SID depth",
+			maxSidDepth: 3,
+			wantSidList: []string{"fc00:0:2::", "fc00:0:2:e002::", "fc00:0:3::"},
+		},
+		{
+			name:        "Test CalculateBestPath parallel last hop without maximum SID depth",
+			wantSidList: []string{"fc00:0:2::", "fc00:0:2:e002::", "fc00:0:3::"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			inMemoryCache := cache.NewInMemoryCache()
+			inMemoryCache.StoreSid(setUpMicroSid("2", "fc00:0:2::", 0, 0))
+			inMemoryCache.StoreSid(setUpMicroSid("3", "fc00:0:3::", 0, 0))
+			inMemoryCache.StoreAdjacencySids("2", map[uint32]string{0: "fc00:0:2:e002::"})
+			calculationSetup := NewMockCalculationSetup(controller)
+			calculationTransformer := NewCalculationTransformerService(inMemoryCache, networkGraph)
+			calculationTransformer.minimizeSidList = false
+			manager := NewCalculationManager(inMemoryCache, networkGraph, calculationSetup, calculationTransformer, NewMockCalculationUpdater(controller))
+			intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+			pathRequest, err := domain.NewDomainPathRequest("2001:db8::1", "2001:db8::3", intents, api.NewMockIntentController_GetIntentPathServer(controller), context.Background())
+			assert.NoError(t, err)
+			pathRequest.SetMaxSidDepth(tt.maxSidDepth)
+			maxConstraints := map[helper.WeightKey]float64{}
+			if tt.maxSidDepth > 0 {
+				maxConstraints[helper.SidDepthKey] = float64(tt.maxSidDepth)
+			}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[3], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, maxConstraints, map[helper.WeightKey]float64{}, nil, nil}
+			calculationSetup.EXPECT().PerformSetup(pathRequest).Return(calculationOptions, nil)
+			calculationSetup.EXPECT().GetLexicographicTolerances(intents).Return(nil)
+			pathResult, err := manager.CalculateBestPath(pathRequest)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "maximum SID depth")
+				assert.Nil(t, pathResult)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSidList, pathResult.GetIpv6SidAddresses())
+		})
+	}
+}
+
+func TestCalculationManager_CalculateBestPath_InsufficientBandwidth(t *testing.T) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+	}
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 10000}),
+	}
+	tests := []struct {
+		name                      string
+		reservedBandwidth         float64
+		bandwidthDemand           uint32
+		wantErr                   bool
+		wantInsufficientBandwidth bool
+	}{
+		{
+			name:              "Test CalculateBestPath with bandwidth left by the reservations",
+			reservedBandwidth: 4000,
+			bandwidthDemand:   5000,
+		},
+		{
+			name:                      "Test CalculateBestPath with bandwidth used by the reservations",
+			reservedBandwidth:         6000,
+			bandwidthDemand:           5000,
+			wantErr:                   true,
+			wantInsufficientBandwidth: true,
+		},
+		{
+			name:            "Test CalculateBestPath with bandwidth demand above the measured bandwidth",
+			bandwidthDemand: 20000,
+			wantErr:         true,
+		},
+		{
+			name:              "Test CalculateBestPath with reservations and bandwidth demand above the measured bandwidth",
+			reservedBandwidth: 6000,
+			bandwidthDemand:   20000,
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			inMemoryCache := cache.NewInMemoryCache()
+			inMemoryCache.StoreSid(setUpMicroSid("2", "fc00:0:2::", 0, 0))
+			if tt.reservedBandwidth > 0 {
+				inMemoryCache.AddBandwidthReservation("1", tt.reservedBandwidth)
+			}
+			calculationSetup := NewMockCalculationSetup(controller)
+			manager := NewCalculationManager(inMemoryCache, networkGraph, calculationSetup, NewCalculationTransformerService(inMemoryCache, networkGraph), NewMockCalculationUpdater(controller))
+			intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+			pathRequest, err := domain.NewDomainPathRequest("2001:db8::1", "2001:db8::2", intents, api.NewMockIntentController_GetIntentPathServer(controller), context.Background())
+			assert.NoError(t, err)
+			pathRequest.SetBandwidthDemand(tt.bandwidthDemand)
+			calculationSetup.EXPECT().PerformSetup(pathRequest).DoAndReturn(func(domain.PathRequest) (*CalculationOptions, error) {
+				minConstraints := map[helper.WeightKey]float64{helper.AvailableBandwidthKey: float64(tt.bandwidthDemand)}
+				return &CalculationOptions{networkGraph, nodes[1], nodes[2], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, minConstraints, nil, nil}, nil
+			}).AnyTimes()
+			calculationSetup.EXPECT().GetLexicographicTolerances(intents).Return(nil).AnyTimes()
+			pathResult, err := manager.CalculateBestPath(pathRequest)
+			assert.Equal(t, float64(10000), networkGraph.GetEdge("1").GetWeight(helper.AvailableBandwidthKey))
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				assert.NotNil(t, pathResult)
+				return
+			}
+			assert.Error(t, err)
+			assert.Nil(t, pathResult)
+			assert.Equal(t, tt.wantInsufficientBandwidth, errors.Is(err, ErrInsufficientCapacity))
+		})
+	}
+}
+
 func TestCalculationManager_getCalculationUpdateOptinos(t *testing.T) {
 	tests := []struct {
 		name string
@@ -439,11 +739,15 @@ func TestCalculationManager_getCalculationUpdateOptinos(t *testing.T) {
 			currentPathResult.EXPECT().GetIpv6SidAddresses().Return([]string{"2001:db8::1", "2001:db8::2"})
 			pathRequest.EXPECT().GetIntents().Return([]domain.Intent{})
 			calculationSetup.EXPECT().GetWeightKeysandCalculationMode(gomock.Any()).Return([]helper.WeightKey{}, CalculationModeSum)
+			calculationSetup.EXPECT().GetLexicographicTolerances(gomock.Any()).Return([]float64{0.1})
+			calculationSetup.EXPECT().GetWeights(gomock.Any()).Return([]float64{1})
 			calculationUpdateOptions := manager.getCalculationUpdateOptions(streamSession)
 			assert.NotNil(t, calculationUpdateOptions)
 			assert.Equal(t, []string{"2001:db8::1", "2001:db8::2"}, calculationUpdateOptions.currentAppliedSidList)
 			assert.Equal(t, []helper.WeightKey{}, calculationUpdateOptions.weightKeys)
 			assert.Equal(t, CalculationModeSum, calculationUpdateOptions.calculationMode)
+			assert.Equal(t, []float64{0.1}, calculationUpdateOptions.tolerances)
+			assert.Equal(t, []float64{1}, calculationUpdateOptions.weights)
 			assert.Equal(t, pathRequest, calculationUpdateOptions.pathRequest)
 			assert.Equal(t, currentPathResult, calculationUpdateOptions.currentPathResult)
 		})
@@ -510,6 +814,7 @@ func TestCalculationManager_CalculatePathUpdate(t *testing.T) {
 			calculationUpdater := NewMockCalculationUpdater(controller)
 			cacheMock.EXPECT().Lock().Return().AnyTimes()
 			cacheMock.EXPECT().Unlock().Return().AnyTimes()
+			cacheMock.EXPECT().GetBandwidthReservations().Return(map[string]float64{}).AnyTimes()
 			network, err := setupGraph(nodes, edges)
 			assert.Nil(t, err)
 			manager := NewCalculationManager(cacheMock, network, calculationSetup, calculationTransformer, calculationUpdater)
@@ -530,6 +835,8 @@ func TestCalculationManager_CalculatePathUpdate(t *testing.T) {
 			pathResult, err := domain.NewDomainPathResult(pathRequest, path, []string{"2001:db8::1", "2001:db8::2"})
 			assert.NoError(t, err)
 			calculationSetup.EXPECT().GetWeightKeysandCalculationMode(gomock.Any()).Return(weightKeys, calculationMode)
+			calculationSetup.EXPECT().GetLexicographicTolerances(gomock.Any()).Return(nil).AnyTimes()
+			calculationSetup.EXPECT().GetWeights(gomock.Any()).Return([]float64{1})
 			streamSession := domain.NewDomainStreamSession(pathRequest, pathResult)
 			if tt.wantErr {
 				for _, edge := range nodes[1].GetEdges() {
@@ -546,3 +853,241 @@ func TestCalculationManager_CalculatePathUpdate(t *testing.T) {
 		})
 	}
 }
+
+// runs the hook once before the graph is locked, so another calculation can be interleaved deterministically
+type lockHookGraph struct {
+	graph.Graph
+	onLock func()
+}
+
+func (hookGraph *lockHookGraph) Lock() {
+	if onLock := hookGraph.onLock; onLock != nil {
+		hookGraph.onLock = nil
+		onLock()
+	}
+	hookGraph.Graph.Lock()
+}
+
+func TestCalculationManager_CalculatePathUpdate_ConcurrentBandwidthReservations(t *testing.T) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+	}
+	// the direct link 1 -> 2 is the bottleneck, it only fits one of the sessions
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 10000}),
+		2: graph.NewNetworkEdge("2", nodes[1], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 10000}),
+		3: graph.NewNetworkEdge("3", nodes[3], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 10000}),
+	}
+	tests := []struct {
+		name            string
+		bandwidthDemand uint32
+	}{
+		{
+			name:            "TestCalculationManager_CalculatePathUpdate concurrent sessions on a bottleneck link",
+			bandwidthDemand: 6000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			hookGraph := &lockHookGraph{Graph: networkGraph}
+			inMemoryCache := cache.NewInMemoryCache()
+			inMemoryCache.StoreSid(setUpMicroSid("2", "fc00:0:2::", 0, 0))
+			inMemoryCache.StoreSid(setUpMicroSid("3", "fc00:0:3::", 0, 0))
+			calculationSetup := NewMockCalculationSetup(controller)
+			calculationUpdater := NewMockCalculationUpdater(controller)
+			manager := NewCalculationManager(inMemoryCache, hookGraph, calculationSetup, NewCalculationTransformerService(inMemoryCache, networkGraph), calculationUpdater)
+			intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+			calculationSetup.EXPECT().PerformSetup(gomock.Any()).DoAndReturn(func(domain.PathRequest) (*CalculationOptions, error) {
+				minConstraints := map[helper.WeightKey]float64{helper.AvailableBandwidthKey: float64(tt.bandwidthDemand)}
+				return &CalculationOptions{networkGraph, nodes[1], nodes[2], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, minConstraints, nil, nil}, nil
+			}).AnyTimes()
+			calculationSetup.EXPECT().GetLexicographicTolerances(gomock.Any()).Return(nil).AnyTimes()
+			calculationSetup.EXPECT().GetWeightKeysandCalculationMode(gomock.Any()).Return([]helper.WeightKey{helper.LatencyKey}, CalculationModeSum).AnyTimes()
+			calculationSetup.EXPECT().GetWeights(gomock.Any()).Return([]float64{1}).AnyTimes()
+			// like the hysteresis of the updater, a session only moves to a shorter path
+			calculationUpdater.EXPECT().UpdateCalculation(gomock.Any()).DoAndReturn(func(options *CalculationUpdateOptions) (domain.PathResult, error) {
+				if len(options.newPathResult.GetEdges()) >= len(options.currentPathResult.GetEdges()) {
+					return nil, nil
+				}
+				options.streamSession.SetPathResult(options.newPathResult, domain.UpdateReasonNetworkChange)
+				return options.newPathResult, nil
+			}).AnyTimes()
+
+			streamSessions := make([]domain.StreamSession, 0, 2)
+			for index := 0; index < 2; index++ {
+				pathRequest, err := domain.NewDomainPathRequest("2001:db8::1", "2001:db8::2", intents, api.NewMockIntentController_GetIntentPathServer(controller), context.Background())
+				assert.NoError(t, err)
+				pathRequest.SetBandwidthDemand(tt.bandwidthDemand)
+				pathResult, err := manager.CalculateBestPath(pathRequest)
+				assert.NoError(t, err)
+				manager.ReserveBandwidth(pathResult)
+				streamSessions = append(streamSessions, domain.NewDomainStreamSession(pathRequest, pathResult))
+			}
+			assert.Len(t, streamSessions[0].GetPathResult().GetEdges(), 1)
+			assert.Len(t, streamSessions[1].GetPathResult().GetEdges(), 2)
+
+			// the second session is recalculated while the recalculation of the first session waits for the lock
+			hookGraph.onLock = func() {
+				_, err := manager.CalculatePathUpdate(streamSessions[1])
+				assert.NoError(t, err)
+			}
+			_, err = manager.CalculatePathUpdate(streamSessions[0])
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, inMemoryCache.GetBandwidthReservations()["1"], float64(10000))
+			assert.Equal(t, float64(tt.bandwidthDemand), inMemoryCache.GetBandwidthReservations()["1"])
+		})
+	}
+}
+
+func TestCalculationManager_ServiceSessions(t *testing.T) {
+	tests := []struct {
+		name           string
+		serviceSidList []string
+		remove         bool
+	}{
+		{
+			name:           "TestCalculationManager_AddServiceSessions",
+			serviceSidList: []string{"fc00:0:2f::", "fc00:0:6f::"},
+		},
+		{
+			name:           "TestCalculationManager_RemoveServiceSessions",
+			serviceSidList: []string{"fc00:0:2f::", "fc00:0:6f::"},
+			remove:         true,
+		},
+		{
+			name:           "TestCalculationManager_AddServiceSessions without services",
+			serviceSidList: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			cacheMock := cache.NewMockCache(controller)
+			manager := NewCalculationManager(cacheMock, graph.NewMockGraph(controller), NewMockCalculationSetup(controller), NewMockCalculationTransformer(controller), NewMockCalculationUpdater(controller))
+			pathResult := domain.NewMockPathResult(controller)
+			pathResult.EXPECT().GetServiceSidList().Return(tt.serviceSidList)
+			cacheMock.EXPECT().Lock()
+			cacheMock.EXPECT().Unlock()
+			for _, sid := range tt.serviceSidList {
+				if tt.remove {
+					cacheMock.EXPECT().RemoveServiceSession(sid)
+				} else {
+					cacheMock.EXPECT().AddServiceSession(sid)
+				}
+			}
+			if tt.remove {
+				manager.RemoveServiceSessions(pathResult)
+			} else {
+				manager.AddServiceSessions(pathResult)
+			}
+		})
+	}
+}
+
+func TestCalculationManager_applyBandwidthReservations(t *testing.T) {
+	tests := []struct {
+		name                   string
+		reservations           map[string]float64
+		wantAvailableBandwidth map[string]float64
+	}{
+		{
+			name:                   "TestCalculationManager_applyBandwidthReservations without reservations",
+			reservations:           map[string]float64{},
+			wantAvailableBandwidth: map[string]float64{"1": 10000, "2": 5000},
+		},
+		{
+			name:                   "TestCalculationManager_applyBandwidthReservations with reservations",
+			reservations:           map[string]float64{"1": 4000, "2": 1000},
+			wantAvailableBandwidth: map[string]float64{"1": 6000, "2": 4000},
+		},
+		{
+			name:                   "TestCalculationManager_applyBandwidthReservations with reservation above available bandwidth",
+			reservations:           map[string]float64{"2": 8000},
+			wantAvailableBandwidth: map[string]float64{"1": 10000, "2": 0},
+		},
+		{
+			name:                   "TestCalculationManager_applyBandwidthReservations with reservation of deleted link",
+			reservations:           map[string]float64{"3": 1000},
+			wantAvailableBandwidth: map[string]float64{"1": 10000, "2": 5000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			cacheMock := cache.NewMockCache(controller)
+			nodes := map[int]graph.Node{
+				1: graph.NewNetworkNode("1", "1", []uint32{0}),
+				2: graph.NewNetworkNode("2", "2", []uint32{0}),
+			}
+			edges := map[int]graph.Edge{
+				1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 10000}),
+				2: graph.NewNetworkEdge("2", nodes[2], nodes[1], map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 5000}),
+			}
+			network, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			manager := NewCalculationManager(cacheMock, network, NewMockCalculationSetup(controller), NewMockCalculationTransformer(controller), NewMockCalculationUpdater(controller))
+			cacheMock.EXPECT().GetBandwidthReservations().Return(tt.reservations)
+			restoreAvailableBandwidths := manager.applyBandwidthReservations()
+			for edgeId, wantAvailableBandwidth := range tt.wantAvailableBandwidth {
+				assert.Equal(t, wantAvailableBandwidth, network.GetEdge(edgeId).GetWeight(helper.AvailableBandwidthKey))
+			}
+			restoreAvailableBandwidths()
+			assert.Equal(t, float64(10000), network.GetEdge("1").GetWeight(helper.AvailableBandwidthKey))
+			assert.Equal(t, float64(5000), network.GetEdge("2").GetWeight(helper.AvailableBandwidthKey))
+		})
+	}
+}
+
+func TestCalculationManager_BandwidthReservations(t *testing.T) {
+	tests := []struct {
+		name            string
+		bandwidthDemand uint32
+		release         bool
+	}{
+		{
+			name:            "TestCalculationManager_ReserveBandwidth",
+			bandwidthDemand: 1000,
+		},
+		{
+			name:            "TestCalculationManager_ReleaseBandwidth",
+			bandwidthDemand: 1000,
+			release:         true,
+		},
+		{
+			name:            "TestCalculationManager_ReserveBandwidth without bandwidth demand",
+			bandwidthDemand: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			cacheMock := cache.NewMockCache(controller)
+			manager := NewCalculationManager(cacheMock, graph.NewMockGraph(controller), NewMockCalculationSetup(controller), NewMockCalculationTransformer(controller), NewMockCalculationUpdater(controller))
+			pathResult := domain.NewMockPathResult(controller)
+			pathResult.EXPECT().GetBandwidthDemand().Return(tt.bandwidthDemand)
+			if tt.bandwidthDemand > 0 {
+				edge := graph.NewMockEdge(controller)
+				edge.EXPECT().GetId().Return("1")
+				pathResult.EXPECT().GetEdges().Return([]graph.Edge{edge})
+				cacheMock.EXPECT().Lock()
+				cacheMock.EXPECT().Unlock()
+				if tt.release {
+					cacheMock.EXPECT().RemoveBandwidthReservation("1", float64(tt.bandwidthDemand))
+				} else {
+					cacheMock.EXPECT().AddBandwidthReservation("1", float64(tt.bandwidthDemand))
+				}
+			}
+			if tt.release {
+				manager.ReleaseBandwidth(pathResult)
+			} else {
+				manager.ReserveBandwidth(pathResult)
+			}
+		})
+	}
+}
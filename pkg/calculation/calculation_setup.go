@@ -7,22 +7,67 @@ import (
 )
 
 type CalculationOptions struct {
-	graph           graph.Graph
-	sourceNode      graph.Node
-	destinationNode graph.Node
-	weightKeys      []helper.WeightKey
-	calculationMode CalculationMode
-	maxConstraints  map[helper.WeightKey]float64
-	minConstraints  map[helper.WeightKey]float64
+	graph               graph.Graph
+	sourceNode          graph.Node
+	destinationNode     graph.Node
+	weightKeys          []helper.WeightKey
+	calculationMode     CalculationMode
+	maxConstraints      map[helper.WeightKey]float64
+	minConstraints      map[helper.WeightKey]float64
+	weights             []float64
+	topologyConstraints *TopologyConstraints
 }
 
+// serviceRouters holds the candidate routers of every included service in the order of the intent values,
+// servicePredecessors holds per service the mask of services which have to be applied before in an unordered chain,
+// serviceLoads and serviceMetadata hold the load and the registry metadata of every candidate service instance by its SID
 type SfcCalculationOptions struct {
-	serviceFunctionChain [][]string
-	routerServiceMap     map[string]string
+	serviceRouters      [][]string
+	routerServiceMap    map[string]string
+	unordered           bool
+	servicePredecessors []uint64
+	services            []string
+	serviceLoads        map[string]float64
+	serviceMetadata     map[string]domain.ServiceMetadata
+}
+
+type TopologyConstraints struct {
+	excludedNodes map[string]struct{}
+	excludedEdges map[string]struct{}
+	includedNodes []string
+	includedEdges []string
+}
+
+func NewTopologyConstraints() *TopologyConstraints {
+	return &TopologyConstraints{
+		excludedNodes: make(map[string]struct{}),
+		excludedEdges: make(map[string]struct{}),
+		includedNodes: make([]string, 0),
+		includedEdges: make([]string, 0),
+	}
+}
+
+// the copy can be extended by a calculation without affecting the constraints of the path request
+func (constraints *TopologyConstraints) clone() *TopologyConstraints {
+	clone := NewTopologyConstraints()
+	if constraints == nil {
+		return clone
+	}
+	for nodeId := range constraints.excludedNodes {
+		clone.excludedNodes[nodeId] = struct{}{}
+	}
+	for edgeId := range constraints.excludedEdges {
+		clone.excludedEdges[edgeId] = struct{}{}
+	}
+	clone.includedNodes = append(clone.includedNodes, constraints.includedNodes...)
+	clone.includedEdges = append(clone.includedEdges, constraints.includedEdges...)
+	return clone
 }
 
 type CalculationSetup interface {
 	PerformSetup(pathRequest domain.PathRequest) (*CalculationOptions, error)
 	PerformServiceFunctionChainSetup(intent domain.Intent, algorithm uint32) (*SfcCalculationOptions, error)
 	GetWeightKeysandCalculationMode(intents []domain.Intent) ([]helper.WeightKey, CalculationMode)
+	GetLexicographicTolerances(intents []domain.Intent) []float64
+	GetWeights(intents []domain.Intent) []float64
 }
@@ -40,6 +40,20 @@ func (m *MockCalculationSetup) EXPECT() *MockCalculationSetupMockRecorder {
 	return m.recorder
 }
 
+// GetLexicographicTolerances mocks base method.
+func (m *MockCalculationSetup) GetLexicographicTolerances(intents []domain.Intent) []float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLexicographicTolerances", intents)
+	ret0, _ := ret[0].([]float64)
+	return ret0
+}
+
+// GetLexicographicTolerances indicates an expected call of GetLexicographicTolerances.
+func (mr *MockCalculationSetupMockRecorder) GetLexicographicTolerances(intents any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLexicographicTolerances", reflect.TypeOf((*MockCalculationSetup)(nil).GetLexicographicTolerances), intents)
+}
+
 // GetWeightKeysandCalculationMode mocks base method.
 func (m *MockCalculationSetup) GetWeightKeysandCalculationMode(intents []domain.Intent) ([]helper.WeightKey, CalculationMode) {
 	m.ctrl.T.Helper()
@@ -55,6 +69,20 @@ func (mr *MockCalculationSetupMockRecorder) GetWeightKeysandCalculationMode(inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWeightKeysandCalculationMode", reflect.TypeOf((*MockCalculationSetup)(nil).GetWeightKeysandCalculationMode), intents)
 }
 
+// GetWeights mocks base method.
+func (m *MockCalculationSetup) GetWeights(intents []domain.Intent) []float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWeights", intents)
+	ret0, _ := ret[0].([]float64)
+	return ret0
+}
+
+// GetWeights indicates an expected call of GetWeights.
+func (mr *MockCalculationSetupMockRecorder) GetWeights(intents any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWeights", reflect.TypeOf((*MockCalculationSetup)(nil).GetWeights), intents)
+}
+
 // PerformServiceFunctionChainSetup mocks base method.
 func (m *MockCalculationSetup) PerformServiceFunctionChainSetup(intent domain.Intent, algorithm uint32) (*SfcCalculationOptions, error) {
 	m.ctrl.T.Helper()
@@ -2,7 +2,10 @@ package calculation
 
 import (
 	"fmt"
+	"math"
 	"net"
+	"slices"
+	"strings"
 
 	"github.com/hawkv6/hawkeye/pkg/cache"
 	"github.com/hawkv6/hawkeye/pkg/domain"
@@ -119,7 +122,18 @@ func (provider *CalculationSetupProvider) getIntentOffset(intents []domain.Inten
 	return offset
 }
 
+func (provider *CalculationSetupProvider) getOptimizationIntents(intents []domain.Intent) []domain.Intent {
+	optimizationIntents := make([]domain.Intent, 0, len(intents))
+	for _, intent := range intents {
+		if !domain.IsTopologyIntentType(intent.GetIntentType()) {
+			optimizationIntents = append(optimizationIntents, intent)
+		}
+	}
+	return optimizationIntents
+}
+
 func (provider *CalculationSetupProvider) GetWeightKeysandCalculationMode(intents []domain.Intent) ([]helper.WeightKey, CalculationMode) {
+	intents = provider.getOptimizationIntents(intents)
 	if len(intents) == 1 {
 		weightKey, calcType := provider.getWeightKeyAndCalcMode(intents[0].GetIntentType())
 		return []helper.WeightKey{weightKey}, calcType
@@ -136,6 +150,25 @@ func (provider *CalculationSetupProvider) GetWeightKeysandCalculationMode(intent
 	}
 }
 
+func (provider *CalculationSetupProvider) GetWeights(intents []domain.Intent) []float64 {
+	intents = provider.getOptimizationIntents(intents)
+	weightKeys, _ := provider.GetWeightKeysandCalculationMode(intents)
+	offset := len(intents) - len(weightKeys)
+	weights := make([]float64, len(weightKeys))
+	totalWeight := 0.0
+	for i := range weights {
+		weights[i] = intents[i+offset].GetWeight()
+		totalWeight += weights[i]
+	}
+	if totalWeight == 0 {
+		return getDefaultWeights(len(weightKeys))
+	}
+	for i := range weights {
+		weights[i] = weights[i] / totalWeight
+	}
+	return weights
+}
+
 func (provider *CalculationSetupProvider) getMaxConstraints(intents []domain.Intent, weightKeys []helper.WeightKey) map[helper.WeightKey]float64 {
 	maxValues := make(map[helper.WeightKey]float64)
 	offset := provider.getIntentOffset(intents)
@@ -170,18 +203,210 @@ func (provider *CalculationSetupProvider) getMinConstraints(intents []domain.Int
 	return minValues
 }
 
-func (provider *CalculationSetupProvider) getServiceSids(serviceFunctionChainIntent domain.Intent) ([][]string, error) {
+// the requested maximum SID depth is limited by the maximum SID depth advertised by the headend, 0 means no limit
+func getMaxSidDepth(cache cache.Cache, pathRequest domain.PathRequest, sourceNode graph.Node) uint32 {
+	maxSidDepth := pathRequest.GetMaxSidDepth()
+	headend := cache.GetNodeByIgpRouterId(sourceNode.GetId())
+	if headend == nil || headend.GetMaximumSidDepth() == 0 {
+		return maxSidDepth
+	}
+	if maxSidDepth == 0 || headend.GetMaximumSidDepth() < maxSidDepth {
+		return headend.GetMaximumSidDepth()
+	}
+	return maxSidDepth
+}
+
+func (provider *CalculationSetupProvider) addHopConstraints(pathRequest domain.PathRequest, sourceNode graph.Node, maxConstraints map[helper.WeightKey]float64) {
+	if maxHopCount := pathRequest.GetMaxHopCount(); maxHopCount > 0 {
+		maxConstraints[helper.HopCountKey] = float64(maxHopCount)
+	}
+	if maxSidDepth := getMaxSidDepth(provider.cache, pathRequest, sourceNode); maxSidDepth > 0 {
+		// strict paths need an End.X SID per hop and the node SID of the destination
+		if maxSidDepth > 1 && pathRequest.GetStrictPath() {
+			maxSidDepth--
+		}
+		maxConstraints[helper.SidDepthKey] = float64(maxSidDepth)
+	}
+}
+
+// links which can not carry the bandwidth demand of the session besides the reservations of the other sessions are ignored
+func (provider *CalculationSetupProvider) addBandwidthDemand(pathRequest domain.PathRequest, minConstraints map[helper.WeightKey]float64) {
+	if bandwidthDemand := float64(pathRequest.GetBandwidthDemand()); bandwidthDemand > minConstraints[helper.AvailableBandwidthKey] {
+		minConstraints[helper.AvailableBandwidthKey] = bandwidthDemand
+	}
+}
+
+func (provider *CalculationSetupProvider) GetLexicographicTolerances(intents []domain.Intent) []float64 {
+	intents = provider.getOptimizationIntents(intents)
+	offset := provider.getIntentOffset(intents)
+	tolerances := make([]float64, len(intents)-offset)
+	lexicographic := false
+	for i := offset; i < len(intents); i++ {
+		for _, value := range intents[i].GetValues() {
+			if value.GetValueType() == domain.ValueTypeTolerance {
+				tolerances[i-offset] = float64(value.GetNumberValue()) / 100
+				lexicographic = true
+			}
+		}
+	}
+	if !lexicographic {
+		return nil
+	}
+	return tolerances
+}
+
+// a node is referenced by its identifier or by its name, a name shared by several nodes is rejected since it does not tell which node is meant
+func (provider *CalculationSetupProvider) getNodeId(value string) (string, error) {
+	if provider.graph.NodeExists(value) {
+		return value, nil
+	}
+	nodeIds := make([]string, 0, 1)
+	for nodeId, node := range provider.graph.GetNodes() {
+		if node.GetName() == value {
+			nodeIds = append(nodeIds, nodeId)
+		}
+	}
+	switch len(nodeIds) {
+	case 0:
+		return "", fmt.Errorf("Node %s not found in the graph", value)
+	case 1:
+		return nodeIds[0], nil
+	default:
+		slices.Sort(nodeIds)
+		return "", fmt.Errorf("Node name %s is ambiguous, it is used by the nodes %s, use the node identifier instead", value, strings.Join(nodeIds, ", "))
+	}
+}
+
+func (provider *CalculationSetupProvider) addTopologyNodes(intent domain.Intent, addNode func(string)) error {
+	for _, value := range intent.GetValues() {
+		nodeId, err := provider.getNodeId(value.GetStringValue())
+		if err != nil {
+			return err
+		}
+		addNode(nodeId)
+	}
+	return nil
+}
+
+func (provider *CalculationSetupProvider) addTopologyEdges(intent domain.Intent, addEdge func(string)) error {
+	for _, value := range intent.GetValues() {
+		edgeId := value.GetStringValue()
+		if !provider.graph.EdgeExists(edgeId) {
+			return fmt.Errorf("Link %s not found in the graph", edgeId)
+		}
+		addEdge(edgeId)
+	}
+	return nil
+}
+
+func (provider *CalculationSetupProvider) addTopologyIntent(intent domain.Intent, constraints *TopologyConstraints) error {
+	switch intent.GetIntentType() {
+	case domain.IntentTypeExcludeNodes:
+		return provider.addTopologyNodes(intent, func(nodeId string) { constraints.excludedNodes[nodeId] = struct{}{} })
+	case domain.IntentTypeIncludeNodes:
+		return provider.addTopologyNodes(intent, func(nodeId string) { constraints.includedNodes = append(constraints.includedNodes, nodeId) })
+	case domain.IntentTypeExcludeLinks:
+		return provider.addTopologyEdges(intent, func(edgeId string) { constraints.excludedEdges[edgeId] = struct{}{} })
+	case domain.IntentTypeIncludeLinks:
+		return provider.addTopologyEdges(intent, func(edgeId string) { constraints.includedEdges = append(constraints.includedEdges, edgeId) })
+	default:
+		return nil
+	}
+}
+
+func (provider *CalculationSetupProvider) validateTopologyConstraints(constraints *TopologyConstraints, sourceNode, destinationNode graph.Node) error {
+	for _, node := range []graph.Node{sourceNode, destinationNode} {
+		if _, excluded := constraints.excludedNodes[node.GetId()]; excluded {
+			return fmt.Errorf("Node %s can not be excluded since it is the source or destination of the path", node.GetName())
+		}
+	}
+	for _, nodeId := range constraints.includedNodes {
+		if _, excluded := constraints.excludedNodes[nodeId]; excluded {
+			return fmt.Errorf("Node %s can not be excluded and included at the same time", nodeId)
+		}
+	}
+	for _, edgeId := range constraints.includedEdges {
+		edge := provider.graph.GetEdge(edgeId)
+		_, fromExcluded := constraints.excludedNodes[edge.From().GetId()]
+		_, toExcluded := constraints.excludedNodes[edge.To().GetId()]
+		if fromExcluded || toExcluded {
+			return fmt.Errorf("Link %s can not be included since one of its nodes is excluded", edgeId)
+		}
+	}
+	return nil
+}
+
+func (provider *CalculationSetupProvider) getTopologyConstraints(intents []domain.Intent, sourceNode, destinationNode graph.Node) (*TopologyConstraints, error) {
+	constraints := NewTopologyConstraints()
+	topologyIntents := 0
+	for _, intent := range intents {
+		if !domain.IsTopologyIntentType(intent.GetIntentType()) {
+			continue
+		}
+		topologyIntents++
+		if err := provider.addTopologyIntent(intent, constraints); err != nil {
+			return nil, err
+		}
+	}
+	if topologyIntents == 0 {
+		return nil, nil
+	}
+	if err := provider.validateTopologyConstraints(constraints, sourceNode, destinationNode); err != nil {
+		return nil, err
+	}
+	provider.log.Debugf("Topology constraints: excluded nodes %v, excluded links %v, included nodes %v, included links %v", constraints.excludedNodes, constraints.excludedEdges, constraints.includedNodes, constraints.includedEdges)
+	return constraints, nil
+}
+
+// optional services without any healthy instance are skipped, the returned services are the ones included in the chain
+// instances which do not register a capacity are limited by the configured session cap, 0 if unlimited
+func (provider *CalculationSetupProvider) getServiceCapacity(metadata domain.ServiceMetadata) uint32 {
+	if metadata != nil && metadata.GetCapacity() > 0 {
+		return metadata.GetCapacity()
+	}
+	return helper.ServiceSessionCap
+}
+
+func (provider *CalculationSetupProvider) hasFreeCapacity(sid string) bool {
+	capacity := provider.getServiceCapacity(provider.cache.GetServiceMetadata(sid))
+	return capacity == 0 || provider.cache.GetServiceSessionCount(sid) < int(capacity)
+}
+
+// the returned flag tells if the service has instances, but all of them reached their session cap
+func (provider *CalculationSetupProvider) getAvailableServiceSids(serviceType string) ([]string, bool) {
+	serviceSids := provider.cache.GetServiceSids(serviceType)
+	availableSids := make([]string, 0)
+	for _, sid := range serviceSids {
+		if !provider.hasFreeCapacity(sid) {
+			provider.log.Debugf("Service instance %s of service %s reached its session cap", sid, serviceType)
+			continue
+		}
+		availableSids = append(availableSids, sid)
+	}
+	return availableSids, len(availableSids) == 0 && len(serviceSids) > 0
+}
+
+func (provider *CalculationSetupProvider) getServiceSids(serviceFunctionChainIntent domain.Intent) ([][]string, []string, error) {
 	serviceSids := make([][]string, 0)
-	for index, value := range serviceFunctionChainIntent.GetValues() {
+	services := make([]string, 0)
+	for _, value := range serviceFunctionChainIntent.GetValues() {
 		value := value.GetStringValue()
-		serviceSids = append(serviceSids, make([]string, 0))
-		serviceSids[index] = provider.cache.GetServiceSids(value)
-		if len(serviceSids[index]) == 0 {
-			return nil, fmt.Errorf("No SIDs found for service: %s", value)
+		sids, reachedSessionCap := provider.getAvailableServiceSids(value)
+		if len(sids) == 0 {
+			if serviceFunctionChainIntent.IsOptionalService(value) {
+				provider.log.Infof("No SIDs found for optional service %s, service is skipped", value)
+				continue
+			}
+			if reachedSessionCap {
+				return nil, nil, fmt.Errorf("%w: all instances of service %s reached their session cap", ErrInsufficientCapacity, value)
+			}
+			return nil, nil, fmt.Errorf("No SIDs found for service: %s", value)
 		}
+		serviceSids = append(serviceSids, sids)
+		services = append(services, value)
 	}
 	provider.log.Debugln("Service SIDs: ", serviceSids)
-	return serviceSids, nil
+	return serviceSids, services, nil
 }
 
 func (provider *CalculationSetupProvider) getServiceRouter(serviceSids [][]string, algorithm uint32) ([][]string, map[string]string, error) {
@@ -203,38 +428,101 @@ func (provider *CalculationSetupProvider) getServiceRouter(serviceSids [][]strin
 	return serviceRouters, routerServiceMap, nil
 }
 
-func (provider *CalculationSetupProvider) getServiceChainCombinations(serviceRouter [][]string) [][]string {
-	serviceChainCombination := [][]string{{}}
+// the load of an instance is the higher of its reported load and its session utilization, relative to its capacity
+// or, without capacity, to all sessions of the service, and is divided by the weight of the instance
+func (provider *CalculationSetupProvider) getServiceLoad(sid string, serviceSessionCount int) float64 {
+	metadata := provider.cache.GetServiceMetadata(sid)
+	sessionCount := float64(provider.cache.GetServiceSessionCount(sid))
+	load, weight := 0.0, 1.0
+	if metadata != nil {
+		load, weight = metadata.GetLoad()/100, metadata.GetWeight()
+	}
+	if capacity := provider.getServiceCapacity(metadata); capacity > 0 {
+		load = math.Max(load, sessionCount/float64(capacity))
+	} else if serviceSessionCount > 0 {
+		load = math.Max(load, sessionCount/float64(serviceSessionCount))
+	}
+	return load / weight
+}
+
+func (provider *CalculationSetupProvider) getServiceLoads(serviceSids [][]string) map[string]float64 {
+	serviceLoads := make(map[string]float64)
+	for _, sids := range serviceSids {
+		serviceSessionCount := 0
+		for _, sid := range sids {
+			serviceSessionCount += provider.cache.GetServiceSessionCount(sid)
+		}
+		for _, sid := range sids {
+			serviceLoads[sid] = provider.getServiceLoad(sid, serviceSessionCount)
+		}
+	}
+	provider.log.Debugln("Service loads: ", serviceLoads)
+	return serviceLoads
+}
+
+func (provider *CalculationSetupProvider) getServiceMetadata(serviceSids [][]string) map[string]domain.ServiceMetadata {
+	serviceMetadata := make(map[string]domain.ServiceMetadata)
+	for _, sids := range serviceSids {
+		for _, sid := range sids {
+			if metadata := provider.cache.GetServiceMetadata(sid); metadata != nil {
+				serviceMetadata[sid] = metadata
+			}
+		}
+	}
+	return serviceMetadata
+}
 
-	for _, routerIds := range serviceRouter {
-		newQueue := [][]string{}
-		for _, combination := range serviceChainCombination {
-			for _, routerId := range routerIds {
-				newCombination := append([]string{}, combination...)
-				newCombination = append(newCombination, routerId)
-				newQueue = append(newQueue, newCombination)
+// service order constraints are transitive, so a skipped optional service still orders the services around it
+func (provider *CalculationSetupProvider) getServicePredecessors(serviceFunctionChainIntent domain.Intent, services []string) []uint64 {
+	serviceIndices := make(map[string]int, len(services))
+	for index, service := range services {
+		serviceIndices[service] = index
+	}
+	predecessors := make(map[string][]string)
+	for _, order := range serviceFunctionChainIntent.GetServiceOrder() {
+		predecessors[order.GetAfter()] = append(predecessors[order.GetAfter()], order.GetBefore())
+	}
+	servicePredecessors := make([]uint64, len(services))
+	for index, service := range services {
+		visited := make(map[string]bool)
+		pending := append([]string{}, predecessors[service]...)
+		for len(pending) > 0 {
+			predecessor := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			if visited[predecessor] {
+				continue
 			}
+			visited[predecessor] = true
+			if predecessorIndex, ok := serviceIndices[predecessor]; ok {
+				servicePredecessors[index] |= 1 << predecessorIndex
+			}
+			pending = append(pending, predecessors[predecessor]...)
 		}
-		serviceChainCombination = newQueue
 	}
-	provider.log.Debugln("Service Chain Combinations: ", serviceChainCombination)
-	return serviceChainCombination
+	return servicePredecessors
 }
 
 func (provider *CalculationSetupProvider) PerformServiceFunctionChainSetup(serviceFunctionChainIntent domain.Intent, algorithm uint32) (*SfcCalculationOptions, error) {
 	sfcCalculationOptions := &SfcCalculationOptions{}
-	serviceSids, err := provider.getServiceSids(serviceFunctionChainIntent)
+	serviceSids, services, err := provider.getServiceSids(serviceFunctionChainIntent)
 	if err != nil {
-		return nil, fmt.Errorf("Error getting service SIDs: %s", err)
+		return nil, fmt.Errorf("Error getting service SIDs: %w", err)
 	}
 
-	serviceRouter, routerServiceMap, err := provider.getServiceRouter(serviceSids, algorithm)
+	serviceRouters, routerServiceMap, err := provider.getServiceRouter(serviceSids, algorithm)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting service routers: %s", err)
 	}
+	sfcCalculationOptions.serviceRouters = serviceRouters
 	sfcCalculationOptions.routerServiceMap = routerServiceMap
-
-	sfcCalculationOptions.serviceFunctionChain = provider.getServiceChainCombinations(serviceRouter)
+	sfcCalculationOptions.services = services
+	sfcCalculationOptions.serviceLoads = provider.getServiceLoads(serviceSids)
+	sfcCalculationOptions.serviceMetadata = provider.getServiceMetadata(serviceSids)
+	if serviceFunctionChainIntent.GetUnordered() {
+		sfcCalculationOptions.unordered = true
+		sfcCalculationOptions.servicePredecessors = provider.getServicePredecessors(serviceFunctionChainIntent, services)
+		provider.log.Debugln("Unordered service function chain with service predecessors: ", sfcCalculationOptions.servicePredecessors)
+	}
 
 	return sfcCalculationOptions, nil
 }
@@ -258,8 +546,16 @@ func (provider *CalculationSetupProvider) PerformSetup(pathRequest domain.PathRe
 	if calculationSetupOption.calculationMode == CalculationModeUndefined {
 		return nil, fmt.Errorf("Calculation mode not defined for intents")
 	}
-	calculationSetupOption.maxConstraints = provider.getMaxConstraints(intents, calculationSetupOption.weightKeys)
-	calculationSetupOption.minConstraints = provider.getMinConstraints(intents, calculationSetupOption.weightKeys)
+	optimizationIntents := provider.getOptimizationIntents(intents)
+	calculationSetupOption.maxConstraints = provider.getMaxConstraints(optimizationIntents, calculationSetupOption.weightKeys)
+	calculationSetupOption.minConstraints = provider.getMinConstraints(optimizationIntents, calculationSetupOption.weightKeys)
+	provider.addHopConstraints(pathRequest, calculationSetupOption.sourceNode, calculationSetupOption.maxConstraints)
+	provider.addBandwidthDemand(pathRequest, calculationSetupOption.minConstraints)
+	calculationSetupOption.weights = provider.GetWeights(intents)
+	calculationSetupOption.topologyConstraints, err = provider.getTopologyConstraints(intents, calculationSetupOption.sourceNode, calculationSetupOption.destinationNode)
+	if err != nil {
+		return nil, err
+	}
 
 	return calculationSetupOption, nil
 }
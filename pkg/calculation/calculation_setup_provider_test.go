@@ -2,6 +2,7 @@ package calculation
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/hawkv6/hawkeye/pkg/api"
@@ -453,6 +454,26 @@ func TestCalculationSetupProvider_GetWeightKeysandCalculationMode(t *testing.T)
 			wantKeys:     []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey, helper.NormalizedPacketLossKey},
 			wantCalcMode: CalculationModeSum,
 		},
+		{
+			name: "Test Get Weight Keys and Calculation Mode high bandwidth, exclude nodes",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeHighBandwidth, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeExcludeNodes, []domain.Value{}),
+			},
+			wantKeys:     []helper.WeightKey{helper.AvailableBandwidthKey},
+			wantCalcMode: CalculationModeMax,
+		},
+		{
+			name: "Test Get Weight Keys and Calculation Mode sfc, low latency, low jitter, include links",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeSFC, []domain.Value{sfcValue}),
+				domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeLowJitter, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeIncludeLinks, []domain.Value{}),
+			},
+			wantKeys:     []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey},
+			wantCalcMode: CalculationModeSum,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -541,42 +562,331 @@ func TestCalculationSetupProvider_getMinConstraints(t *testing.T) {
 	}
 }
 
+func TestCalculationSetupProvider_GetLexicographicTolerances(t *testing.T) {
+	toleranceValue, _ := domain.NewNumberValue(domain.ValueTypeTolerance, proto.Int32(10))
+	flexAlgoValue, _ := domain.NewNumberValue(domain.ValueTypeFlexAlgoNr, proto.Int32(128))
+	tests := []struct {
+		name           string
+		intents        []domain.Intent
+		wantTolerances []float64
+	}{
+		{
+			name: "Test GetLexicographicTolerances without tolerance",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{}),
+			},
+			wantTolerances: nil,
+		},
+		{
+			name: "Test GetLexicographicTolerances with tolerance on first intent",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{toleranceValue}),
+				domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{}),
+			},
+			wantTolerances: []float64{0.1, 0},
+		},
+		{
+			name: "Test GetLexicographicTolerances with flex algo offset",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeFlexAlgo, []domain.Value{flexAlgoValue}),
+				domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeHighBandwidth, []domain.Value{toleranceValue}),
+			},
+			wantTolerances: []float64{0, 0.1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			provider := NewCalculationSetupProvider(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			assert.Equal(t, tt.wantTolerances, provider.GetLexicographicTolerances(tt.intents))
+		})
+	}
+}
+
+func TestCalculationSetupProvider_GetWeights(t *testing.T) {
+	flexAlgoValue, _ := domain.NewNumberValue(domain.ValueTypeFlexAlgoNr, proto.Int32(128))
+	getWeightedIntent := func(intentType domain.IntentType, weight float64) domain.Intent {
+		intent := domain.NewDomainIntent(intentType, []domain.Value{})
+		intent.SetWeight(weight)
+		return intent
+	}
+	tests := []struct {
+		name        string
+		intents     []domain.Intent
+		wantWeights []float64
+	}{
+		{
+			name: "Test GetWeights single intent",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+			},
+			wantWeights: []float64{1},
+		},
+		{
+			name: "Test GetWeights default two factor weights",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{}),
+			},
+			wantWeights: helper.TwoFactorWeights,
+		},
+		{
+			name: "Test GetWeights request weights are normalized",
+			intents: []domain.Intent{
+				getWeightedIntent(domain.IntentTypeLowLatency, 3),
+				getWeightedIntent(domain.IntentTypeLowPacketLoss, 1),
+			},
+			wantWeights: []float64{0.75, 0.25},
+		},
+		{
+			name: "Test GetWeights request weights with flex algo offset",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeFlexAlgo, []domain.Value{flexAlgoValue}),
+				getWeightedIntent(domain.IntentTypeLowLatency, 0.5),
+				getWeightedIntent(domain.IntentTypeLowJitter, 0.5),
+			},
+			wantWeights: []float64{0.5, 0.5},
+		},
+		{
+			name: "Test GetWeights four intents without request weights",
+			intents: []domain.Intent{
+				domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeLowJitter, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeLowPacketLoss, []domain.Value{}),
+				domain.NewDomainIntent(domain.IntentTypeHighBandwidth, []domain.Value{}),
+			},
+			wantWeights: []float64{0.25, 0.25, 0.25, 0.25},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			provider := NewCalculationSetupProvider(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			assert.Equal(t, tt.wantWeights, provider.GetWeights(tt.intents))
+		})
+	}
+}
+
+func TestCalculationSetupProvider_getTopologyConstraints(t *testing.T) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1.1.1.1", "XR-1", srAlgorithm),
+		2: graph.NewNetworkNode("2.2.2.2", "XR-2", srAlgorithm),
+		3: graph.NewNetworkNode("3.3.3.3", "XR-3", srAlgorithm),
+		4: graph.NewNetworkNode("4.4.4.4", "XR-4", srAlgorithm),
+		5: graph.NewNetworkNode("5.5.5.5", "XR-4", srAlgorithm),
+	}
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("link1", nodes[1], nodes[2], map[helper.WeightKey]float64{}),
+		2: graph.NewNetworkEdge("link2", nodes[2], nodes[3], map[helper.WeightKey]float64{}),
+		3: graph.NewNetworkEdge("link3", nodes[1], nodes[4], map[helper.WeightKey]float64{}),
+		4: graph.NewNetworkEdge("link4", nodes[1], nodes[5], map[helper.WeightKey]float64{}),
+	}
+	getIntent := func(intentType domain.IntentType, valueType domain.ValueType, elements ...string) domain.Intent {
+		values := make([]domain.Value, 0, len(elements))
+		for _, element := range elements {
+			value, _ := domain.NewStringValue(valueType, proto.String(element))
+			values = append(values, value)
+		}
+		return domain.NewDomainIntent(intentType, values)
+	}
+	lowLatencyIntent := domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})
+	tests := []struct {
+		name            string
+		intents         []domain.Intent
+		want            *TopologyConstraints
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "Test getTopologyConstraints without node or link intents",
+			intents: []domain.Intent{lowLatencyIntent},
+			want:    nil,
+		},
+		{
+			name: "Test getTopologyConstraints nodes by router id and name",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeExcludeNodes, domain.ValueTypeNode, "XR-2"),
+				getIntent(domain.IntentTypeExcludeLinks, domain.ValueTypeLink, "link2"),
+			},
+			want: &TopologyConstraints{
+				excludedNodes: map[string]struct{}{"2.2.2.2": {}},
+				excludedEdges: map[string]struct{}{"link2": {}},
+				includedNodes: []string{},
+				includedEdges: []string{},
+			},
+		},
+		{
+			name: "Test getTopologyConstraints included elements keep their order",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeIncludeNodes, domain.ValueTypeNode, "XR-2", "1.1.1.1"),
+			},
+			want: &TopologyConstraints{
+				excludedNodes: map[string]struct{}{},
+				excludedEdges: map[string]struct{}{},
+				includedNodes: []string{"2.2.2.2", "1.1.1.1"},
+				includedEdges: []string{},
+			},
+		},
+		{
+			name: "Test getTopologyConstraints unknown node",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeExcludeNodes, domain.ValueTypeNode, "XR-9"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test getTopologyConstraints node name used by several nodes",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeExcludeNodes, domain.ValueTypeNode, "XR-4"),
+			},
+			wantErr:         true,
+			wantErrContains: "used by the nodes 4.4.4.4, 5.5.5.5",
+		},
+		{
+			name: "Test getTopologyConstraints node with shared name by router id",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeExcludeNodes, domain.ValueTypeNode, "5.5.5.5"),
+			},
+			want: &TopologyConstraints{
+				excludedNodes: map[string]struct{}{"5.5.5.5": {}},
+				excludedEdges: map[string]struct{}{},
+				includedNodes: []string{},
+				includedEdges: []string{},
+			},
+		},
+		{
+			name: "Test getTopologyConstraints unknown link",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeIncludeLinks, domain.ValueTypeLink, "link9"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test getTopologyConstraints excluded source node",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeExcludeNodes, domain.ValueTypeNode, "1.1.1.1"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test getTopologyConstraints node excluded by name and included by router id",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeExcludeNodes, domain.ValueTypeNode, "XR-2"),
+				getIntent(domain.IntentTypeIncludeNodes, domain.ValueTypeNode, "2.2.2.2"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test getTopologyConstraints included link with excluded node",
+			intents: []domain.Intent{
+				lowLatencyIntent,
+				getIntent(domain.IntentTypeExcludeNodes, domain.ValueTypeNode, "XR-2"),
+				getIntent(domain.IntentTypeIncludeLinks, domain.ValueTypeLink, "link1"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			provider := NewCalculationSetupProvider(nil, networkGraph)
+			got, err := provider.getTopologyConstraints(tt.intents, nodes[1], nodes[3])
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErrContains)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestCalculationSetupProvider_getServiceSids(t *testing.T) {
 	fw, _ := domain.NewStringValue(domain.ValueTypeSFC, proto.String("fw"))
 	ids, _ := domain.NewStringValue(domain.ValueTypeSFC, proto.String("ids"))
 	fwSids := []string{"fc00:0:2f::", "fc00:0:3f::"}
 	idsSids := []string{"fc00:0:6f::", "fc00:0:7f::"}
 	tests := []struct {
-		name     string
-		intents  domain.Intent
-		wantSids [][]string
-		wantErr  bool
+		name             string
+		optionalServices []string
+		idsSids          []string
+		idsSessionCount  int
+		wantSids         [][]string
+		wantServices     []string
+		wantErr          bool
+		wantCapacityErr  bool
 	}{
 		{
-			name: "Test Get Service Sids success",
-			intents: domain.NewDomainIntent(domain.IntentTypeSFC, []domain.Value{
-				fw,
-				ids,
-			}),
+			name:    "Test Get Service Sids success",
+			idsSids: idsSids,
 			wantSids: [][]string{
 				fwSids,
 				idsSids,
 			},
-			wantErr: false,
+			wantServices: []string{"fw", "ids"},
+			wantErr:      false,
 		},
 		{
-			name: "Test Get Service Sids empty ids sids",
-			intents: domain.NewDomainIntent(domain.IntentTypeSFC, []domain.Value{
-				fw,
-				ids,
-			}),
+			name:    "Test Get Service Sids empty ids sids",
+			idsSids: []string{},
+			wantErr: true,
+		},
+		{
+			name:             "Test Get Service Sids optional service with sids",
+			optionalServices: []string{"ids"},
+			idsSids:          idsSids,
 			wantSids: [][]string{
 				fwSids,
-				{},
+				idsSids,
 			},
-			wantErr: true,
+			wantServices: []string{"fw", "ids"},
+			wantErr:      false,
+		},
+		{
+			name:             "Test Get Service Sids optional service without sids",
+			optionalServices: []string{"ids"},
+			idsSids:          []string{},
+			wantSids: [][]string{
+				fwSids,
+			},
+			wantServices: []string{"fw"},
+			wantErr:      false,
+		},
+		{
+			name:            "Test Get Service Sids ids instances at capacity",
+			idsSids:         idsSids,
+			idsSessionCount: 10,
+			wantErr:         true,
+			wantCapacityErr: true,
+		},
+		{
+			name:             "Test Get Service Sids optional service instances at capacity",
+			optionalServices: []string{"ids"},
+			idsSids:          idsSids,
+			idsSessionCount:  10,
+			wantSids: [][]string{
+				fwSids,
+			},
+			wantServices: []string{"fw"},
+			wantErr:      false,
 		},
 	}
+	metadata, err := domain.NewDomainServiceMetadata(0, 1, 10, 0, 0, 0)
+	assert.NoError(t, err)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			controller := gomock.NewController(t)
@@ -584,17 +894,24 @@ func TestCalculationSetupProvider_getServiceSids(t *testing.T) {
 			graphMock := graph.NewMockGraph(controller)
 			provider := NewCalculationSetupProvider(cacheMock, graphMock)
 			cacheMock.EXPECT().GetServiceSids("fw").Return(fwSids)
-			if tt.wantErr {
-				cacheMock.EXPECT().GetServiceSids("ids").Return([]string{})
-			} else {
-				cacheMock.EXPECT().GetServiceSids("ids").Return(idsSids)
+			cacheMock.EXPECT().GetServiceSids("ids").Return(tt.idsSids)
+			cacheMock.EXPECT().GetServiceMetadata(gomock.Any()).Return(metadata).AnyTimes()
+			for _, sid := range fwSids {
+				cacheMock.EXPECT().GetServiceSessionCount(sid).Return(0).AnyTimes()
 			}
-			sids, err := provider.getServiceSids(tt.intents)
+			for _, sid := range idsSids {
+				cacheMock.EXPECT().GetServiceSessionCount(sid).Return(tt.idsSessionCount).AnyTimes()
+			}
+			intent := domain.NewDomainIntent(domain.IntentTypeSFC, []domain.Value{fw, ids})
+			intent.SetOptionalServices(tt.optionalServices)
+			sids, services, err := provider.getServiceSids(intent)
 			if !tt.wantErr {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.wantSids, sids)
+				assert.Equal(t, tt.wantServices, services)
 			} else {
 				assert.Error(t, err)
+				assert.Equal(t, tt.wantCapacityErr, errors.Is(err, ErrInsufficientCapacity))
 			}
 		})
 	}
@@ -688,87 +1005,147 @@ func TestCalculationSetupProvider_getServiceRouter(t *testing.T) {
 	}
 }
 
-func TestCalculationSetupProvider_getServiceChainCombinations(t *testing.T) {
+func TestCalculationSetupProvider_getServiceLoads(t *testing.T) {
+	serviceSessionCap := helper.ServiceSessionCap
+	defer func() { helper.ServiceSessionCap = serviceSessionCap }()
 	tests := []struct {
-		name             string
-		serviceRouters   [][]string
-		wantCombinations [][]string
+		name              string
+		load              float64
+		weight            float64
+		capacity          uint32
+		serviceSessionCap uint32
+		noMetadata        bool
+		sessionCounts     []int
+		wantLoads         []float64
 	}{
 		{
-			name: "Test Get Service Chain Combinations two services with 2 routers each",
-			wantCombinations: [][]string{
-				{"router1", "router3"},
-				{"router1", "router4"},
-				{"router2", "router3"},
-				{"router2", "router4"},
-			},
-			serviceRouters: [][]string{
-				{"router1", "router2"},
-				{"router3", "router4"},
-			},
+			name:          "Test get service loads without metadata and sessions",
+			noMetadata:    true,
+			sessionCounts: []int{0, 0},
+			wantLoads:     []float64{0, 0},
 		},
 		{
-			name: "Test Get Service Chain Combinations two services one service only one router",
-			wantCombinations: [][]string{
-				{"router1", "router3"},
-				{"router1", "router4"},
-			},
-			serviceRouters: [][]string{
-				{"router1"},
-				{"router3", "router4"},
-			},
+			name:          "Test get service loads without metadata relative to all sessions of the service",
+			noMetadata:    true,
+			sessionCounts: []int{3, 1},
+			wantLoads:     []float64{0.75, 0.25},
 		},
 		{
-			name: "Test Get Service Chain Combinations two routers only",
-			wantCombinations: [][]string{
-				{"router1"},
-				{"router2"},
-			},
-			serviceRouters: [][]string{
-				{"router1", "router2"},
-			},
+			name:          "Test get service loads relative to the capacity",
+			weight:        1,
+			capacity:      10,
+			sessionCounts: []int{5, 1},
+			wantLoads:     []float64{0.5, 0.1},
 		},
 		{
-			name: "Test Get Service Chain Combinations 3 services, thirds service has 1 router",
-			wantCombinations: [][]string{
-				{"router1", "router3", "router5"},
-				{"router1", "router4", "router5"},
-				{"router2", "router3", "router5"},
-				{"router2", "router4", "router5"},
-			},
-			serviceRouters: [][]string{
-				{"router1", "router2"},
-				{"router3", "router4"},
-				{"router5"},
-			},
+			name:              "Test get service loads relative to the configured session cap",
+			weight:            1,
+			serviceSessionCap: 4,
+			sessionCounts:     []int{2, 1},
+			wantLoads:         []float64{0.5, 0.25},
 		},
 		{
-			name: "Test Get Service Chain Combinations 3 services all have 2 router",
-			wantCombinations: [][]string{
-				{"router1", "router3", "router5"},
-				{"router1", "router3", "router6"},
-				{"router1", "router4", "router5"},
-				{"router1", "router4", "router6"},
-				{"router2", "router3", "router5"},
-				{"router2", "router3", "router6"},
-				{"router2", "router4", "router5"},
-				{"router2", "router4", "router6"},
-			},
-			serviceRouters: [][]string{
-				{"router1", "router2"},
-				{"router3", "router4"},
-				{"router5", "router6"},
-			},
+			name:          "Test get service loads with reported load above session utilization",
+			load:          80,
+			weight:        1,
+			capacity:      10,
+			sessionCounts: []int{5, 1},
+			wantLoads:     []float64{0.8, 0.8},
+		},
+		{
+			name:          "Test get service loads divided by the weight",
+			load:          80,
+			weight:        2,
+			capacity:      10,
+			sessionCounts: []int{0, 0},
+			wantLoads:     []float64{0.4, 0.4},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			helper.ServiceSessionCap = tt.serviceSessionCap
 			controller := gomock.NewController(t)
 			cacheMock := cache.NewMockCache(controller)
-			graphMock := graph.NewMockGraph(controller)
-			provider := NewCalculationSetupProvider(cacheMock, graphMock)
-			combinations := provider.getServiceChainCombinations(tt.serviceRouters)
-			assert.Equal(t, tt.wantCombinations, combinations)
+			provider := NewCalculationSetupProvider(cacheMock, graph.NewMockGraph(controller))
+			sids := []string{"fc00:0:2f::", "fc00:0:3f::"}
+			var metadata domain.ServiceMetadata
+			if !tt.noMetadata {
+				var err error
+				metadata, err = domain.NewDomainServiceMetadata(tt.load, tt.weight, tt.capacity, 0, 0, 0)
+				assert.NoError(t, err)
+			}
+			for index, sid := range sids {
+				cacheMock.EXPECT().GetServiceMetadata(sid).Return(metadata).AnyTimes()
+				cacheMock.EXPECT().GetServiceSessionCount(sid).Return(tt.sessionCounts[index]).AnyTimes()
+			}
+			serviceLoads := provider.getServiceLoads([][]string{sids})
+			for index, sid := range sids {
+				assert.InDelta(t, tt.wantLoads[index], serviceLoads[sid], 1e-9)
+			}
+		})
+	}
+}
+
+func TestCalculationSetupProvider_getServiceMetadata(t *testing.T) {
+	controller := gomock.NewController(t)
+	cacheMock := cache.NewMockCache(controller)
+	provider := NewCalculationSetupProvider(cacheMock, graph.NewMockGraph(controller))
+	metadata, err := domain.NewDomainServiceMetadata(0, 1, 0, 1000, 100, 0.1)
+	assert.NoError(t, err)
+	cacheMock.EXPECT().GetServiceMetadata("fc00:0:2f::").Return(metadata)
+	cacheMock.EXPECT().GetServiceMetadata("fc00:0:3f::").Return(nil)
+	serviceMetadata := provider.getServiceMetadata([][]string{{"fc00:0:2f::", "fc00:0:3f::"}})
+	assert.Equal(t, map[string]domain.ServiceMetadata{"fc00:0:2f::": metadata}, serviceMetadata)
+}
+
+func TestCalculationSetupProvider_getServicePredecessors(t *testing.T) {
+	fwValue, _ := domain.NewStringValue(domain.ValueTypeSFC, proto.String("fw"))
+	idsValue, _ := domain.NewStringValue(domain.ValueTypeSFC, proto.String("ids"))
+	natValue, _ := domain.NewStringValue(domain.ValueTypeSFC, proto.String("nat"))
+	tests := []struct {
+		name                    string
+		services                []string
+		serviceOrder            []domain.ServiceOrder
+		wantServicePredecessors []uint64
+	}{
+		{
+			name:                    "Test get service predecessors without service order",
+			wantServicePredecessors: []uint64{0, 0, 0},
+		},
+		{
+			name:                    "Test get service predecessors with one service order",
+			serviceOrder:            []domain.ServiceOrder{domain.NewServiceOrder("fw", "ids")},
+			wantServicePredecessors: []uint64{0, 1, 0},
+		},
+		{
+			name:                    "Test get service predecessors with several service orders",
+			serviceOrder:            []domain.ServiceOrder{domain.NewServiceOrder("fw", "ids"), domain.NewServiceOrder("nat", "ids"), domain.NewServiceOrder("nat", "fw")},
+			wantServicePredecessors: []uint64{4, 5, 0},
+		},
+		{
+			name:                    "Test get service predecessors with transitive service order",
+			serviceOrder:            []domain.ServiceOrder{domain.NewServiceOrder("nat", "fw"), domain.NewServiceOrder("fw", "ids")},
+			wantServicePredecessors: []uint64{4, 5, 0},
+		},
+		{
+			name:                    "Test get service predecessors with skipped optional service",
+			services:                []string{"fw", "ids"},
+			serviceOrder:            []domain.ServiceOrder{domain.NewServiceOrder("fw", "nat"), domain.NewServiceOrder("nat", "ids")},
+			wantServicePredecessors: []uint64{0, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			provider := NewCalculationSetupProvider(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			sfcIntent := domain.NewDomainIntent(domain.IntentTypeSFC, []domain.Value{fwValue, idsValue, natValue})
+			sfcIntent.SetUnordered(true)
+			sfcIntent.SetServiceOrder(tt.serviceOrder)
+			services := tt.services
+			if services == nil {
+				services = []string{"fw", "ids", "nat"}
+			}
+			assert.Equal(t, tt.wantServicePredecessors, provider.getServicePredecessors(sfcIntent, services))
 		})
 	}
 }
@@ -810,6 +1187,8 @@ func TestCalculationSetupProvider_PerformServiceFunctionChainSetup(t *testing.T)
 			graphMock := graph.NewMockGraph(controller)
 			provider := NewCalculationSetupProvider(cacheMock, graphMock)
 			cacheMock.EXPECT().GetServiceSids("fw").Return(serviceSids[0]).AnyTimes()
+			cacheMock.EXPECT().GetServiceMetadata(gomock.Any()).Return(nil).AnyTimes()
+			cacheMock.EXPECT().GetServiceSessionCount(gomock.Any()).Return(0).AnyTimes()
 			if tt.wantServiceSidErr {
 				cacheMock.EXPECT().GetServiceSids("ids").Return([]string{}).AnyTimes()
 			} else {
@@ -836,7 +1215,137 @@ func TestCalculationSetupProvider_PerformServiceFunctionChainSetup(t *testing.T)
 				sfcCalculationOptions, err := provider.PerformServiceFunctionChainSetup(sfcIntent, 0)
 				assert.NotNil(t, sfcCalculationOptions)
 				assert.NoError(t, err)
+				assert.Equal(t, [][]string{{"router1", "router2"}, {"router3", "router4"}}, sfcCalculationOptions.serviceRouters)
+				assert.Equal(t, map[string]float64{"fc00:0:2f::": 0, "fc00:0:3f::": 0, "fc00:0:6f::": 0, "fc00:0:7f::": 0}, sfcCalculationOptions.serviceLoads)
+			}
+		})
+	}
+}
+
+func TestCalculationSetupProvider_addHopConstraints(t *testing.T) {
+	tests := []struct {
+		name               string
+		maxHopCount        uint32
+		maxSidDepth        uint32
+		headendSidDepth    uint32
+		headendKnown       bool
+		strictPath         bool
+		wantMaxConstraints map[helper.WeightKey]float64
+	}{
+		{
+			name:               "Test addHopConstraints without limits",
+			wantMaxConstraints: map[helper.WeightKey]float64{},
+		},
+		{
+			name:               "Test addHopConstraints with max hop count",
+			maxHopCount:        5,
+			wantMaxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 5},
+		},
+		{
+			name:               "Test addHopConstraints with requested max SID depth and unknown headend",
+			maxSidDepth:        4,
+			wantMaxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 4},
+		},
+		{
+			name:               "Test addHopConstraints with headend without advertised SID depth",
+			maxSidDepth:        4,
+			headendKnown:       true,
+			wantMaxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 4},
+		},
+		{
+			name:               "Test addHopConstraints with headend SID depth only",
+			headendSidDepth:    3,
+			headendKnown:       true,
+			wantMaxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 3},
+		},
+		{
+			name:               "Test addHopConstraints with headend SID depth lower than requested",
+			maxHopCount:        5,
+			maxSidDepth:        4,
+			headendSidDepth:    3,
+			headendKnown:       true,
+			wantMaxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 5, helper.SidDepthKey: 3},
+		},
+		{
+			name:               "Test addHopConstraints with requested SID depth lower than headend",
+			maxSidDepth:        2,
+			headendSidDepth:    3,
+			headendKnown:       true,
+			wantMaxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 2},
+		},
+		{
+			name:               "Test addHopConstraints with strict path reserves destination SID",
+			maxSidDepth:        4,
+			strictPath:         true,
+			wantMaxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			cacheMock := cache.NewMockCache(controller)
+			provider := NewCalculationSetupProvider(cacheMock, graph.NewMockGraph(controller))
+			pathRequest := domain.NewMockPathRequest(controller)
+			pathRequest.EXPECT().GetMaxHopCount().Return(tt.maxHopCount).AnyTimes()
+			pathRequest.EXPECT().GetMaxSidDepth().Return(tt.maxSidDepth).AnyTimes()
+			pathRequest.EXPECT().GetStrictPath().Return(tt.strictPath).AnyTimes()
+			sourceNode := graph.NewMockNode(controller)
+			sourceNode.EXPECT().GetId().Return("0000.0000.0001").AnyTimes()
+			sourceNode.EXPECT().GetName().Return("XR-1").AnyTimes()
+			if tt.headendKnown {
+				headend, err := domain.NewDomainNode(proto.String("2_0_0_0000.0000.0001"), proto.String("0000.0000.0001"), proto.String("XR-1"), []uint32{0})
+				assert.NoError(t, err)
+				headend.SetMaximumSidDepth(tt.headendSidDepth)
+				cacheMock.EXPECT().GetNodeByIgpRouterId("0000.0000.0001").Return(headend)
+			} else {
+				cacheMock.EXPECT().GetNodeByIgpRouterId("0000.0000.0001").Return(nil)
 			}
+			maxConstraints := make(map[helper.WeightKey]float64)
+			provider.addHopConstraints(pathRequest, sourceNode, maxConstraints)
+			assert.Equal(t, tt.wantMaxConstraints, maxConstraints)
+		})
+	}
+}
+
+func TestCalculationSetupProvider_addBandwidthDemand(t *testing.T) {
+	tests := []struct {
+		name               string
+		bandwidthDemand    uint32
+		minConstraints     map[helper.WeightKey]float64
+		wantMinConstraints map[helper.WeightKey]float64
+	}{
+		{
+			name:               "Test addBandwidthDemand without demand",
+			minConstraints:     map[helper.WeightKey]float64{},
+			wantMinConstraints: map[helper.WeightKey]float64{},
+		},
+		{
+			name:               "Test addBandwidthDemand with demand",
+			bandwidthDemand:    1000,
+			minConstraints:     map[helper.WeightKey]float64{},
+			wantMinConstraints: map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 1000},
+		},
+		{
+			name:               "Test addBandwidthDemand with demand above min bandwidth",
+			bandwidthDemand:    1000,
+			minConstraints:     map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 500},
+			wantMinConstraints: map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 1000},
+		},
+		{
+			name:               "Test addBandwidthDemand with demand below min bandwidth",
+			bandwidthDemand:    1000,
+			minConstraints:     map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 5000},
+			wantMinConstraints: map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 5000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			provider := NewCalculationSetupProvider(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			pathRequest := domain.NewMockPathRequest(controller)
+			pathRequest.EXPECT().GetBandwidthDemand().Return(tt.bandwidthDemand)
+			provider.addBandwidthDemand(pathRequest, tt.minConstraints)
+			assert.Equal(t, tt.wantMinConstraints, tt.minConstraints)
 		})
 	}
 }
@@ -890,7 +1399,10 @@ func TestCalculationSetupProvider_PerformSetup(t *testing.T) {
 				cacheMock.EXPECT().GetRouterIdFromNetworkAddress("2001:db8:1::").Return("").AnyTimes()
 			} else {
 				cacheMock.EXPECT().GetRouterIdFromNetworkAddress("2001:db8:1::").Return("routerId").AnyTimes()
-				graphMock.EXPECT().GetNode("routerId").Return(graph.NewMockNode(controller)).AnyTimes()
+				sourceNode := graph.NewMockNode(controller)
+				sourceNode.EXPECT().GetId().Return("routerId").AnyTimes()
+				graphMock.EXPECT().GetNode("routerId").Return(sourceNode).AnyTimes()
+				cacheMock.EXPECT().GetNodeByIgpRouterId("routerId").Return(nil).AnyTimes()
 			}
 			if tt.destinationNodeErr {
 				cacheMock.EXPECT().GetRouterIdFromNetworkAddress("2001:db8:2::").Return("").AnyTimes()
@@ -0,0 +1,151 @@
+package calculation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDefaultWeights(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int
+		want  []float64
+	}{
+		{
+			name:  "Test getDefaultWeights two factors",
+			count: 2,
+			want:  helper.TwoFactorWeights,
+		},
+		{
+			name:  "Test getDefaultWeights three factors",
+			count: 3,
+			want:  helper.ThreeFactorWeights,
+		},
+		{
+			name:  "Test getDefaultWeights four factors",
+			count: 4,
+			want:  []float64{0.25, 0.25, 0.25, 0.25},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getDefaultWeights(tt.count))
+		})
+	}
+}
+
+func TestGetWeightedEdgeValue(t *testing.T) {
+	node1 := graph.NewNetworkNode("1", "1", []uint32{0})
+	node2 := graph.NewNetworkNode("2", "2", []uint32{0})
+	edge := graph.NewNetworkEdge("1", node1, node2, map[helper.WeightKey]float64{
+		helper.NormalizedLatencyKey:    0.2,
+		helper.NormalizedJitterKey:     0.4,
+		helper.NormalizedPacketLossKey: 0.6,
+		helper.AvailableBandwidthKey:   0.8,
+	})
+	tests := []struct {
+		name       string
+		weightKeys []helper.WeightKey
+		weights    []float64
+		want       float64
+	}{
+		{
+			name:       "Test getWeightedEdgeValue single weight key",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey},
+			want:       0.2,
+		},
+		{
+			name:       "Test getWeightedEdgeValue default weights",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey},
+			want:       0.2*helper.TwoFactorWeights[0] + 0.4*helper.TwoFactorWeights[1],
+		},
+		{
+			name:       "Test getWeightedEdgeValue request weights",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey},
+			weights:    []float64{0.5, 0.5},
+			want:       0.3,
+		},
+		{
+			name:       "Test getWeightedEdgeValue four request weights",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey, helper.NormalizedPacketLossKey, helper.AvailableBandwidthKey},
+			weights:    []float64{0.1, 0.2, 0.3, 0.4},
+			want:       0.2*0.1 + 0.4*0.2 + 0.6*0.3 + 0.8*0.4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, almostEqual(tt.want, getWeightedEdgeValue(edge, tt.weightKeys, tt.weights)))
+		})
+	}
+}
+
+func TestGetTieBreakingPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		policyName string
+		want       TieBreakingPolicy
+	}{
+		{
+			name:       "Test getTieBreakingPolicy lowest router ID",
+			policyName: "lowest-router-id",
+			want:       TieBreakingLowestRouterId,
+		},
+		{
+			name:       "Test getTieBreakingPolicy fewest hops",
+			policyName: "fewest-hops",
+			want:       TieBreakingFewestHops,
+		},
+		{
+			name:       "Test getTieBreakingPolicy incumbent",
+			policyName: "incumbent",
+			want:       TieBreakingIncumbent,
+		},
+		{
+			name:       "Test getTieBreakingPolicy unknown policy",
+			policyName: "random",
+			want:       TieBreakingLowestRouterId,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getTieBreakingPolicy(tt.policyName))
+		})
+	}
+}
+
+func TestIsEqualCost(t *testing.T) {
+	tests := []struct {
+		name      string
+		cost      float64
+		otherCost float64
+		want      bool
+	}{
+		{
+			name:      "Test isEqualCost with rounding difference",
+			cost:      0.1 + 0.2,
+			otherCost: 0.3,
+			want:      true,
+		},
+		{
+			name:      "Test isEqualCost with different costs",
+			cost:      1000,
+			otherCost: 1001,
+			want:      false,
+		},
+		{
+			name:      "Test isEqualCost with infinite cost",
+			cost:      1000,
+			otherCost: math.Inf(1),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isEqualCost(tt.cost, tt.otherCost))
+		})
+	}
+}
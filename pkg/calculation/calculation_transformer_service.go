@@ -1,56 +1,286 @@
 package calculation
 
 import (
+	"net/netip"
+
 	"github.com/hawkv6/hawkeye/pkg/cache"
 	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/hawkv6/hawkeye/pkg/logging"
 	"github.com/sirupsen/logrus"
 )
 
 type CalculationTransformerService struct {
-	log   *logrus.Entry
-	cache cache.Cache
+	log             *logrus.Entry
+	cache           cache.Cache
+	graph           graph.Graph
+	minimizeSidList bool
+	compressSidList bool
 }
 
-func NewCalculationTransformerService(cache cache.Cache) *CalculationTransformerService {
+func NewCalculationTransformerService(cache cache.Cache, graph graph.Graph) *CalculationTransformerService {
 	return &CalculationTransformerService{
-		log:   logging.DefaultLogger.WithField("subsystem", subsystem),
-		cache: cache,
+		log:             logging.DefaultLogger.WithField("subsystem", subsystem),
+		cache:           cache,
+		graph:           graph,
+		minimizeSidList: helper.MinimizeSidList,
+		compressSidList: helper.CompressSidList,
+	}
+}
+
+func (service *CalculationTransformerService) getAlgorithmGraph(algorithm uint32) graph.Graph {
+	if algorithm == 0 {
+		return service.graph
+	}
+	return service.graph.GetSubGraph(algorithm)
+}
+
+// a segment is either the node SID of a router or the End.X SID of a link starting at the router
+type segment struct {
+	nodeId       string
+	adjacencySid string
+}
+
+func (service *CalculationTransformerService) getShortestPathTree(tree *igpShortestPathTree, algorithmGraph graph.Graph, segmentStartEdge graph.Edge) *igpShortestPathTree {
+	if tree == nil {
+		return newIgpShortestPathTree(algorithmGraph, segmentStartEdge.From().GetId())
+	}
+	return tree
+}
+
+// an End.X SID is used if the link is not the only IGP (or flex algo) shortest path to its neighbour, e.g. one of several parallel links
+func (service *CalculationTransformerService) isAdjacencySidRequired(tree *igpShortestPathTree, edge graph.Edge, strictPath bool) bool {
+	if strictPath {
+		return true
+	}
+	return !tree.isUniqueShortestPath(edge.To().GetId(), edge.GetWeight(helper.IgpMetricKey))
+}
+
+// a node SID is only needed where the IGP (or flex algo) shortest path from the previous segment endpoint diverges from the path
+func (service *CalculationTransformerService) isSegmentEnd(tree *igpShortestPathTree, nodeId string, cost float64, nextEdge graph.Edge, routerServiceMap map[string]string, strictPath bool) bool {
+	if _, isServiceRouter := routerServiceMap[nodeId]; isServiceRouter {
+		return true
+	}
+	if nextEdge == nil || strictPath || !service.minimizeSidList {
+		return true
+	}
+	return !tree.isUniqueShortestPath(nextEdge.To().GetId(), cost+nextEdge.GetWeight(helper.IgpMetricKey))
+}
+
+func (service *CalculationTransformerService) getSegmentsFromPath(path graph.Path, routerServiceMap map[string]string, algorithm uint32, strictPath bool) []segment {
+	edges := path.GetEdges()
+	segments := make([]segment, 0, len(edges))
+	algorithmGraph := service.getAlgorithmGraph(algorithm)
+	var tree *igpShortestPathTree
+	segmentStartIndex := 0
+	cost := 0.0
+	for index, edge := range edges {
+		nodeId := edge.To().GetId()
+		var nextEdge graph.Edge
+		if index < len(edges)-1 {
+			nextEdge = edges[index+1]
+		}
+		if index == segmentStartIndex {
+			adjacencySid := service.cache.GetAdjacencySid(edge.GetId(), algorithm)
+			if adjacencySid == "" && strictPath {
+				service.log.Warnf("No End.X SID found for link %s, use node SID instead", edge.GetId())
+			}
+			if adjacencySid != "" && !strictPath {
+				tree = service.getShortestPathTree(tree, algorithmGraph, edge)
+			}
+			if adjacencySid != "" && service.isAdjacencySidRequired(tree, edge, strictPath) {
+				segments = append(segments, segment{nodeId: edge.From().GetId(), adjacencySid: adjacencySid})
+				if _, isServiceRouter := routerServiceMap[nodeId]; isServiceRouter || nextEdge == nil {
+					segments = append(segments, segment{nodeId: nodeId})
+				}
+				segmentStartIndex, tree = index+1, nil
+				continue
+			}
+		}
+		if nextEdge != nil && service.minimizeSidList && !strictPath {
+			cost += edge.GetWeight(helper.IgpMetricKey)
+			tree = service.getShortestPathTree(tree, algorithmGraph, edges[segmentStartIndex])
+		}
+		if service.isSegmentEnd(tree, nodeId, cost, nextEdge, routerServiceMap, strictPath) {
+			segments = append(segments, segment{nodeId: nodeId})
+			segmentStartIndex, tree, cost = index+1, nil, 0
+		}
+	}
+	service.log.Debugf("Translated path with %d links to %d segments", len(edges), len(segments))
+	return segments
+}
+
+// the SID structure is only needed to compress the SID list
+func (service *CalculationTransformerService) getNodeSid(nodeId string, algorithm uint32) (string, domain.Sid) {
+	if !service.compressSidList {
+		return service.cache.GetSrAlgorithmSid(nodeId, algorithm), nil
+	}
+	nodeSid := service.cache.GetSrAlgorithmDomainSid(nodeId, algorithm)
+	if nodeSid == nil {
+		return "", nil
 	}
+	return nodeSid.GetSid(), nodeSid
 }
 
-func (service *CalculationTransformerService) getNodesFromPath(path graph.Path) []string {
-	nodeList := make([]string, 0)
-	for _, edge := range path.GetEdges() {
-		to := edge.To().GetId()
-		nodeList = append(nodeList, to)
+type microSid struct {
+	address        [16]byte
+	blockLength    uint32
+	locatorLength  uint32
+	microSidLength uint32
+	value          uint64
+}
+
+// a node SID is compressible if its SID structure is advertised and nothing follows the node and function part
+func (service *CalculationTransformerService) getNodeMicroSid(address [16]byte, nodeSid domain.Sid) *microSid {
+	blockLength := nodeSid.GetLocatorBlockLength()
+	microSidLength := nodeSid.GetLocatorNodeLength() + nodeSid.GetFunctionLength()
+	if blockLength == 0 || nodeSid.GetLocatorNodeLength() == 0 || microSidLength > 64 || blockLength+microSidLength > ipv6AddressLength {
+		return nil
+	}
+	if !hasZeroBitsFrom(address, blockLength+microSidLength) {
+		return nil
+	}
+	return &microSid{
+		address:        address,
+		blockLength:    blockLength,
+		locatorLength:  blockLength + nodeSid.GetLocatorNodeLength(),
+		microSidLength: microSidLength,
+		value:          getAddressBits(address, blockLength, microSidLength),
 	}
-	return nodeList
 }
 
-func (service *CalculationTransformerService) translatePathToSidList(path graph.Path, algorithm uint32) ([]string, []string) {
-	nodeList := service.getNodesFromPath(path)
+// a service SID is compressible if it is a local function of the preceding node micro SID
+func (service *CalculationTransformerService) getServiceMicroSid(address [16]byte, nodeMicroSid *microSid) *microSid {
+	if nodeMicroSid == nil || nodeMicroSid.locatorLength+nodeMicroSid.microSidLength > ipv6AddressLength {
+		return nil
+	}
+	if !haveEqualPrefix(address, nodeMicroSid.address, nodeMicroSid.locatorLength) || !hasZeroBitsFrom(address, nodeMicroSid.locatorLength+nodeMicroSid.microSidLength) {
+		return nil
+	}
+	return &microSid{
+		address:        address,
+		blockLength:    nodeMicroSid.blockLength,
+		locatorLength:  nodeMicroSid.locatorLength,
+		microSidLength: nodeMicroSid.microSidLength,
+		value:          getAddressBits(address, nodeMicroSid.locatorLength, nodeMicroSid.microSidLength),
+	}
+}
+
+// consecutive SIDs sharing a micro SID block are packed into compressed SID containers, all others stay full SIDs
+func (service *CalculationTransformerService) packMicroSids(sidList []string, nodeSids []domain.Sid) []string {
+	packedSidList := make([]string, 0, len(sidList))
+	var container *microSidContainer
+	var previousMicroSid *microSid
+	for index, sid := range sidList {
+		var currentMicroSid *microSid
+		if address, err := netip.ParseAddr(sid); err == nil && address.Is6() {
+			if nodeSids[index] != nil {
+				currentMicroSid = service.getNodeMicroSid(address.As16(), nodeSids[index])
+			} else {
+				currentMicroSid = service.getServiceMicroSid(address.As16(), previousMicroSid)
+			}
+		}
+		previousMicroSid = currentMicroSid
+		if currentMicroSid == nil {
+			if container != nil {
+				packedSidList = append(packedSidList, container.String())
+				container = nil
+			}
+			packedSidList = append(packedSidList, sid)
+			continue
+		}
+		if container != nil && (container.isFull() || !container.sharesBlock(currentMicroSid.address, currentMicroSid.blockLength, currentMicroSid.microSidLength)) {
+			packedSidList = append(packedSidList, container.String())
+			container = nil
+		}
+		if container == nil {
+			container = newMicroSidContainer(currentMicroSid.address, currentMicroSid.blockLength, currentMicroSid.microSidLength)
+		}
+		container.addMicroSid(currentMicroSid.value)
+	}
+	if container != nil {
+		packedSidList = append(packedSidList, container.String())
+	}
+	service.log.Debugf("Compressed SID list from %d to %d SIDs", len(sidList), len(packedSidList))
+	return packedSidList
+}
+
+func (service *CalculationTransformerService) translatePathToSidList(path graph.Path, algorithm uint32, strictPath bool) ([]string, []string) {
 	serviceSidList := make([]string, 0)
 	routerServiceMap := path.GetRouterServiceMap()
-	service.log.Debugln("Node in Path: ", nodeList)
+	segments := service.getSegmentsFromPath(path, routerServiceMap, algorithm, strictPath)
+	service.log.Debugln("Segments in Path: ", segments)
 	var sidList []string
-	for _, node := range nodeList {
-		sid := service.cache.GetSrAlgorithmSid(node, algorithm)
+	var nodeSids []domain.Sid
+	for _, segment := range segments {
+		if segment.adjacencySid != "" {
+			sidList = append(sidList, segment.adjacencySid)
+			nodeSids = append(nodeSids, nil)
+			continue
+		}
+		node := segment.nodeId
+		sid, nodeSid := service.getNodeSid(node, algorithm)
 		if sid == "" {
 			service.log.Errorln("SID not found for router: ", node)
 			continue
 		}
 		sidList = append(sidList, sid)
+		nodeSids = append(nodeSids, nodeSid)
 		if serviceSid, ok := routerServiceMap[node]; ok {
 			sidList = append(sidList, serviceSid)
+			nodeSids = append(nodeSids, nil)
 			serviceSidList = append(serviceSidList, serviceSid)
 		}
 	}
+	if service.compressSidList {
+		sidList = service.packMicroSids(sidList, nodeSids)
+	}
 	service.log.Debugln("Translated SID List: ", sidList)
 	return sidList, serviceSidList
 }
 
+func (service *CalculationTransformerService) isStrictPath(pathRequest domain.PathRequest) bool {
+	return pathRequest != nil && pathRequest.GetStrictPath()
+}
+
+func (service *CalculationTransformerService) transformPaths(paths []graph.Path, pathRequest domain.PathRequest, algorithm uint32) []domain.PathResult {
+	pathResults := make([]domain.PathResult, 0, len(paths))
+	for _, path := range paths {
+		sidList, serviceSidList := service.translatePathToSidList(path, algorithm, service.isStrictPath(pathRequest))
+		pathResult, err := domain.NewDomainPathResult(pathRequest, path, sidList)
+		if err != nil {
+			service.log.Errorln("Error creating path result: ", err)
+			continue
+		}
+		pathResult.SetServiceSidList(serviceSidList)
+		pathResults = append(pathResults, pathResult)
+	}
+	return pathResults
+}
+
+func (service *CalculationTransformerService) transformAlternativePaths(path graph.Path, pathRequest domain.PathRequest, algorithm uint32) []domain.PathResult {
+	return service.transformPaths(path.GetAlternativePaths(), pathRequest, algorithm)
+}
+
+func (service *CalculationTransformerService) transformParetoPaths(path graph.Path, pathRequest domain.PathRequest, algorithm uint32) []domain.PathResult {
+	return service.transformPaths(path.GetParetoPaths(), pathRequest, algorithm)
+}
+
+func (service *CalculationTransformerService) transformBackupPath(path graph.Path, pathRequest domain.PathRequest, algorithm uint32) domain.PathResult {
+	backupPath := path.GetBackupPath()
+	if backupPath == nil {
+		return nil
+	}
+	sidList, _ := service.translatePathToSidList(backupPath, algorithm, service.isStrictPath(pathRequest))
+	backupResult, err := domain.NewDomainPathResult(pathRequest, backupPath, sidList)
+	if err != nil {
+		service.log.Errorln("Error creating backup path result: ", err)
+		return nil
+	}
+	return backupResult
+}
+
 func (service *CalculationTransformerService) TransformResult(path graph.Path, pathRequest domain.PathRequest, algorithm uint32) domain.PathResult {
 	var sidList []string
 	var serviceSidList []string
@@ -58,7 +288,7 @@ func (service *CalculationTransformerService) TransformResult(path graph.Path, p
 		service.log.Errorln("No path found, return destination IPv6 address as SID list")
 		sidList = []string{pathRequest.GetIpv6DestinationAddress()}
 	} else {
-		sidList, serviceSidList = service.translatePathToSidList(path, algorithm)
+		sidList, serviceSidList = service.translatePathToSidList(path, algorithm, service.isStrictPath(pathRequest))
 	}
 	pathResult, err := domain.NewDomainPathResult(pathRequest, path, sidList)
 	if err != nil {
@@ -66,5 +296,11 @@ func (service *CalculationTransformerService) TransformResult(path graph.Path, p
 		return nil
 	}
 	pathResult.SetServiceSidList(serviceSidList)
+	pathResult.SetFlexAlgoNumber(algorithm)
+	if path != nil {
+		pathResult.SetAlternativePathResults(service.transformAlternativePaths(path, pathRequest, algorithm))
+		pathResult.SetBackupPathResult(service.transformBackupPath(path, pathRequest, algorithm))
+		pathResult.SetParetoPathResults(service.transformParetoPaths(path, pathRequest, algorithm))
+	}
 	return pathResult
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/hawkv6/hawkeye/pkg/cache"
 	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -22,31 +23,8 @@ func TestNewCalculationTransformerService(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			controller := gomock.NewController(t)
 			cache := cache.NewMockCache(controller)
-			assert.NotNil(t, NewCalculationTransformerService(cache))
-		})
-	}
-}
-
-func TestCalculationTransformerService_getNodesFromPath(t *testing.T) {
-	tests := []struct {
-		name string
-	}{
-		{
-			name: "TestCalculationTransformerService_getNodesFromPath",
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			controller := gomock.NewController(t)
-			cache := cache.NewMockCache(controller)
-			service := NewCalculationTransformerService(cache)
-			path := graph.NewMockPath(controller)
-			edge := graph.NewMockEdge(controller)
-			path.EXPECT().GetEdges().Return([]graph.Edge{edge})
-			to := graph.NewMockNode(controller)
-			to.EXPECT().GetId().Return("to")
-			edge.EXPECT().To().Return(to)
-			assert.Equal(t, []string{"to"}, service.getNodesFromPath(path))
+			cache.EXPECT().GetAdjacencySid(gomock.Any(), gomock.Any()).Return("").AnyTimes()
+			assert.NotNil(t, NewCalculationTransformerService(cache, graph.NewMockGraph(controller)))
 		})
 	}
 }
@@ -78,16 +56,18 @@ func TestCalculationTransformerService_translatePathToSidList(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			controller := gomock.NewController(t)
 			cache := cache.NewMockCache(controller)
-			service := NewCalculationTransformerService(cache)
+			cache.EXPECT().GetAdjacencySid(gomock.Any(), gomock.Any()).Return("").AnyTimes()
+			service := NewCalculationTransformerService(cache, graph.NewMockGraph(controller))
 			path := graph.NewMockPath(controller)
 			edge := graph.NewMockEdge(controller)
 			path.EXPECT().GetEdges().Return([]graph.Edge{edge})
 			to := graph.NewMockNode(controller)
 			to.EXPECT().GetId().Return(tt.nodeId)
 			edge.EXPECT().To().Return(to)
+			edge.EXPECT().GetId().Return("link")
 			path.EXPECT().GetRouterServiceMap().Return(map[string]string{tt.nodeId: tt.serviceSid})
 			cache.EXPECT().GetSrAlgorithmSid(tt.nodeId, uint32(0)).Return(tt.nodeSid)
-			gotSidList, gotServiceSidList := service.translatePathToSidList(path, uint32(0))
+			gotSidList, gotServiceSidList := service.translatePathToSidList(path, uint32(0), false)
 			if tt.nodeSid == "" {
 				assert.Len(t, gotSidList, 0)
 				return
@@ -98,6 +78,222 @@ func TestCalculationTransformerService_translatePathToSidList(t *testing.T) {
 	}
 }
 
+func setUpMicroSid(igpRouterId, sidValue string, blockLength, nodeLength uint32) domain.Sid {
+	sid, _ := domain.NewDomainSid(&sidValue, &igpRouterId, &sidValue, new(uint32))
+	sid.SetSidStructure(blockLength, nodeLength, 0)
+	return sid
+}
+
+func TestCalculationTransformerService_packMicroSids(t *testing.T) {
+	tests := []struct {
+		name     string
+		sidList  []string
+		nodeSids []domain.Sid
+		want     []string
+	}{
+		{
+			name:     "Test packMicroSids node SIDs sharing block",
+			sidList:  []string{"fcbb:bb00:1::", "fcbb:bb00:2::", "fcbb:bb00:3::"},
+			nodeSids: []domain.Sid{setUpMicroSid("1", "fcbb:bb00:1::", 32, 16), setUpMicroSid("2", "fcbb:bb00:2::", 32, 16), setUpMicroSid("3", "fcbb:bb00:3::", 32, 16)},
+			want:     []string{"fcbb:bb00:1:2:3::"},
+		},
+		{
+			name:     "Test packMicroSids service SID of preceding node",
+			sidList:  []string{"fcbb:bb00:1::", "fcbb:bb00:2::", "fcbb:bb00:2:e001::", "fcbb:bb00:3::"},
+			nodeSids: []domain.Sid{setUpMicroSid("1", "fcbb:bb00:1::", 32, 16), setUpMicroSid("2", "fcbb:bb00:2::", 32, 16), nil, setUpMicroSid("3", "fcbb:bb00:3::", 32, 16)},
+			want:     []string{"fcbb:bb00:1:2:e001:3::"},
+		},
+		{
+			name:     "Test packMicroSids service SID of other locator stays full SID",
+			sidList:  []string{"fcbb:bb00:1::", "fcbb:bb00:2::", "fc00:0:f:0:1::", "fcbb:bb00:3::"},
+			nodeSids: []domain.Sid{setUpMicroSid("1", "fcbb:bb00:1::", 32, 16), setUpMicroSid("2", "fcbb:bb00:2::", 32, 16), nil, setUpMicroSid("3", "fcbb:bb00:3::", 32, 16)},
+			want:     []string{"fcbb:bb00:1:2::", "fc00:0:f:0:1::", "fcbb:bb00:3::"},
+		},
+		{
+			name:     "Test packMicroSids full container",
+			sidList:  []string{"fcbb:bb00:1::", "fcbb:bb00:2::", "fcbb:bb00:3::", "fcbb:bb00:4::", "fcbb:bb00:5::", "fcbb:bb00:6::", "fcbb:bb00:7::"},
+			nodeSids: []domain.Sid{setUpMicroSid("1", "fcbb:bb00:1::", 32, 16), setUpMicroSid("2", "fcbb:bb00:2::", 32, 16), setUpMicroSid("3", "fcbb:bb00:3::", 32, 16), setUpMicroSid("4", "fcbb:bb00:4::", 32, 16), setUpMicroSid("5", "fcbb:bb00:5::", 32, 16), setUpMicroSid("6", "fcbb:bb00:6::", 32, 16), setUpMicroSid("7", "fcbb:bb00:7::", 32, 16)},
+			want:     []string{"fcbb:bb00:1:2:3:4:5:6", "fcbb:bb00:7::"},
+		},
+		{
+			name:     "Test packMicroSids different blocks",
+			sidList:  []string{"fcbb:bb00:1::", "fcbb:bb01:2::"},
+			nodeSids: []domain.Sid{setUpMicroSid("1", "fcbb:bb00:1::", 32, 16), setUpMicroSid("2", "fcbb:bb01:2::", 32, 16)},
+			want:     []string{"fcbb:bb00:1::", "fcbb:bb01:2::"},
+		},
+		{
+			name:     "Test packMicroSids full SIDs without SID structure",
+			sidList:  []string{"fc00:0:1:0:1::", "fc00:0:2:0:1::"},
+			nodeSids: []domain.Sid{setUpMicroSid("1", "fc00:0:1:0:1::", 0, 0), setUpMicroSid("2", "fc00:0:2:0:1::", 0, 0)},
+			want:     []string{"fc00:0:1:0:1::", "fc00:0:2:0:1::"},
+		},
+		{
+			name:     "Test packMicroSids SID with function bits after micro SID",
+			sidList:  []string{"fcbb:bb00:1::", "fcbb:bb00:2:0:1::"},
+			nodeSids: []domain.Sid{setUpMicroSid("1", "fcbb:bb00:1::", 32, 16), setUpMicroSid("2", "fcbb:bb00:2:0:1::", 32, 16)},
+			want:     []string{"fcbb:bb00:1::", "fcbb:bb00:2:0:1::"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			service := NewCalculationTransformerService(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			assert.Equal(t, tt.want, service.packMicroSids(tt.sidList, tt.nodeSids))
+		})
+	}
+}
+
+func TestCalculationTransformerService_translatePathToSidList_compressed(t *testing.T) {
+	controller := gomock.NewController(t)
+	cacheMock := cache.NewMockCache(controller)
+	cacheMock.EXPECT().GetAdjacencySid("link", uint32(0)).Return("")
+	service := NewCalculationTransformerService(cacheMock, graph.NewMockGraph(controller))
+	service.compressSidList = true
+	path := graph.NewMockPath(controller)
+	edge := graph.NewMockEdge(controller)
+	path.EXPECT().GetEdges().Return([]graph.Edge{edge})
+	to := graph.NewMockNode(controller)
+	to.EXPECT().GetId().Return("to")
+	edge.EXPECT().To().Return(to)
+	edge.EXPECT().GetId().Return("link")
+	path.EXPECT().GetRouterServiceMap().Return(map[string]string{"to": "fcbb:bb00:2:e001::"})
+	cacheMock.EXPECT().GetSrAlgorithmDomainSid("to", uint32(0)).Return(setUpMicroSid("to", "fcbb:bb00:2::", 32, 16))
+	gotSidList, gotServiceSidList := service.translatePathToSidList(path, uint32(0), false)
+	assert.Equal(t, []string{"fcbb:bb00:2:e001::"}, gotSidList)
+	assert.Equal(t, []string{"fcbb:bb00:2:e001::"}, gotServiceSidList)
+}
+
+func setUpPathRequestMock(controller *gomock.Controller, strictPath bool) *domain.MockPathRequest {
+	pathRequest := domain.NewMockPathRequest(controller)
+	pathRequest.EXPECT().GetStrictPath().Return(strictPath).AnyTimes()
+	return pathRequest
+}
+
+func TestCalculationTransformerService_transformAlternativePaths(t *testing.T) {
+	tests := []struct {
+		name        string
+		nodeSid     string
+		wantResults int
+	}{
+		{
+			name:        "TestCalculationTransformerService_transformAlternativePaths success",
+			nodeSid:     "2001:db8:1::",
+			wantResults: 1,
+		},
+		{
+			name:        "TestCalculationTransformerService_transformAlternativePaths no node sid",
+			wantResults: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			cache := cache.NewMockCache(controller)
+			cache.EXPECT().GetAdjacencySid(gomock.Any(), gomock.Any()).Return("").AnyTimes()
+			service := NewCalculationTransformerService(cache, graph.NewMockGraph(controller))
+			path := graph.NewMockPath(controller)
+			alternativePath := graph.NewMockPath(controller)
+			edge := graph.NewMockEdge(controller)
+			to := graph.NewMockNode(controller)
+			to.EXPECT().GetId().Return("to")
+			edge.EXPECT().To().Return(to)
+			edge.EXPECT().GetId().Return("link")
+			alternativePath.EXPECT().GetEdges().Return([]graph.Edge{edge})
+			alternativePath.EXPECT().GetRouterServiceMap().Return(map[string]string{})
+			path.EXPECT().GetAlternativePaths().Return([]graph.Path{alternativePath})
+			cache.EXPECT().GetSrAlgorithmSid("to", uint32(0)).Return(tt.nodeSid)
+			alternativeResults := service.transformAlternativePaths(path, setUpPathRequestMock(controller, false), uint32(0))
+			assert.Len(t, alternativeResults, tt.wantResults)
+			if tt.wantResults > 0 {
+				assert.Equal(t, []string{tt.nodeSid}, alternativeResults[0].GetIpv6SidAddresses())
+			}
+		})
+	}
+}
+
+func TestCalculationTransformerService_transformParetoPaths(t *testing.T) {
+	controller := gomock.NewController(t)
+	cache := cache.NewMockCache(controller)
+	cache.EXPECT().GetAdjacencySid(gomock.Any(), gomock.Any()).Return("").AnyTimes()
+	service := NewCalculationTransformerService(cache, graph.NewMockGraph(controller))
+	path := graph.NewMockPath(controller)
+	paretoPaths := make([]graph.Path, 0)
+	for _, nodeId := range []string{"first", "second"} {
+		paretoPath := graph.NewMockPath(controller)
+		edge := graph.NewMockEdge(controller)
+		to := graph.NewMockNode(controller)
+		to.EXPECT().GetId().Return(nodeId)
+		edge.EXPECT().To().Return(to)
+		edge.EXPECT().GetId().Return("link")
+		paretoPath.EXPECT().GetEdges().Return([]graph.Edge{edge})
+		paretoPath.EXPECT().GetRouterServiceMap().Return(map[string]string{})
+		paretoPaths = append(paretoPaths, paretoPath)
+	}
+	path.EXPECT().GetParetoPaths().Return(paretoPaths)
+	cache.EXPECT().GetSrAlgorithmSid("first", uint32(0)).Return("2001:db8:1::")
+	cache.EXPECT().GetSrAlgorithmSid("second", uint32(0)).Return("2001:db8:2::")
+	paretoResults := service.transformParetoPaths(path, setUpPathRequestMock(controller, false), uint32(0))
+	assert.Len(t, paretoResults, 2)
+	assert.Equal(t, []string{"2001:db8:1::"}, paretoResults[0].GetIpv6SidAddresses())
+	assert.Equal(t, []string{"2001:db8:2::"}, paretoResults[1].GetIpv6SidAddresses())
+}
+
+func TestCalculationTransformerService_transformBackupPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		hasBackup  bool
+		nodeSid    string
+		wantResult bool
+	}{
+		{
+			name:       "TestCalculationTransformerService_transformBackupPath success",
+			hasBackup:  true,
+			nodeSid:    "2001:db8:1::",
+			wantResult: true,
+		},
+		{
+			name:       "TestCalculationTransformerService_transformBackupPath no node sid",
+			hasBackup:  true,
+			wantResult: false,
+		},
+		{
+			name:       "TestCalculationTransformerService_transformBackupPath no backup path",
+			hasBackup:  false,
+			wantResult: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			cache := cache.NewMockCache(controller)
+			cache.EXPECT().GetAdjacencySid(gomock.Any(), gomock.Any()).Return("").AnyTimes()
+			service := NewCalculationTransformerService(cache, graph.NewMockGraph(controller))
+			path := graph.NewMockPath(controller)
+			if !tt.hasBackup {
+				path.EXPECT().GetBackupPath().Return(nil)
+				assert.Nil(t, service.transformBackupPath(path, setUpPathRequestMock(controller, false), uint32(0)))
+				return
+			}
+			backupPath := graph.NewMockPath(controller)
+			edge := graph.NewMockEdge(controller)
+			to := graph.NewMockNode(controller)
+			to.EXPECT().GetId().Return("to")
+			edge.EXPECT().To().Return(to)
+			edge.EXPECT().GetId().Return("link")
+			backupPath.EXPECT().GetEdges().Return([]graph.Edge{edge})
+			backupPath.EXPECT().GetRouterServiceMap().Return(map[string]string{})
+			path.EXPECT().GetBackupPath().Return(backupPath)
+			cache.EXPECT().GetSrAlgorithmSid("to", uint32(0)).Return(tt.nodeSid)
+			backupResult := service.transformBackupPath(path, setUpPathRequestMock(controller, false), uint32(0))
+			if tt.wantResult {
+				assert.Equal(t, []string{tt.nodeSid}, backupResult.GetIpv6SidAddresses())
+			} else {
+				assert.Nil(t, backupResult)
+			}
+		})
+	}
+}
+
 func TestCalculationTransformerService_TransformResult(t *testing.T) {
 	// sourceIpv6Address := "2001:db8:1::"
 	destinationIpv6Address := "2001:db8:2::"
@@ -129,7 +325,8 @@ func TestCalculationTransformerService_TransformResult(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			controller := gomock.NewController(t)
 			cache := cache.NewMockCache(controller)
-			service := NewCalculationTransformerService(cache)
+			cache.EXPECT().GetAdjacencySid(gomock.Any(), gomock.Any()).Return("").AnyTimes()
+			service := NewCalculationTransformerService(cache, graph.NewMockGraph(controller))
 			if tt.pathIsNil {
 				pathRequest := domain.NewMockPathRequest(controller)
 				pathRequest.EXPECT().GetIpv6DestinationAddress().Return(destinationIpv6Address)
@@ -144,11 +341,15 @@ func TestCalculationTransformerService_TransformResult(t *testing.T) {
 				to := graph.NewMockNode(controller)
 				to.EXPECT().GetId().Return(nodeId)
 				edge.EXPECT().To().Return(to)
+				edge.EXPECT().GetId().Return("link")
 				path.EXPECT().GetRouterServiceMap().Return(map[string]string{nodeId: serviceSid})
 				cache.EXPECT().GetSrAlgorithmSid(nodeId, uint32(0)).Return(nodeSid)
+				path.EXPECT().GetAlternativePaths().Return([]graph.Path{}).AnyTimes()
+				path.EXPECT().GetBackupPath().Return(nil).AnyTimes()
+				path.EXPECT().GetParetoPaths().Return([]graph.Path{}).AnyTimes()
 				var pathResult domain.PathResult
 				if !tt.wantErr {
-					pathResult = service.TransformResult(path, domain.NewMockPathRequest(controller), uint32(0))
+					pathResult = service.TransformResult(path, setUpPathRequestMock(controller, false), uint32(0))
 					assert.NotNil(t, pathResult)
 					assert.Equal(t, []string{nodeSid, serviceSid}, pathResult.GetIpv6SidAddresses())
 				} else {
@@ -158,3 +359,141 @@ func TestCalculationTransformerService_TransformResult(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculationTransformerService_getSegmentsFromPath(t *testing.T) {
+	tests := []struct {
+		name             string
+		pathEdges        []int
+		removedEdges     []int
+		shortcut         bool
+		parallel         bool
+		adjacencySids    map[string]string
+		routerServiceMap map[string]string
+		disabled         bool
+		strictPath       bool
+		want             []segment
+	}{
+		{
+			name:         "Test getSegmentsFromPath path matches IGP shortest path",
+			pathEdges:    []int{1, 2, 3},
+			removedEdges: []int{4, 5},
+			want:         []segment{{nodeId: "4"}},
+		},
+		{
+			name:      "Test getSegmentsFromPath equal cost paths require node SID",
+			pathEdges: []int{1, 2, 3},
+			want:      []segment{{nodeId: "2"}, {nodeId: "4"}},
+		},
+		{
+			name:      "Test getSegmentsFromPath other equal cost path",
+			pathEdges: []int{4, 5, 3},
+			want:      []segment{{nodeId: "5"}, {nodeId: "4"}},
+		},
+		{
+			name:         "Test getSegmentsFromPath path diverges from IGP shortest path",
+			pathEdges:    []int{1, 2, 3},
+			removedEdges: []int{4, 5},
+			shortcut:     true,
+			want:         []segment{{nodeId: "3"}, {nodeId: "4"}},
+		},
+		{
+			name:             "Test getSegmentsFromPath service router is kept",
+			pathEdges:        []int{1, 2, 3},
+			removedEdges:     []int{4, 5},
+			routerServiceMap: map[string]string{"3": "2001:db8:f3::"},
+			want:             []segment{{nodeId: "3"}, {nodeId: "4"}},
+		},
+		{
+			name:      "Test getSegmentsFromPath single link",
+			pathEdges: []int{6},
+			want:      []segment{{nodeId: "4"}},
+		},
+		{
+			name:         "Test getSegmentsFromPath minimization disabled",
+			pathEdges:    []int{1, 2, 3},
+			removedEdges: []int{4, 5},
+			disabled:     true,
+			want:         []segment{{nodeId: "2"}, {nodeId: "3"}, {nodeId: "4"}},
+		},
+		{
+			name:          "Test getSegmentsFromPath single link not IGP shortest path uses End.X SID",
+			pathEdges:     []int{6},
+			adjacencySids: map[string]string{"6": "fc00:0:1:e006::"},
+			want:          []segment{{nodeId: "1", adjacencySid: "fc00:0:1:e006::"}, {nodeId: "4"}},
+		},
+		{
+			name:          "Test getSegmentsFromPath parallel link uses End.X SID",
+			pathEdges:     []int{1, 2, 3},
+			removedEdges:  []int{4, 5},
+			parallel:      true,
+			adjacencySids: map[string]string{"1": "fc00:0:1:e001::", "8": "fc00:0:1:e008::"},
+			want:          []segment{{nodeId: "1", adjacencySid: "fc00:0:1:e001::"}, {nodeId: "4"}},
+		},
+		{
+			name:          "Test getSegmentsFromPath IGP shortest path does not use End.X SIDs",
+			pathEdges:     []int{1, 2, 3},
+			removedEdges:  []int{4, 5},
+			adjacencySids: map[string]string{"1": "fc00:0:1:e001::", "2": "fc00:0:2:e002::", "3": "fc00:0:3:e003::"},
+			want:          []segment{{nodeId: "4"}},
+		},
+		{
+			name:          "Test getSegmentsFromPath strict path",
+			pathEdges:     []int{1, 2, 3},
+			removedEdges:  []int{4, 5},
+			adjacencySids: map[string]string{"1": "fc00:0:1:e001::", "2": "fc00:0:2:e002::", "3": "fc00:0:3:e003::"},
+			strictPath:    true,
+			want:          []segment{{nodeId: "1", adjacencySid: "fc00:0:1:e001::"}, {nodeId: "2", adjacencySid: "fc00:0:2:e002::"}, {nodeId: "3", adjacencySid: "fc00:0:3:e003::"}, {nodeId: "4"}},
+		},
+		{
+			name:          "Test getSegmentsFromPath strict path without End.X SID uses node SID",
+			pathEdges:     []int{1, 2, 3},
+			removedEdges:  []int{4, 5},
+			adjacencySids: map[string]string{"1": "fc00:0:1:e001::", "3": "fc00:0:3:e003::"},
+			strictPath:    true,
+			want:          []segment{{nodeId: "1", adjacencySid: "fc00:0:1:e001::"}, {nodeId: "3"}, {nodeId: "3", adjacencySid: "fc00:0:3:e003::"}, {nodeId: "4"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, edges := setupIgpShortestPathTestElements()
+			pathEdges := make([]graph.Edge, 0, len(tt.pathEdges))
+			for _, edgeIndex := range tt.pathEdges {
+				pathEdges = append(pathEdges, edges[edgeIndex])
+			}
+			for _, edgeIndex := range tt.removedEdges {
+				delete(edges, edgeIndex)
+			}
+			if tt.shortcut {
+				edges[7] = graph.NewNetworkEdge("7", nodes[2], nodes[4], map[helper.WeightKey]float64{helper.IgpMetricKey: 10})
+			}
+			if tt.parallel {
+				edges[8] = graph.NewNetworkEdge("8", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.IgpMetricKey: 10})
+			}
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			inMemoryCache := cache.NewInMemoryCache()
+			for edgeId, adjacencySid := range tt.adjacencySids {
+				inMemoryCache.StoreAdjacencySids(edgeId, map[uint32]string{0: adjacencySid})
+			}
+			service := NewCalculationTransformerService(inMemoryCache, networkGraph)
+			service.minimizeSidList = !tt.disabled
+			path := graph.NewShortestPath(pathEdges, 0, 0, 0, 0, 0, nil)
+			assert.Equal(t, tt.want, service.getSegmentsFromPath(path, tt.routerServiceMap, 0, tt.strictPath))
+		})
+	}
+}
+
+func TestCalculationTransformerService_translatePathToSidList_adjacencySid(t *testing.T) {
+	nodes, edges := setupIgpShortestPathTestElements()
+	networkGraph, err := setupGraph(nodes, edges)
+	assert.NoError(t, err)
+	inMemoryCache := cache.NewInMemoryCache()
+	inMemoryCache.StoreAdjacencySids("6", map[uint32]string{0: "fc00:0:1:e006::"})
+	nodeSid := "fc00:0:4::"
+	algorithm := uint32(0)
+	inMemoryCache.StoreSid(setUpMicroSid("4", nodeSid, 0, 0))
+	service := NewCalculationTransformerService(inMemoryCache, networkGraph)
+	path := graph.NewShortestPath([]graph.Edge{edges[6]}, 0, 0, 0, 0, 0, nil)
+	sidList, _ := service.translatePathToSidList(path, algorithm, false)
+	assert.Equal(t, []string{"fc00:0:1:e006::", nodeSid}, sidList)
+}
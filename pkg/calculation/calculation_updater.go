@@ -9,7 +9,9 @@ type CalculationUpdateOptions struct {
 	currentPathResult     domain.PathResult
 	currentAppliedSidList []string
 	weightKeys            []helper.WeightKey
+	weights               []float64
 	calculationMode       CalculationMode
+	tolerances            []float64
 	newPathResult         domain.PathResult
 	pathRequest           domain.PathRequest
 	streamSession         domain.StreamSession
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
+	"time"
 
 	"github.com/hawkv6/hawkeye/pkg/cache"
 	"github.com/hawkv6/hawkeye/pkg/domain"
@@ -42,36 +44,33 @@ func (service *CalculationUpdaterService) getUpdatedEdge(edge graph.Edge) (graph
 	return updatedEdge, 0, nil
 }
 
-func (service *CalculationUpdaterService) getUpdatedTotalCost(edges []graph.Edge, weightTypes []helper.WeightKey, newTotalCost float64) (float64, error) {
+func (service *CalculationUpdaterService) getUpdatedTotalCost(edges []graph.Edge, weightTypes []helper.WeightKey, weights []float64, newTotalCost float64) (float64, error) {
 	for _, edge := range edges {
 		updatedEdge, returnValue, err := service.getUpdatedEdge(edge)
 		if err != nil {
 			return returnValue, err
 		}
-		if len(weightTypes) == 1 {
-			if weightTypes[0] == helper.PacketLossKey {
-				newTotalCost *= 1 - updatedEdge.GetWeight(weightTypes[0])
-			} else {
-				newTotalCost += updatedEdge.GetWeight(weightTypes[0])
-			}
-		} else if len(weightTypes) == 2 {
-			newTotalCost += edge.GetWeight(weightTypes[0])*float64(helper.TwoFactorWeights[0]) + edge.GetWeight(weightTypes[1])*float64(helper.TwoFactorWeights[1])
+		if len(weightTypes) == 1 && weightTypes[0] == helper.PacketLossKey {
+			newTotalCost *= 1 - updatedEdge.GetWeight(weightTypes[0])
 		} else {
-			newTotalCost += edge.GetWeight(weightTypes[0])*float64(helper.ThreeFactorWeights[0]) + edge.GetWeight(weightTypes[1])*float64(helper.ThreeFactorWeights[1]) + edge.GetWeight(weightTypes[2])*float64(helper.ThreeFactorWeights[2])
+			newTotalCost += getWeightedEdgeValue(updatedEdge, weightTypes, weights)
 		}
 	}
 	return newTotalCost, nil
 }
 
-func (service *CalculationUpdaterService) updateTotalCost(pathResult domain.PathResult, weightTypes []helper.WeightKey) error {
+func (service *CalculationUpdaterService) updateTotalCost(pathResult domain.PathResult, weightTypes []helper.WeightKey, weights []float64) error {
 	newTotalCost := service.getInitialTotalCost(weightTypes)
 
-	newTotalCost, err := service.getUpdatedTotalCost(pathResult.GetEdges(), weightTypes, newTotalCost)
+	newTotalCost, err := service.getUpdatedTotalCost(pathResult.GetEdges(), weightTypes, weights, newTotalCost)
 	if err != nil {
 		return err
 	}
 	if len(weightTypes) == 1 && weightTypes[0] == helper.PacketLossKey {
 		newTotalCost = (1 - newTotalCost) * 100
+	} else {
+		// the services of a chain keep their cost from the calculation, the new path is compared including its service cost
+		newTotalCost += pathResult.GetServiceCost()
 	}
 	currentTotalCost := pathResult.GetTotalCost()
 	if currentTotalCost != newTotalCost {
@@ -119,10 +118,10 @@ func (service *CalculationUpdaterService) updateMinimumValue(pathResult domain.P
 	return nil
 }
 
-func (service *CalculationUpdaterService) updateCurrentResult(weightKeys []helper.WeightKey, calculationMode CalculationMode, currentPathResult domain.PathResult) error {
+func (service *CalculationUpdaterService) updateCurrentResult(weightKeys []helper.WeightKey, weights []float64, calculationMode CalculationMode, currentPathResult domain.PathResult) error {
 	var err error
 	if calculationMode == CalculationModeSum {
-		err = service.updateTotalCost(currentPathResult, weightKeys)
+		err = service.updateTotalCost(currentPathResult, weightKeys, weights)
 	} else {
 		err = service.updateMinimumValue(currentPathResult, weightKeys[0])
 	}
@@ -132,31 +131,57 @@ func (service *CalculationUpdaterService) updateCurrentResult(weightKeys []helpe
 	return nil
 }
 
-func (service *CalculationUpdaterService) updatePathIfCostImproved(currentPathResult, newPathResult domain.PathResult, streamSession domain.StreamSession) domain.PathResult {
+// the hysteresis of the path request or the global flapping threshold, increased by the flap damping penalty of the session
+func (service *CalculationUpdaterService) getHysteresis(streamSession domain.StreamSession) float64 {
+	hysteresis := helper.FlappingThreshold
+	if hysteresisPercentage := streamSession.GetPathRequest().GetHysteresisPercentage(); hysteresisPercentage > 0 {
+		hysteresis = hysteresisPercentage / 100
+	}
+	flapPenalty := streamSession.GetFlapPenalty()
+	if flapPenalty > 0 {
+		service.log.Debugf("Session changed its path %d times, required improvement is increased by flap penalty %f", streamSession.GetPathChangeCount(), flapPenalty)
+	}
+	return math.Min(hysteresis*(1+flapPenalty), 1)
+}
+
+func (service *CalculationUpdaterService) isHeldDown(streamSession domain.StreamSession) bool {
+	holdDownTime := streamSession.GetPathRequest().GetHoldDownTime()
+	if holdDownTime <= 0 {
+		return false
+	}
+	remainingTime := holdDownTime - time.Since(streamSession.GetLastPathChange())
+	if remainingTime > 0 {
+		service.log.Debugf("No path changes, path was changed at %s and is held down for another %s", streamSession.GetLastPathChange().Format(time.RFC3339), remainingTime.Round(time.Second))
+		return true
+	}
+	return false
+}
+
+func (service *CalculationUpdaterService) updatePathIfCostImproved(currentPathResult, newPathResult domain.PathResult, streamSession domain.StreamSession, hysteresis float64) domain.PathResult {
 	newPathTotalCost := newPathResult.GetTotalCost()
 	currentPathTotalCost := currentPathResult.GetTotalCost()
-	if newPathTotalCost < currentPathTotalCost*(1-helper.FlappingThreshold) {
-		service.log.Debugf("New path will be applied, cost of new path is by more than 10 percent smaller, current: %f to new: %f", currentPathTotalCost, newPathTotalCost)
-		streamSession.SetPathResult(newPathResult)
+	if newPathTotalCost < currentPathTotalCost*(1-hysteresis) {
+		service.log.Debugf("New path will be applied, cost of new path is by more than %g percent smaller, current: %f to new: %f", hysteresis*100, currentPathTotalCost, newPathTotalCost)
+		streamSession.SetPathResult(newPathResult, domain.UpdateReasonNetworkChange)
 		return newPathResult
 	}
-	service.log.Debugf("No path changes, cost of new path is not smaller by more than 10 percent, current: %f to new: %f", currentPathTotalCost, newPathTotalCost)
+	service.log.Debugf("No path changes, cost of new path is not smaller by more than %g percent, current: %f to new: %f", hysteresis*100, currentPathTotalCost, newPathTotalCost)
 	return nil
 }
 
-func (service *CalculationUpdaterService) updatePathIfMinimumImproved(currentPathResult, newPathResult domain.PathResult, streamSession domain.StreamSession) domain.PathResult {
+func (service *CalculationUpdaterService) updatePathIfMinimumImproved(currentPathResult, newPathResult domain.PathResult, streamSession domain.StreamSession, hysteresis float64) domain.PathResult {
 	newPathMinimumValue := newPathResult.GetBottleneckValue()
 	currentPathMinimumValue := currentPathResult.GetBottleneckValue()
-	if newPathMinimumValue < currentPathMinimumValue*(1-helper.FlappingThreshold) {
+	if newPathMinimumValue < currentPathMinimumValue*(1-hysteresis) {
 		newPathMinimumEdge := newPathResult.GetBottleneckEdge()
 		currentPathMinimumEdge := currentPathResult.GetBottleneckEdge()
 		service.log.Debugf("Bottleneck in old path was %v with value %g: ", currentPathMinimumEdge, currentPathMinimumValue)
 		service.log.Debugf("Bottleneck in new path is %v with value %g: ", newPathMinimumEdge, newPathMinimumValue)
-		service.log.Debugf("New Path will be applied, bottleneck of new path is by more than 10 percent smaller, current: %f to new: %f", currentPathMinimumValue, newPathMinimumValue)
-		streamSession.SetPathResult(newPathResult)
+		service.log.Debugf("New Path will be applied, bottleneck of new path is by more than %g percent smaller, current: %f to new: %f", hysteresis*100, currentPathMinimumValue, newPathMinimumValue)
+		streamSession.SetPathResult(newPathResult, domain.UpdateReasonNetworkChange)
 		return newPathResult
 	}
-	service.log.Debugf("No path changes, cost of new path is not smaller by more than 10 percent, current: %f to new: %f", currentPathMinimumValue, newPathMinimumValue)
+	service.log.Debugf("No path changes, cost of new path is not smaller by more than %g percent, current: %f to new: %f", hysteresis*100, currentPathMinimumValue, newPathMinimumValue)
 	return nil
 }
 
@@ -171,9 +196,68 @@ func (service *CalculationUpdaterService) currentServicesStillValid(serviceSidLi
 	return true
 }
 
+func (service *CalculationUpdaterService) isServiceOfType(serviceType, sid string) bool {
+	return slices.Contains(service.cache.GetServiceSids(serviceType), sid)
+}
+
+func (service *CalculationUpdaterService) isServiceOrderSatisfied(intent domain.Intent, serviceType string, appliedServices map[string]bool) bool {
+	for _, order := range intent.GetServiceOrder() {
+		if order.GetAfter() == serviceType && !appliedServices[order.GetBefore()] {
+			return false
+		}
+	}
+	return true
+}
+
+func (service *CalculationUpdaterService) getAppliedServiceType(intent domain.Intent, services []string, sid string, position int, appliedServices map[string]bool) string {
+	for index, serviceType := range services {
+		if appliedServices[serviceType] || (!intent.GetUnordered() && index != position) {
+			continue
+		}
+		if service.isServiceOfType(serviceType, sid) && service.isServiceOrderSatisfied(intent, serviceType, appliedServices) {
+			return serviceType
+		}
+	}
+	return ""
+}
+
+// optional services which were skipped during the calculation are not part of the current path
+func (service *CalculationUpdaterService) getIncludedServices(intent domain.Intent, currentPathResult domain.PathResult) []string {
+	includedServices := make([]string, 0, len(intent.GetValues()))
+	for _, value := range intent.GetValues() {
+		serviceType := value.GetStringValue()
+		if intent.IsOptionalService(serviceType) && !slices.Contains(currentPathResult.GetIncludedServices(), serviceType) {
+			continue
+		}
+		includedServices = append(includedServices, serviceType)
+	}
+	return includedServices
+}
+
+// the service SIDs are listed in the order the services are applied, which has to match the intent values
+// or, for an unordered chain, the service order constraints
+func (service *CalculationUpdaterService) currentServiceOrderStillValid(intent domain.Intent, currentPathResult domain.PathResult) bool {
+	serviceSidList := currentPathResult.GetServiceSidList()
+	services := service.getIncludedServices(intent, currentPathResult)
+	if len(serviceSidList) != len(services) {
+		service.log.Debugf("Current path applies %d of %d services, new path will be applied", len(serviceSidList), len(services))
+		return false
+	}
+	appliedServices := make(map[string]bool)
+	for position, sid := range serviceSidList {
+		serviceType := service.getAppliedServiceType(intent, services, sid, position, appliedServices)
+		if serviceType == "" {
+			service.log.Debugf("Service SID %s does not provide the required service at position %d anymore, new path will be applied", sid, position+1)
+			return false
+		}
+		appliedServices[serviceType] = true
+	}
+	return true
+}
+
 func (service *CalculationUpdaterService) currentServicesNotValidAnymore(firstIntent domain.Intent, currentPathResult domain.PathResult) bool {
 	if firstIntent.GetIntentType() == domain.IntentTypeSFC {
-		if !service.currentServicesStillValid(currentPathResult.GetServiceSidList()) {
+		if !service.currentServicesStillValid(currentPathResult.GetServiceSidList()) || !service.currentServiceOrderStillValid(firstIntent, currentPathResult) {
 			service.log.Debugln("Current services are not valid anymore, new path will be applied")
 			return true
 		}
@@ -182,38 +266,171 @@ func (service *CalculationUpdaterService) currentServicesNotValidAnymore(firstIn
 	return false
 }
 
-func (service *CalculationUpdaterService) currentPathNotValidAnymore(weightKeys []helper.WeightKey, calculationMode CalculationMode, currentPathResult domain.PathResult) bool {
-	if err := service.updateCurrentResult(weightKeys, calculationMode, currentPathResult); err != nil {
+// a path which includes previously skipped optional services is applied regardless of its cost
+func (service *CalculationUpdaterService) skippedServicesAvailableAgain(firstIntent domain.Intent, currentPathResult, newPathResult domain.PathResult) bool {
+	if firstIntent.GetIntentType() != domain.IntentTypeSFC || len(firstIntent.GetOptionalServices()) == 0 {
+		return false
+	}
+	currentServiceCount := len(currentPathResult.GetIncludedServices())
+	newServiceCount := len(newPathResult.GetIncludedServices())
+	if newServiceCount > currentServiceCount {
+		service.log.Debugf("New path includes %d instead of %d services, new path will be applied", newServiceCount, currentServiceCount)
+		return true
+	}
+	return false
+}
+
+func (service *CalculationUpdaterService) currentPathNotValidAnymore(weightKeys []helper.WeightKey, weights []float64, calculationMode CalculationMode, currentPathResult domain.PathResult) bool {
+	if err := service.updateCurrentResult(weightKeys, weights, calculationMode, currentPathResult); err != nil {
 		service.log.Errorln("Current Path is not valid anymore, new path will be applied: ", err)
 		return true
 	}
 	return false
 }
 
-func (service *CalculationUpdaterService) handlePathChange(weightKeys []helper.WeightKey, calculationMode CalculationMode, currentPathResult, newPathResult domain.PathResult, streamSession domain.StreamSession) domain.PathResult {
+func (service *CalculationUpdaterService) handlePathChange(weightKeys []helper.WeightKey, weights []float64, calculationMode CalculationMode, currentPathResult, newPathResult domain.PathResult, streamSession domain.StreamSession) domain.PathResult {
 	service.log.Debugln("Better Path found, check for applicability")
 	service.log.Debugln("Validate current path and its cost")
 
-	if service.currentServicesNotValidAnymore(streamSession.GetPathRequest().GetIntents()[0], currentPathResult) || service.currentPathNotValidAnymore(weightKeys, calculationMode, currentPathResult) {
-		streamSession.SetPathResult(newPathResult)
+	firstIntent := streamSession.GetPathRequest().GetIntents()[0]
+	if service.currentServicesNotValidAnymore(firstIntent, currentPathResult) || service.currentPathNotValidAnymore(weightKeys, weights, calculationMode, currentPathResult) {
+		streamSession.SetPathResult(newPathResult, domain.UpdateReasonNetworkChange)
 		return newPathResult
 	}
 
+	if service.isHeldDown(streamSession) {
+		return nil
+	}
+
+	if service.skippedServicesAvailableAgain(firstIntent, currentPathResult, newPathResult) {
+		streamSession.SetPathResult(newPathResult, domain.UpdateReasonNetworkChange)
+		return newPathResult
+	}
+
+	hysteresis := service.getHysteresis(streamSession)
 	if calculationMode == CalculationModeSum {
-		return service.updatePathIfCostImproved(currentPathResult, newPathResult, streamSession)
+		return service.updatePathIfCostImproved(currentPathResult, newPathResult, streamSession, hysteresis)
 	} else {
-		return service.updatePathIfMinimumImproved(currentPathResult, newPathResult, streamSession)
+		return service.updatePathIfMinimumImproved(currentPathResult, newPathResult, streamSession, hysteresis)
 	}
 }
 
+func (service *CalculationUpdaterService) updateCurrentMetrics(pathResult domain.PathResult) error {
+	latency, jitter, packetLoss := 0.0, 0.0, 0.0
+	bottleneckValue := math.Inf(1)
+	var bottleneckEdge graph.Edge
+	for _, edge := range pathResult.GetEdges() {
+		updatedEdge, _, err := service.getUpdatedEdge(edge)
+		if err != nil {
+			return fmt.Errorf("Current Path is not valid anymore, new path will be applied: %s", err)
+		}
+		latency += updatedEdge.GetWeight(helper.LatencyKey)
+		jitter += updatedEdge.GetWeight(helper.JitterKey)
+		packetLoss = 1 - ((1 - packetLoss) * (1 - updatedEdge.GetWeight(helper.PacketLossKey)/100))
+		if updatedEdge.GetWeight(helper.AvailableBandwidthKey) < bottleneckValue {
+			bottleneckValue = updatedEdge.GetWeight(helper.AvailableBandwidthKey)
+			bottleneckEdge = updatedEdge
+		}
+	}
+	for _, sid := range pathResult.GetServiceSidList() {
+		if metadata := service.cache.GetServiceMetadata(sid); metadata != nil {
+			latency += metadata.GetDelay()
+			jitter += metadata.GetJitter()
+			packetLoss = 1 - ((1 - packetLoss) * (1 - metadata.GetPacketLoss()/100))
+		}
+	}
+	pathResult.SetTotalDelay(latency)
+	pathResult.SetTotalJitter(jitter)
+	pathResult.SetTotalPacketLoss(packetLoss)
+	service.updateBottleneckValues(pathResult, bottleneckEdge, bottleneckValue)
+	return nil
+}
+
+func (service *CalculationUpdaterService) isLexicographicallyBetter(weightKeys []helper.WeightKey, tolerances []float64, hysteresis float64, currentPathResult, newPathResult domain.PathResult) bool {
+	for index, weightKey := range weightKeys {
+		objective := getObjectiveKey(weightKey)
+		if objective == helper.UndefinedKey || index >= len(tolerances) {
+			continue
+		}
+		currentValue := getPathObjectiveValue(currentPathResult, objective)
+		newValue := getPathObjectiveValue(newPathResult, objective)
+		band := math.Max(tolerances[index], hysteresis) * math.Abs(currentValue)
+		if newValue < currentValue-band {
+			service.log.Debugf("New path is better in %s beyond the tolerance band, current: %f to new: %f", objective, math.Abs(currentValue), math.Abs(newValue))
+			return true
+		}
+		if newValue > currentValue+band {
+			service.log.Debugf("Current path is better in %s beyond the tolerance band, current: %f to new: %f", objective, math.Abs(currentValue), math.Abs(newValue))
+			return false
+		}
+	}
+	return false
+}
+
+func (service *CalculationUpdaterService) handleLexicographicPathChange(options *CalculationUpdateOptions) domain.PathResult {
+	service.log.Debugln("Better Path found, compare paths in lexicographic intent order")
+	if err := service.updateCurrentMetrics(options.currentPathResult); err != nil {
+		service.log.Errorln(err)
+		options.streamSession.SetPathResult(options.newPathResult, domain.UpdateReasonNetworkChange)
+		return options.newPathResult
+	}
+	if service.isHeldDown(options.streamSession) {
+		return nil
+	}
+	if service.isLexicographicallyBetter(options.weightKeys, options.tolerances, service.getHysteresis(options.streamSession), options.currentPathResult, options.newPathResult) {
+		service.log.Debugln("New path will be applied, it is lexicographically better than the current path")
+		options.streamSession.SetPathResult(options.newPathResult, domain.UpdateReasonNetworkChange)
+		return options.newPathResult
+	}
+	service.log.Debugln("No path changes, new path is not lexicographically better than the current path")
+	return nil
+}
+
+func (service *CalculationUpdaterService) updateBackupPath(options *CalculationUpdateOptions) bool {
+	currentBackupResult := options.currentPathResult.GetBackupPathResult()
+	newBackupResult := options.newPathResult.GetBackupPathResult()
+	if currentBackupResult == nil && newBackupResult == nil {
+		return false
+	}
+	if currentBackupResult != nil && !service.currentPathNotValidAnymore(options.weightKeys, options.weights, options.calculationMode, currentBackupResult) {
+		service.log.Debugln("Current backup path is still valid")
+		return false
+	}
+	// if a path change was rejected, the backup of the new path can be the current path, which the new path is disjoint to
+	if newBackupResult != nil && reflect.DeepEqual(newBackupResult.GetIpv6SidAddresses(), options.currentAppliedSidList) {
+		service.log.Debugln("Backup of the new path is the current path, new path will be applied as backup path")
+		newBackupResult = options.newPathResult
+	}
+	service.log.Debugln("Backup path changed, updated backup path will be applied")
+	options.currentPathResult.SetBackupPathResult(newBackupResult)
+	return true
+}
+
+// the backup path is kept valid whenever the current path is kept, also if a path change is rejected by the hysteresis or the hold-down time
 func (service *CalculationUpdaterService) UpdateCalculation(options *CalculationUpdateOptions) (domain.PathResult, error) {
 	if !reflect.DeepEqual(options.newPathResult.GetIpv6SidAddresses(), options.currentAppliedSidList) {
-		return service.handlePathChange(options.weightKeys, options.calculationMode, options.currentPathResult, options.newPathResult, options.streamSession), nil
+		var updatedPathResult domain.PathResult
+		if options.tolerances != nil {
+			updatedPathResult = service.handleLexicographicPathChange(options)
+		} else {
+			updatedPathResult = service.handlePathChange(options.weightKeys, options.weights, options.calculationMode, options.currentPathResult, options.newPathResult, options.streamSession)
+		}
+		if updatedPathResult != nil {
+			return updatedPathResult, nil
+		}
+		if service.updateBackupPath(options) {
+			return options.currentPathResult, nil
+		}
 	} else {
 		service.log.Debugln("No changes in path detected, update current path with new path cost")
-		if err := service.updateCurrentResult(options.weightKeys, options.calculationMode, options.currentPathResult); err != nil {
+		if err := service.updateCurrentResult(options.weightKeys, options.weights, options.calculationMode, options.currentPathResult); err != nil {
 			return nil, err
 		}
+		options.currentPathResult.SetAlternativePathResults(options.newPathResult.GetAlternativePathResults())
+		options.currentPathResult.SetParetoPathResults(options.newPathResult.GetParetoPathResults())
+		if service.updateBackupPath(options) {
+			return options.currentPathResult, nil
+		}
 	}
 	service.log.Debugln("No path changes, current path is still valid")
 	return nil, nil
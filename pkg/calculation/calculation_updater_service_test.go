@@ -2,6 +2,7 @@ package calculation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hawkv6/hawkeye/pkg/cache"
 	"github.com/hawkv6/hawkeye/pkg/domain"
@@ -125,7 +126,7 @@ func TestCalculationUpdateService_getUpdatedTotalCost(t *testing.T) {
 				pathRequest := domain.NewMockPathRequest(controller)
 				pathResult, err := domain.NewDomainPathResult(pathRequest, path, []string{})
 				assert.NoError(t, err)
-				totalCost, err := service.getUpdatedTotalCost(pathResult.GetEdges(), tt.weightTypes, tt.initialTotalCost)
+				totalCost, err := service.getUpdatedTotalCost(pathResult.GetEdges(), tt.weightTypes, nil, tt.initialTotalCost)
 				assert.Error(t, err)
 				assert.Equal(t, 0.0, totalCost)
 				return
@@ -160,7 +161,7 @@ func TestCalculationUpdateService_getUpdatedTotalCost(t *testing.T) {
 			pathRequest := domain.NewMockPathRequest(controller)
 			pathResult, err := domain.NewDomainPathResult(pathRequest, path, []string{})
 			assert.NoError(t, err)
-			totalCost, err := service.getUpdatedTotalCost(pathResult.GetEdges(), tt.weightTypes, tt.initialTotalCost)
+			totalCost, err := service.getUpdatedTotalCost(pathResult.GetEdges(), tt.weightTypes, nil, tt.initialTotalCost)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -207,7 +208,7 @@ func TestCalculationUpdateService_updateTotalCost(t *testing.T) {
 				pathRequest := domain.NewMockPathRequest(controller)
 				pathResult, err := domain.NewDomainPathResult(pathRequest, path, []string{})
 				assert.NoError(t, err)
-				err = service.updateTotalCost(pathResult, tt.weightTypes)
+				err = service.updateTotalCost(pathResult, tt.weightTypes, nil)
 				assert.Error(t, err)
 				return
 			}
@@ -229,12 +230,27 @@ func TestCalculationUpdateService_updateTotalCost(t *testing.T) {
 			path.EXPECT().GetTotalCost().Return(0.0)
 			path.EXPECT().SetTotalCost(gomock.Any()).AnyTimes()
 			assert.NoError(t, err)
-			err = service.updateTotalCost(pathResult, tt.weightTypes)
+			err = service.updateTotalCost(pathResult, tt.weightTypes, nil)
 			assert.NoError(t, err)
 		})
 	}
 }
 
+func TestCalculationUpdateService_updateTotalCost_ServiceCost(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	networkGraph, err := setupGraph(nodes, edges)
+	assert.NoError(t, err)
+	controller := gomock.NewController(t)
+	service := NewCalculationUpdaterService(cache.NewMockCache(controller), networkGraph)
+	path := graph.NewShortestPath([]graph.Edge{edges[1], edges[2]}, 3000, 3000, 0, 0, 0, nil)
+	path.SetServiceCost(1000)
+	pathResult, err := domain.NewDomainPathResult(domain.NewMockPathRequest(controller), path, []string{})
+	assert.NoError(t, err)
+	edges[1].SetWeight(helper.LatencyKey, 2000)
+	assert.NoError(t, service.updateTotalCost(pathResult, []helper.WeightKey{helper.LatencyKey}, nil))
+	assert.Equal(t, 4000.0, pathResult.GetTotalCost())
+}
+
 func TestCalculationUpdateService_getUpdatedBottleneckValues(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -407,10 +423,11 @@ func TestCalculationUpdateService_updateCurrentResult(t *testing.T) {
 			edgeMock := graph.NewMockEdge(controller)
 
 			pathResult.EXPECT().GetEdges().Return([]graph.Edge{edgeMock}).AnyTimes()
+			pathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
 			if tt.wantErr {
 				testGraph.EXPECT().GetEdge(gomock.Any()).Return(nil)
 				edgeMock.EXPECT().GetId().Return("1").AnyTimes()
-				err := service.updateCurrentResult([]helper.WeightKey{helper.LatencyKey}, tt.calculationMode, pathResult)
+				err := service.updateCurrentResult([]helper.WeightKey{helper.LatencyKey}, nil, tt.calculationMode, pathResult)
 				assert.Error(t, err)
 			}
 
@@ -426,7 +443,7 @@ func TestCalculationUpdateService_updateCurrentResult(t *testing.T) {
 				pathResult.EXPECT().SetBottleneckEdge(gomock.Any()).AnyTimes()
 				pathResult.EXPECT().SetBottleneckValue(gomock.Any()).AnyTimes()
 			}
-			err := service.updateCurrentResult([]helper.WeightKey{helper.LatencyKey}, tt.calculationMode, pathResult)
+			err := service.updateCurrentResult([]helper.WeightKey{helper.LatencyKey}, nil, tt.calculationMode, pathResult)
 			assert.NoError(t, err)
 		})
 	}
@@ -463,11 +480,11 @@ func TestCalculationUpdateService_updatePathIfCostImproved(t *testing.T) {
 			pathRequest := domain.NewMockPathRequest(controller)
 			streamSession := domain.NewDomainStreamSession(pathRequest, oldPathResult)
 			if tt.oldTotalCost > tt.newTotalCost*(1-helper.FlappingThreshold) {
-				pathResult := service.updatePathIfCostImproved(oldPathResult, newPathResult, streamSession)
+				pathResult := service.updatePathIfCostImproved(oldPathResult, newPathResult, streamSession, helper.FlappingThreshold)
 				assert.Equal(t, newPathResult, pathResult)
 				return
 			}
-			assert.Nil(t, service.updatePathIfCostImproved(oldPathResult, newPathResult, streamSession))
+			assert.Nil(t, service.updatePathIfCostImproved(oldPathResult, newPathResult, streamSession, helper.FlappingThreshold))
 		})
 	}
 }
@@ -507,13 +524,228 @@ func TestCalculationUpdateService_updatePathIfMinimumImproved(t *testing.T) {
 				newPathResult.EXPECT().SetBottleneckValue(gomock.Any()).AnyTimes()
 				pathRequest := domain.NewMockPathRequest(controller)
 				streamSession := domain.NewDomainStreamSession(pathRequest, oldPathResult)
-				pathResult := service.updatePathIfMinimumImproved(oldPathResult, newPathResult, streamSession)
+				pathResult := service.updatePathIfMinimumImproved(oldPathResult, newPathResult, streamSession, helper.FlappingThreshold)
 				assert.Equal(t, newPathResult, pathResult)
 				return
 			}
 			pathRequest := domain.NewMockPathRequest(controller)
 			streamSession := domain.NewDomainStreamSession(pathRequest, oldPathResult)
-			assert.Nil(t, service.updatePathIfMinimumImproved(oldPathResult, newPathResult, streamSession))
+			assert.Nil(t, service.updatePathIfMinimumImproved(oldPathResult, newPathResult, streamSession, helper.FlappingThreshold))
+		})
+	}
+}
+
+func TestCalculationUpdateService_getHysteresis(t *testing.T) {
+	tests := []struct {
+		name                 string
+		hysteresisPercentage float64
+		flapDampingHalfLife  time.Duration
+		pathChanges          int
+		want                 float64
+	}{
+		{
+			name:                 "Test getHysteresis with global flapping threshold",
+			hysteresisPercentage: 0,
+			want:                 helper.FlappingThreshold,
+		},
+		{
+			name:                 "Test getHysteresis with hysteresis of the path request",
+			hysteresisPercentage: 20,
+			pathChanges:          1,
+			want:                 0.2,
+		},
+		{
+			name:                 "Test getHysteresis with flap damping penalty",
+			hysteresisPercentage: 20,
+			flapDampingHalfLife:  time.Hour,
+			pathChanges:          2,
+			want:                 0.6,
+		},
+		{
+			name:                 "Test getHysteresis limited to 100 percent",
+			hysteresisPercentage: 80,
+			flapDampingHalfLife:  time.Hour,
+			pathChanges:          1,
+			want:                 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			service := NewCalculationUpdaterService(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			pathRequest := domain.NewMockPathRequest(controller)
+			pathRequest.EXPECT().GetHysteresisPercentage().Return(tt.hysteresisPercentage).AnyTimes()
+			pathRequest.EXPECT().GetFlapDampingHalfLife().Return(tt.flapDampingHalfLife).AnyTimes()
+			pathResult := domain.NewMockPathResult(controller)
+			streamSession := domain.NewDomainStreamSession(pathRequest, pathResult)
+			for change := 0; change < tt.pathChanges; change++ {
+				streamSession.SetPathResult(pathResult, domain.UpdateReasonNetworkChange)
+			}
+			assert.InDelta(t, tt.want, service.getHysteresis(streamSession), 0.001)
+		})
+	}
+}
+
+func TestCalculationUpdateService_isHeldDown(t *testing.T) {
+	tests := []struct {
+		name         string
+		holdDownTime time.Duration
+		waitTime     time.Duration
+		want         bool
+	}{
+		{
+			name:         "Test isHeldDown without hold-down time",
+			holdDownTime: 0,
+			want:         false,
+		},
+		{
+			name:         "Test isHeldDown within hold-down time",
+			holdDownTime: time.Minute,
+			want:         true,
+		},
+		{
+			name:         "Test isHeldDown after hold-down time",
+			holdDownTime: time.Millisecond,
+			waitTime:     2 * time.Millisecond,
+			want:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			service := NewCalculationUpdaterService(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			pathRequest := domain.NewMockPathRequest(controller)
+			pathRequest.EXPECT().GetHoldDownTime().Return(tt.holdDownTime).AnyTimes()
+			pathResult := domain.NewMockPathResult(controller)
+			streamSession := domain.NewDomainStreamSession(pathRequest, pathResult)
+			streamSession.SetPathResult(pathResult, domain.UpdateReasonNetworkChange)
+			time.Sleep(tt.waitTime)
+			assert.Equal(t, tt.want, service.isHeldDown(streamSession))
+		})
+	}
+}
+
+func TestCalculationUpdateService_isLexicographicallyBetter(t *testing.T) {
+	tests := []struct {
+		name              string
+		tolerances        []float64
+		currentLatency    float64
+		newLatency        float64
+		currentPacketLoss float64
+		newPacketLoss     float64
+		want              bool
+	}{
+		{
+			name:              "Test isLexicographicallyBetter first intent better beyond band",
+			tolerances:        []float64{0, 0},
+			currentLatency:    2000,
+			newLatency:        1000,
+			currentPacketLoss: 0.01,
+			newPacketLoss:     0.02,
+			want:              true,
+		},
+		{
+			name:              "Test isLexicographicallyBetter first intent worse beyond band",
+			tolerances:        []float64{0, 0},
+			currentLatency:    1000,
+			newLatency:        2000,
+			currentPacketLoss: 0.02,
+			newPacketLoss:     0.01,
+			want:              false,
+		},
+		{
+			name:              "Test isLexicographicallyBetter first intent within band second intent better",
+			tolerances:        []float64{0.5, 0},
+			currentLatency:    1000,
+			newLatency:        1400,
+			currentPacketLoss: 0.02,
+			newPacketLoss:     0.01,
+			want:              true,
+		},
+		{
+			name:              "Test isLexicographicallyBetter all intents within band",
+			tolerances:        []float64{0.5, 0.5},
+			currentLatency:    1000,
+			newLatency:        1400,
+			currentPacketLoss: 0.02,
+			newPacketLoss:     0.015,
+			want:              false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			service := NewCalculationUpdaterService(cache.NewMockCache(controller), graph.NewMockGraph(controller))
+			currentPathResult := domain.NewMockPathResult(controller)
+			newPathResult := domain.NewMockPathResult(controller)
+			currentPathResult.EXPECT().GetTotalDelay().Return(tt.currentLatency).AnyTimes()
+			newPathResult.EXPECT().GetTotalDelay().Return(tt.newLatency).AnyTimes()
+			currentPathResult.EXPECT().GetTotalPacketLoss().Return(tt.currentPacketLoss).AnyTimes()
+			newPathResult.EXPECT().GetTotalPacketLoss().Return(tt.newPacketLoss).AnyTimes()
+			weightKeys := []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey}
+			assert.Equal(t, tt.want, service.isLexicographicallyBetter(weightKeys, tt.tolerances, helper.FlappingThreshold, currentPathResult, newPathResult))
+		})
+	}
+}
+
+func TestCalculationUpdateService_updateCurrentMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		wantErr     bool
+		serviceSids []string
+		wantDelay   float64
+		wantJitter  float64
+	}{
+		{
+			name:    "Test updateCurrentMetrics with error",
+			wantErr: true,
+		},
+		{
+			name:       "Test updateCurrentMetrics without error",
+			wantErr:    false,
+			wantDelay:  1000,
+			wantJitter: 10,
+		},
+		{
+			name:        "Test updateCurrentMetrics with service processing metrics",
+			wantErr:     false,
+			serviceSids: []string{"fc00:0:2:0:1::", "fc00:0:3:0:1::"},
+			wantDelay:   1500,
+			wantJitter:  60,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			testGraph := graph.NewMockGraph(controller)
+			edgeMock := graph.NewMockEdge(controller)
+			cacheMock := cache.NewMockCache(controller)
+			service := NewCalculationUpdaterService(cacheMock, testGraph)
+			edgeMock.EXPECT().GetId().Return("1").AnyTimes()
+			pathResult := domain.NewMockPathResult(controller)
+			pathResult.EXPECT().GetEdges().Return([]graph.Edge{edgeMock})
+			pathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
+			if tt.wantErr {
+				testGraph.EXPECT().GetEdge(gomock.Any()).Return(nil)
+				assert.Error(t, service.updateCurrentMetrics(pathResult))
+				return
+			}
+			testGraph.EXPECT().GetEdge(gomock.Any()).Return(edgeMock)
+			edgeMock.EXPECT().GetWeight(helper.LatencyKey).Return(float64(1000)).AnyTimes()
+			edgeMock.EXPECT().GetWeight(helper.JitterKey).Return(float64(10)).AnyTimes()
+			edgeMock.EXPECT().GetWeight(helper.PacketLossKey).Return(float64(1)).AnyTimes()
+			edgeMock.EXPECT().GetWeight(helper.AvailableBandwidthKey).Return(float64(100)).AnyTimes()
+			pathResult.EXPECT().GetServiceSidList().Return(tt.serviceSids)
+			metadata, err := domain.NewDomainServiceMetadata(0, 1, 0, 500, 50, 0)
+			assert.NoError(t, err)
+			cacheMock.EXPECT().GetServiceMetadata("fc00:0:2:0:1::").Return(metadata).AnyTimes()
+			cacheMock.EXPECT().GetServiceMetadata("fc00:0:3:0:1::").Return(nil).AnyTimes()
+			pathResult.EXPECT().SetTotalDelay(tt.wantDelay)
+			pathResult.EXPECT().SetTotalJitter(tt.wantJitter)
+			pathResult.EXPECT().SetTotalPacketLoss(gomock.Any())
+			pathResult.EXPECT().GetBottleneckEdge().Return(edgeMock).AnyTimes()
+			pathResult.EXPECT().GetBottleneckValue().Return(float64(100)).AnyTimes()
+			assert.NoError(t, service.updateCurrentMetrics(pathResult))
 		})
 	}
 }
@@ -550,6 +782,104 @@ func TestCalculationUpdateService_currentServicesStillValid(t *testing.T) {
 	}
 }
 
+func TestCalculationUpdateService_currentServiceOrderStillValid(t *testing.T) {
+	tests := []struct {
+		name             string
+		unordered        bool
+		serviceOrder     []domain.ServiceOrder
+		optionalServices []string
+		includedServices []string
+		serviceSidList   []string
+		want             bool
+	}{
+		{
+			name:           "Test currentServiceOrderStillValid ordered chain in order",
+			serviceSidList: []string{"fw-sid", "ids-sid", "nat-sid"},
+			want:           true,
+		},
+		{
+			name:           "Test currentServiceOrderStillValid ordered chain out of order",
+			serviceSidList: []string{"ids-sid", "fw-sid", "nat-sid"},
+			want:           false,
+		},
+		{
+			name:           "Test currentServiceOrderStillValid unordered chain out of order",
+			unordered:      true,
+			serviceSidList: []string{"nat-sid", "ids-sid", "fw-sid"},
+			want:           true,
+		},
+		{
+			name:           "Test currentServiceOrderStillValid unordered chain with satisfied service order",
+			unordered:      true,
+			serviceOrder:   []domain.ServiceOrder{domain.NewServiceOrder("nat", "fw")},
+			serviceSidList: []string{"nat-sid", "ids-sid", "fw-sid"},
+			want:           true,
+		},
+		{
+			name:           "Test currentServiceOrderStillValid unordered chain with violated service order",
+			unordered:      true,
+			serviceOrder:   []domain.ServiceOrder{domain.NewServiceOrder("fw", "ids")},
+			serviceSidList: []string{"nat-sid", "ids-sid", "fw-sid"},
+			want:           false,
+		},
+		{
+			name:           "Test currentServiceOrderStillValid unordered chain with missing service",
+			unordered:      true,
+			serviceSidList: []string{"nat-sid", "ids-sid"},
+			want:           false,
+		},
+		{
+			name:           "Test currentServiceOrderStillValid unordered chain with service applied twice",
+			unordered:      true,
+			serviceSidList: []string{"nat-sid", "nat-sid", "fw-sid"},
+			want:           false,
+		},
+		{
+			name:             "Test currentServiceOrderStillValid ordered chain with skipped optional service",
+			optionalServices: []string{"ids"},
+			includedServices: []string{"fw", "nat"},
+			serviceSidList:   []string{"fw-sid", "nat-sid"},
+			want:             true,
+		},
+		{
+			name:             "Test currentServiceOrderStillValid ordered chain with included optional service",
+			optionalServices: []string{"ids"},
+			includedServices: []string{"fw", "ids", "nat"},
+			serviceSidList:   []string{"fw-sid", "ids-sid", "nat-sid"},
+			want:             true,
+		},
+		{
+			name:             "Test currentServiceOrderStillValid ordered chain with missing included optional service",
+			optionalServices: []string{"ids"},
+			includedServices: []string{"fw", "ids", "nat"},
+			serviceSidList:   []string{"fw-sid", "nat-sid"},
+			want:             false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			cacheMock := cache.NewMockCache(controller)
+			service := NewCalculationUpdaterService(cacheMock, nil)
+			values := make([]domain.Value, 0)
+			for _, serviceType := range []string{"fw", "ids", "nat"} {
+				value, err := domain.NewStringValue(domain.ValueTypeSFC, proto.String(serviceType))
+				assert.NoError(t, err)
+				values = append(values, value)
+				cacheMock.EXPECT().GetServiceSids(serviceType).Return([]string{serviceType + "-sid"}).AnyTimes()
+			}
+			intent := domain.NewDomainIntent(domain.IntentTypeSFC, values)
+			intent.SetUnordered(tt.unordered)
+			intent.SetServiceOrder(tt.serviceOrder)
+			intent.SetOptionalServices(tt.optionalServices)
+			pathResult := domain.NewMockPathResult(controller)
+			pathResult.EXPECT().GetServiceSidList().Return(tt.serviceSidList)
+			pathResult.EXPECT().GetIncludedServices().Return(tt.includedServices).AnyTimes()
+			assert.Equal(t, tt.want, service.currentServiceOrderStillValid(intent, pathResult))
+		})
+	}
+}
+
 func TestCalculationUpdateService_currentServicesNotValidAnymore(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -576,10 +906,14 @@ func TestCalculationUpdateService_currentServicesNotValidAnymore(t *testing.T) {
 				cacheMock.EXPECT().DoesServiceSidExist(gomock.Any()).Return(false).AnyTimes()
 			} else {
 				cacheMock.EXPECT().DoesServiceSidExist(gomock.Any()).Return(true).AnyTimes()
+				cacheMock.EXPECT().GetServiceSids("fw").Return([]string{"1"}).AnyTimes()
+				cacheMock.EXPECT().GetServiceSids("ids").Return([]string{"2"}).AnyTimes()
 			}
 			fwValue, err := domain.NewStringValue(domain.ValueTypeSFC, proto.String("fw"))
 			assert.NoError(t, err)
-			firstIntent := domain.NewDomainIntent(domain.IntentTypeSFC, []domain.Value{fwValue})
+			idsValue, err := domain.NewStringValue(domain.ValueTypeSFC, proto.String("ids"))
+			assert.NoError(t, err)
+			firstIntent := domain.NewDomainIntent(domain.IntentTypeSFC, []domain.Value{fwValue, idsValue})
 			assert.NoError(t, err)
 			currentPathResult := domain.NewMockPathResult(controller)
 			currentPathResult.EXPECT().GetServiceSidList().Return(tt.serviceSidList).AnyTimes()
@@ -592,6 +926,69 @@ func TestCalculationUpdateService_currentServicesNotValidAnymore(t *testing.T) {
 	}
 }
 
+func TestCalculationUpdateService_skippedServicesAvailableAgain(t *testing.T) {
+	tests := []struct {
+		name                    string
+		intentType              domain.IntentType
+		optionalServices        []string
+		currentIncludedServices []string
+		newIncludedServices     []string
+		want                    bool
+	}{
+		{
+			name:                    "Test skippedServicesAvailableAgain with skipped service available again",
+			intentType:              domain.IntentTypeSFC,
+			optionalServices:        []string{"ids"},
+			currentIncludedServices: []string{"fw"},
+			newIncludedServices:     []string{"fw", "ids"},
+			want:                    true,
+		},
+		{
+			name:                    "Test skippedServicesAvailableAgain with same services",
+			intentType:              domain.IntentTypeSFC,
+			optionalServices:        []string{"ids"},
+			currentIncludedServices: []string{"fw", "ids"},
+			newIncludedServices:     []string{"fw", "ids"},
+			want:                    false,
+		},
+		{
+			name:                    "Test skippedServicesAvailableAgain with optional service skipped in new path",
+			intentType:              domain.IntentTypeSFC,
+			optionalServices:        []string{"ids"},
+			currentIncludedServices: []string{"fw", "ids"},
+			newIncludedServices:     []string{"fw"},
+			want:                    false,
+		},
+		{
+			name:       "Test skippedServicesAvailableAgain without optional services",
+			intentType: domain.IntentTypeSFC,
+			want:       false,
+		},
+		{
+			name:       "Test skippedServicesAvailableAgain with non sfc intent",
+			intentType: domain.IntentTypeLowLatency,
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			service := NewCalculationUpdaterService(cache.NewMockCache(controller), nil)
+			fwValue, err := domain.NewStringValue(domain.ValueTypeSFC, proto.String("fw"))
+			assert.NoError(t, err)
+			idsValue, err := domain.NewStringValue(domain.ValueTypeSFC, proto.String("ids"))
+			assert.NoError(t, err)
+			firstIntent := domain.NewDomainIntent(tt.intentType, []domain.Value{fwValue, idsValue})
+			firstIntent.SetOptionalServices(tt.optionalServices)
+			currentPathResult := domain.NewMockPathResult(controller)
+			currentPathResult.EXPECT().GetIncludedServices().Return(tt.currentIncludedServices).AnyTimes()
+			newPathResult := domain.NewMockPathResult(controller)
+			newPathResult.EXPECT().GetIncludedServices().Return(tt.newIncludedServices).AnyTimes()
+			assert.Equal(t, tt.want, service.skippedServicesAvailableAgain(firstIntent, currentPathResult, newPathResult))
+		})
+	}
+}
+
 func TestCalculationUpdateService_currentPathNotValidAnymore(t *testing.T) {
 	tests := []struct {
 		name string
@@ -615,6 +1012,7 @@ func TestCalculationUpdateService_currentPathNotValidAnymore(t *testing.T) {
 			currentPathResult := domain.NewMockPathResult(controller)
 			edgeMock := graph.NewMockEdge(controller)
 			currentPathResult.EXPECT().GetEdges().Return([]graph.Edge{edgeMock}).AnyTimes()
+			currentPathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
 			weightKeys := []helper.WeightKey{helper.LatencyKey}
 			calculationMode := CalculationModeSum
 			edgeMock.EXPECT().GetId().Return("1").AnyTimes()
@@ -627,7 +1025,7 @@ func TestCalculationUpdateService_currentPathNotValidAnymore(t *testing.T) {
 				newPathResult := domain.NewMockPathResult(controller)
 				newPathResult.EXPECT().GetTotalCost().Return(float64(20)).AnyTimes()
 			}
-			assert.Equal(t, tt.want, service.currentPathNotValidAnymore(weightKeys, calculationMode, currentPathResult))
+			assert.Equal(t, tt.want, service.currentPathNotValidAnymore(weightKeys, nil, calculationMode, currentPathResult))
 		})
 	}
 }
@@ -673,14 +1071,18 @@ func TestCalculationUpdateService_handlePathChange(t *testing.T) {
 				pathRequest.EXPECT().GetIntents().Return([]domain.Intent{sfcIntent}).AnyTimes()
 				currentPathResult.EXPECT().GetServiceSidList().Return([]string{"1", "2"}).AnyTimes()
 				cacheMock.EXPECT().DoesServiceSidExist(gomock.Any()).Return(false).AnyTimes()
-				pathResult := service.handlePathChange(weightKey, calculationMode, currentPathResult, newPathResult, streamSession)
+				pathResult := service.handlePathChange(weightKey, nil, calculationMode, currentPathResult, newPathResult, streamSession)
 				assert.Equal(t, newPathResult, pathResult)
 				return
 			}
 			firstIntent := domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})
 			intents := []domain.Intent{firstIntent}
 			pathRequest.EXPECT().GetIntents().Return(intents).AnyTimes()
+			pathRequest.EXPECT().GetHoldDownTime().Return(time.Duration(0)).AnyTimes()
+			pathRequest.EXPECT().GetHysteresisPercentage().Return(float64(0)).AnyTimes()
+			pathRequest.EXPECT().GetFlapDampingHalfLife().Return(time.Duration(0)).AnyTimes()
 			currentPathResult.EXPECT().GetEdges().Return([]graph.Edge{}).AnyTimes()
+			currentPathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
 			currentPathResult.EXPECT().GetTotalCost().Return(float64(100)).AnyTimes()
 			currentPathResult.EXPECT().SetTotalCost(gomock.Any()).AnyTimes()
 			edgeMock := graph.NewMockEdge(controller)
@@ -691,7 +1093,100 @@ func TestCalculationUpdateService_handlePathChange(t *testing.T) {
 			newPathResult.EXPECT().GetBottleneckValue().Return(float64(100)).AnyTimes()
 			currentPathResult.EXPECT().SetBottleneckEdge(gomock.Any()).AnyTimes()
 			currentPathResult.EXPECT().SetBottleneckValue(gomock.Any()).AnyTimes()
-			assert.Nil(t, service.handlePathChange(weightKey, calculationMode, currentPathResult, newPathResult, streamSession))
+			assert.Nil(t, service.handlePathChange(weightKey, nil, calculationMode, currentPathResult, newPathResult, streamSession))
+		})
+	}
+}
+
+func TestCalculationUpdateService_updateBackupPath(t *testing.T) {
+	tests := []struct {
+		name               string
+		hasCurrentBackup   bool
+		hasNewBackup       bool
+		currentBackupValid bool
+		newBackupIsCurrent bool
+		want               bool
+	}{
+		{
+			name: "Test updateBackupPath without backup paths",
+			want: false,
+		},
+		{
+			name:               "Test updateBackupPath with valid current backup path",
+			hasCurrentBackup:   true,
+			hasNewBackup:       true,
+			currentBackupValid: true,
+			want:               false,
+		},
+		{
+			name:               "Test updateBackupPath with invalid current backup path",
+			hasCurrentBackup:   true,
+			hasNewBackup:       true,
+			currentBackupValid: false,
+			want:               true,
+		},
+		{
+			name:         "Test updateBackupPath with new backup path",
+			hasNewBackup: true,
+			want:         true,
+		},
+		{
+			name:               "Test updateBackupPath with new backup path equal to the current path",
+			hasCurrentBackup:   true,
+			hasNewBackup:       true,
+			newBackupIsCurrent: true,
+			want:               true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			graphMock := graph.NewMockGraph(controller)
+			service := NewCalculationUpdaterService(cache.NewMockCache(controller), graphMock)
+			currentPathResult := domain.NewMockPathResult(controller)
+			newPathResult := domain.NewMockPathResult(controller)
+			var currentBackupResult, newBackupResult domain.PathResult
+			if tt.hasCurrentBackup {
+				backupResult := domain.NewMockPathResult(controller)
+				edgeMock := graph.NewMockEdge(controller)
+				edgeMock.EXPECT().GetId().Return("1").AnyTimes()
+				edgeMock.EXPECT().GetWeight(gomock.Any()).Return(float64(100)).AnyTimes()
+				backupResult.EXPECT().GetEdges().Return([]graph.Edge{edgeMock}).AnyTimes()
+				backupResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
+				backupResult.EXPECT().GetTotalCost().Return(float64(100)).AnyTimes()
+				if tt.currentBackupValid {
+					graphMock.EXPECT().GetEdge("1").Return(edgeMock)
+				} else {
+					graphMock.EXPECT().GetEdge("1").Return(nil)
+				}
+				currentBackupResult = backupResult
+			}
+			currentAppliedSidList := []string{"fc00:0:1::", "fc00:0:2::"}
+			wantBackupResult := domain.PathResult(nil)
+			if tt.hasNewBackup {
+				backupResult := domain.NewMockPathResult(controller)
+				if tt.newBackupIsCurrent {
+					backupResult.EXPECT().GetIpv6SidAddresses().Return(currentAppliedSidList).AnyTimes()
+					wantBackupResult = newPathResult
+				} else {
+					backupResult.EXPECT().GetIpv6SidAddresses().Return([]string{"fc00:0:3::", "fc00:0:2::"}).AnyTimes()
+					wantBackupResult = backupResult
+				}
+				newBackupResult = backupResult
+			}
+			currentPathResult.EXPECT().GetBackupPathResult().Return(currentBackupResult)
+			newPathResult.EXPECT().GetBackupPathResult().Return(newBackupResult)
+			if tt.want {
+				currentPathResult.EXPECT().SetBackupPathResult(wantBackupResult)
+			}
+			options := &CalculationUpdateOptions{
+				currentPathResult:     currentPathResult,
+				currentAppliedSidList: currentAppliedSidList,
+				newPathResult:         newPathResult,
+				weightKeys:            []helper.WeightKey{helper.LatencyKey},
+				calculationMode:       CalculationModeSum,
+			}
+			assert.Equal(t, tt.want, service.updateBackupPath(options))
 		})
 	}
 }
@@ -746,6 +1241,7 @@ func TestCalculationUpdateService_UpdateCalculation(t *testing.T) {
 				edgeMock.EXPECT().GetId().Return("1").AnyTimes()
 				graphMock.EXPECT().GetEdge("1").Return(nil).AnyTimes()
 				currentPathResult.EXPECT().GetEdges().Return([]graph.Edge{edgeMock}).AnyTimes()
+				currentPathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
 				pathResult, err := service.UpdateCalculation(&calculationUpdateOptions)
 				assert.NoError(t, err)
 				assert.NotNil(t, pathResult)
@@ -759,6 +1255,7 @@ func TestCalculationUpdateService_UpdateCalculation(t *testing.T) {
 				edgeMock.EXPECT().GetId().Return("1").AnyTimes()
 				graphMock.EXPECT().GetEdge(gomock.Any()).Return(nil).AnyTimes()
 				currentPathResult.EXPECT().GetEdges().Return([]graph.Edge{edgeMock}).AnyTimes()
+				currentPathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
 				pathResult, err := service.UpdateCalculation(&calculationUpdateOptions)
 				assert.Error(t, err)
 				assert.Nil(t, pathResult)
@@ -768,9 +1265,16 @@ func TestCalculationUpdateService_UpdateCalculation(t *testing.T) {
 				edgeMock.EXPECT().GetId().Return("1").AnyTimes()
 				edgeMock.EXPECT().GetWeight(gomock.Any()).Return(float64(100)).AnyTimes()
 				currentPathResult.EXPECT().GetEdges().Return([]graph.Edge{edgeMock}).AnyTimes()
+				currentPathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
 				currentPathResult.EXPECT().GetTotalCost().Return(float64(100)).AnyTimes()
 				newPathResult.EXPECT().GetTotalCost().Return(float64(100)).AnyTimes()
 				graphMock.EXPECT().GetEdge("1").Return(edgeMock).AnyTimes()
+				newPathResult.EXPECT().GetAlternativePathResults().Return([]domain.PathResult{}).AnyTimes()
+				currentPathResult.EXPECT().SetAlternativePathResults([]domain.PathResult{}).AnyTimes()
+				newPathResult.EXPECT().GetParetoPathResults().Return([]domain.PathResult{}).AnyTimes()
+				currentPathResult.EXPECT().SetParetoPathResults([]domain.PathResult{}).AnyTimes()
+				newPathResult.EXPECT().GetBackupPathResult().Return(nil).AnyTimes()
+				currentPathResult.EXPECT().GetBackupPathResult().Return(nil).AnyTimes()
 				pathResult, err := service.UpdateCalculation(&calculationUpdateOptions)
 				assert.NoError(t, err)
 				assert.Nil(t, pathResult)
@@ -779,3 +1283,95 @@ func TestCalculationUpdateService_UpdateCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculationUpdateService_UpdateCalculation_BackupPath(t *testing.T) {
+	tests := []struct {
+		name                 string
+		holdDownTime         time.Duration
+		hysteresisPercentage float64
+		backupLinkRemoved    bool
+		wantBackupUpdate     bool
+	}{
+		{
+			name:              "Test UpdateCalculation with held down path change and removed backup link",
+			holdDownTime:      time.Hour,
+			backupLinkRemoved: true,
+			wantBackupUpdate:  true,
+		},
+		{
+			name:                 "Test UpdateCalculation with path change within hysteresis and removed backup link",
+			hysteresisPercentage: 50,
+			backupLinkRemoved:    true,
+			wantBackupUpdate:     true,
+		},
+		{
+			name:             "Test UpdateCalculation with held down path change and valid backup path",
+			holdDownTime:     time.Hour,
+			wantBackupUpdate: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			graphMock := graph.NewMockGraph(controller)
+			service := NewCalculationUpdaterService(cache.NewMockCache(controller), graphMock)
+			pathRequest := domain.NewMockPathRequest(controller)
+			pathRequest.EXPECT().GetIntents().Return([]domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}).AnyTimes()
+			pathRequest.EXPECT().GetHoldDownTime().Return(tt.holdDownTime).AnyTimes()
+			pathRequest.EXPECT().GetHysteresisPercentage().Return(tt.hysteresisPercentage).AnyTimes()
+			pathRequest.EXPECT().GetFlapDampingHalfLife().Return(time.Duration(0)).AnyTimes()
+
+			primaryEdge := graph.NewMockEdge(controller)
+			primaryEdge.EXPECT().GetId().Return("1").AnyTimes()
+			primaryEdge.EXPECT().GetWeight(gomock.Any()).Return(float64(100)).AnyTimes()
+			graphMock.EXPECT().GetEdge("1").Return(primaryEdge).AnyTimes()
+			currentPathResult := domain.NewMockPathResult(controller)
+			currentPathResult.EXPECT().GetEdges().Return([]graph.Edge{primaryEdge}).AnyTimes()
+			currentPathResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
+			currentPathResult.EXPECT().GetTotalCost().Return(float64(100)).AnyTimes()
+
+			backupEdge := graph.NewMockEdge(controller)
+			backupEdge.EXPECT().GetId().Return("2").AnyTimes()
+			backupEdge.EXPECT().GetWeight(gomock.Any()).Return(float64(200)).AnyTimes()
+			if tt.backupLinkRemoved {
+				graphMock.EXPECT().GetEdge("2").Return(nil).AnyTimes()
+			} else {
+				graphMock.EXPECT().GetEdge("2").Return(backupEdge).AnyTimes()
+			}
+			currentBackupResult := domain.NewMockPathResult(controller)
+			currentBackupResult.EXPECT().GetEdges().Return([]graph.Edge{backupEdge}).AnyTimes()
+			currentBackupResult.EXPECT().GetServiceCost().Return(0.0).AnyTimes()
+			currentBackupResult.EXPECT().GetTotalCost().Return(float64(200)).AnyTimes()
+			currentPathResult.EXPECT().GetBackupPathResult().Return(currentBackupResult).AnyTimes()
+
+			newPathResult := domain.NewMockPathResult(controller)
+			newPathResult.EXPECT().GetIpv6SidAddresses().Return([]string{"fc00:0:3::", "fc00:0:2::"}).AnyTimes()
+			newPathResult.EXPECT().GetTotalCost().Return(float64(90)).AnyTimes()
+			newBackupResult := domain.NewMockPathResult(controller)
+			newBackupResult.EXPECT().GetIpv6SidAddresses().Return([]string{"fc00:0:4::", "fc00:0:2::"}).AnyTimes()
+			newPathResult.EXPECT().GetBackupPathResult().Return(newBackupResult).AnyTimes()
+			if tt.wantBackupUpdate {
+				currentPathResult.EXPECT().SetBackupPathResult(newBackupResult)
+			}
+
+			streamSession := domain.NewDomainStreamSession(pathRequest, currentPathResult)
+			options := &CalculationUpdateOptions{
+				currentPathResult:     currentPathResult,
+				currentAppliedSidList: []string{"fc00:0:2::"},
+				weightKeys:            []helper.WeightKey{helper.LatencyKey},
+				calculationMode:       CalculationModeSum,
+				newPathResult:         newPathResult,
+				pathRequest:           pathRequest,
+				streamSession:         streamSession,
+			}
+			pathResult, err := service.UpdateCalculation(options)
+			assert.NoError(t, err)
+			if tt.wantBackupUpdate {
+				assert.Equal(t, currentPathResult, pathResult)
+			} else {
+				assert.Nil(t, pathResult)
+			}
+			assert.Equal(t, currentPathResult, streamSession.GetPathResult())
+		})
+	}
+}
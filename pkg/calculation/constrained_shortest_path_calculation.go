@@ -0,0 +1,192 @@
+package calculation
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+)
+
+type pathLabel struct {
+	nodeId        string
+	waypointIndex int
+	cost          float64
+	latency       float64
+	jitter        float64
+	packetLoss    float64
+	hopCount      int
+	edge          graph.Edge
+	previous      *pathLabel
+	dominated     bool
+}
+
+// labels only compete with labels at the same node which have passed the same number of waypoints
+type labelState struct {
+	nodeId        string
+	waypointIndex int
+}
+
+func (label *pathLabel) getState() labelState {
+	return labelState{nodeId: label.nodeId, waypointIndex: label.waypointIndex}
+}
+
+type ConstrainedShortestPathCalculation struct {
+	BaseCalculation
+	priorityQueue PriorityQueue
+	itemLabels    map[*Item]*pathLabel
+	nodeLabels    map[labelState][]*pathLabel
+	waypoints     []waypoint
+}
+
+func NewConstrainedShortestPathCalculation(options *CalculationOptions) *ConstrainedShortestPathCalculation {
+	return &ConstrainedShortestPathCalculation{
+		BaseCalculation: *NewBaseCalculation(options),
+		itemLabels:      make(map[*Item]*pathLabel),
+		nodeLabels:      make(map[labelState][]*pathLabel),
+	}
+}
+
+func (calculation *ConstrainedShortestPathCalculation) pushLabel(label *pathLabel) {
+	state := label.getState()
+	calculation.nodeLabels[state] = append(calculation.nodeLabels[state], label)
+	item := &Item{nodeId: label.nodeId, cost: label.cost}
+	calculation.itemLabels[item] = label
+	heap.Push(&calculation.priorityQueue, item)
+}
+
+func (calculation *ConstrainedShortestPathCalculation) popLabel() *pathLabel {
+	item := heap.Pop(&calculation.priorityQueue).(*Item)
+	label := calculation.itemLabels[item]
+	delete(calculation.itemLabels, item)
+	return label
+}
+
+func (calculation *ConstrainedShortestPathCalculation) isConstrained(key helper.WeightKey) bool {
+	_, ok := calculation.maxConstraints[key]
+	return ok
+}
+
+func (calculation *ConstrainedShortestPathCalculation) dominates(label, otherLabel *pathLabel) bool {
+	if label.cost > otherLabel.cost {
+		return false
+	}
+	if calculation.isConstrained(helper.NormalizedLatencyKey) && label.latency > otherLabel.latency {
+		return false
+	}
+	if calculation.isConstrained(helper.NormalizedJitterKey) && label.jitter > otherLabel.jitter {
+		return false
+	}
+	if calculation.isConstrained(helper.NormalizedPacketLossKey) && label.packetLoss > otherLabel.packetLoss {
+		return false
+	}
+	if calculation.isHopCountConstrained() && label.hopCount > otherLabel.hopCount {
+		return false
+	}
+	return true
+}
+
+func (calculation *ConstrainedShortestPathCalculation) isDominated(label *pathLabel) bool {
+	for _, existingLabel := range calculation.nodeLabels[label.getState()] {
+		if !existingLabel.dominated && calculation.dominates(existingLabel, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func (calculation *ConstrainedShortestPathCalculation) removeDominatedLabels(label *pathLabel) {
+	state := label.getState()
+	remainingLabels := make([]*pathLabel, 0, len(calculation.nodeLabels[state]))
+	for _, existingLabel := range calculation.nodeLabels[state] {
+		if existingLabel.dominated {
+			continue
+		}
+		if calculation.dominates(label, existingLabel) {
+			existingLabel.dominated = true
+			continue
+		}
+		remainingLabels = append(remainingLabels, existingLabel)
+	}
+	calculation.nodeLabels[state] = remainingLabels
+}
+
+func (calculation *ConstrainedShortestPathCalculation) extendLabel(label *pathLabel, edge graph.Edge) *pathLabel {
+	return &pathLabel{
+		nodeId:        edge.To().GetId(),
+		waypointIndex: getWaypointIndex(calculation.waypoints, label.waypointIndex, edge.To().GetId(), edge),
+		cost:          label.cost + calculation.getEdgeCost(edge),
+		latency:       label.latency + edge.GetWeight(helper.LatencyKey),
+		jitter:        label.jitter + edge.GetWeight(helper.JitterKey),
+		packetLoss:    1 - ((1 - label.packetLoss) * (1 - edge.GetWeight(helper.PacketLossKey)/100)),
+		hopCount:      label.hopCount + 1,
+		edge:          edge,
+		previous:      label,
+	}
+}
+
+func (calculation *ConstrainedShortestPathCalculation) relaxEdge(label *pathLabel, edge graph.Edge) {
+	if calculation.isExcluded(edge) || calculation.violatesBandwidthMinConstraint(edge) {
+		return
+	}
+	newLabel := calculation.extendLabel(label, edge)
+	if calculation.violatesMaxConstraints(edge, newLabel.latency, newLabel.jitter, newLabel.packetLoss) || calculation.violatesHopCountConstraint(edge, newLabel.hopCount) {
+		return
+	}
+	if calculation.isDominated(newLabel) {
+		return
+	}
+	calculation.removeDominatedLabels(newLabel)
+	calculation.pushLabel(newLabel)
+}
+
+func (calculation *ConstrainedShortestPathCalculation) performLabelSetting() *pathLabel {
+	calculation.priorityQueue = *NewMinimumPriorityQueue()
+	heap.Init(&calculation.priorityQueue)
+	sourceId := calculation.source.GetId()
+	calculation.pushLabel(&pathLabel{nodeId: sourceId, waypointIndex: getWaypointIndex(calculation.waypoints, 0, sourceId, nil)})
+	for !calculation.priorityQueue.IsEmpty() {
+		label := calculation.popLabel()
+		if label.dominated {
+			continue
+		}
+		if label.waypointIndex == len(calculation.waypoints) {
+			return label
+		}
+		for _, edge := range getSortedEdges(calculation.graph.GetNode(label.nodeId)) {
+			calculation.relaxEdge(label, edge)
+		}
+	}
+	return nil
+}
+
+func (calculation *ConstrainedShortestPathCalculation) getEdges(label *pathLabel) []graph.Edge {
+	edges := make([]graph.Edge, 0)
+	for current := label; current.previous != nil; current = current.previous {
+		edges = append([]graph.Edge{current.edge}, edges...)
+	}
+	return edges
+}
+
+func (calculation *ConstrainedShortestPathCalculation) setWaypoints() error {
+	waypoints, err := calculation.getWaypoints()
+	if err != nil {
+		return err
+	}
+	calculation.waypoints = waypoints
+	return nil
+}
+
+func (calculation *ConstrainedShortestPathCalculation) Execute() (graph.Path, error) {
+	if err := calculation.setWaypoints(); err != nil {
+		return nil, err
+	}
+	destinationLabel := calculation.performLabelSetting()
+	if destinationLabel == nil {
+		return nil, fmt.Errorf("No path found from node %s to node %s satisfying all constraints", calculation.source.GetId(), calculation.destination.GetId())
+	}
+	path := calculation.createPathFromEdges(calculation.getEdges(destinationLabel))
+	calculation.log.Debugln("Calculation finished - constrained shortest path found")
+	calculation.log.Debugf("Total cost %g, total latency %gus, total jitter %gus, total packet loss %f -> %f%%", path.GetTotalCost(), path.GetTotalDelay(), path.GetTotalJitter(), path.GetTotalPacketLoss(), path.GetTotalPacketLoss()*100)
+	return path, nil
+}
@@ -0,0 +1,321 @@
+package calculation
+
+import (
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupConstrainedPathTestElements() (map[int]graph.Node, map[int]graph.Edge) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+		4: graph.NewNetworkNode("4", "4", srAlgorithm),
+	}
+	//  [1]---1ms/10us--->[2]--1ms/5us-->[4]
+	//    \                ^
+	//   1ms/1us       1ms/1us
+	//      \              |
+	//       +--->[3]------+
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.JitterKey: 10, helper.PacketLossKey: 0.1, helper.AvailableBandwidthKey: 999000}),
+		2: graph.NewNetworkEdge("2", nodes[1], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.JitterKey: 1, helper.PacketLossKey: 0.5, helper.AvailableBandwidthKey: 999000}),
+		3: graph.NewNetworkEdge("3", nodes[3], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.JitterKey: 1, helper.PacketLossKey: 0.5, helper.AvailableBandwidthKey: 500000}),
+		4: graph.NewNetworkEdge("4", nodes[2], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.JitterKey: 5, helper.PacketLossKey: 0.1, helper.AvailableBandwidthKey: 999000}),
+	}
+	return nodes, edges
+}
+
+func TestNewConstrainedShortestPathCalculation(t *testing.T) {
+	calculation := NewConstrainedShortestPathCalculation(&CalculationOptions{})
+	assert.NotNil(t, calculation)
+	assert.NotNil(t, calculation.itemLabels)
+	assert.NotNil(t, calculation.nodeLabels)
+}
+
+func TestConstrainedShortestPathCalculation_Execute(t *testing.T) {
+	nodes, edges := setupConstrainedPathTestElements()
+	kShortestNodes, kShortestEdges := setupKShortestPathTestElements()
+	tests := []struct {
+		name           string
+		nodes          map[int]graph.Node
+		edges          map[int]graph.Edge
+		from           int
+		to             int
+		weightKey      helper.WeightKey
+		maxConstraints map[helper.WeightKey]float64
+		minConstraints map[helper.WeightKey]float64
+		wantEdgeIds    []string
+		wantCost       float64
+		wantErr        bool
+	}{
+		{
+			name:           "Test constrained shortest path without constraints",
+			nodes:          kShortestNodes,
+			edges:          kShortestEdges,
+			from:           1,
+			to:             8,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{},
+			minConstraints: map[helper.WeightKey]float64{},
+			wantEdgeIds:    []string{"3", "7", "10", "9"},
+			wantCost:       4000,
+		},
+		{
+			name:           "Test constrained shortest path max jitter requires more expensive subpath",
+			nodes:          nodes,
+			edges:          edges,
+			from:           1,
+			to:             4,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 10},
+			minConstraints: map[helper.WeightKey]float64{},
+			wantEdgeIds:    []string{"2", "3", "4"},
+			wantCost:       3000,
+		},
+		{
+			name:           "Test constrained shortest path max jitter satisfied by cheapest path",
+			nodes:          nodes,
+			edges:          edges,
+			from:           1,
+			to:             4,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 15},
+			minConstraints: map[helper.WeightKey]float64{},
+			wantEdgeIds:    []string{"1", "4"},
+			wantCost:       2000,
+		},
+		{
+			name:           "Test constrained shortest path max packet loss and max jitter not satisfiable",
+			nodes:          nodes,
+			edges:          edges,
+			from:           1,
+			to:             4,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 10, helper.NormalizedPacketLossKey: 0.005},
+			minConstraints: map[helper.WeightKey]float64{},
+			wantErr:        true,
+		},
+		{
+			name:           "Test constrained shortest path bandwidth constraint removes feasible subpath",
+			nodes:          nodes,
+			edges:          edges,
+			from:           1,
+			to:             4,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 10},
+			minConstraints: map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 600000},
+			wantErr:        true,
+		},
+		{
+			name:           "Test constrained shortest path max hop count satisfied by cheapest path",
+			nodes:          nodes,
+			edges:          edges,
+			from:           1,
+			to:             4,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 15, helper.HopCountKey: 2},
+			minConstraints: map[helper.WeightKey]float64{},
+			wantEdgeIds:    []string{"1", "4"},
+			wantCost:       2000,
+		},
+		{
+			name:           "Test constrained shortest path max SID depth and max jitter not satisfiable",
+			nodes:          nodes,
+			edges:          edges,
+			from:           1,
+			to:             4,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 10, helper.SidDepthKey: 2},
+			minConstraints: map[helper.WeightKey]float64{},
+			wantErr:        true,
+		},
+		{
+			name:           "Test constrained shortest path no path",
+			nodes:          nodes,
+			edges:          edges,
+			from:           4,
+			to:             1,
+			weightKey:      helper.LatencyKey,
+			maxConstraints: map[helper.WeightKey]float64{},
+			minConstraints: map[helper.WeightKey]float64{},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(tt.nodes, tt.edges)
+			assert.NoError(t, err)
+			calculationOptions := &CalculationOptions{networkGraph, tt.nodes[tt.from], tt.nodes[tt.to], []helper.WeightKey{tt.weightKey}, CalculationModeSum, tt.maxConstraints, tt.minConstraints, nil, nil}
+			calculation := NewConstrainedShortestPathCalculation(calculationOptions)
+			path, err := calculation.Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, path)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(path))
+			assert.True(t, almostEqual(tt.wantCost, path.GetTotalCost()))
+		})
+	}
+}
+
+func TestConstrainedShortestPathCalculation_Execute_TopologyConstraints(t *testing.T) {
+	nodes, edges := setupKShortestPathTestElements()
+	tests := []struct {
+		name           string
+		maxConstraints map[helper.WeightKey]float64
+		excludedNodes  []string
+		excludedEdges  []string
+		includedNodes  []string
+		includedEdges  []string
+		wantEdgeIds    []string
+		wantCost       float64
+		wantErr        bool
+	}{
+		{
+			name:           "Test topology constraints excluded edge",
+			maxConstraints: map[helper.WeightKey]float64{},
+			excludedEdges:  []string{"7"},
+			wantEdgeIds:    []string{"2", "6", "9"},
+			wantCost:       7000,
+		},
+		{
+			name:           "Test topology constraints excluded node",
+			maxConstraints: map[helper.WeightKey]float64{},
+			excludedNodes:  []string{"6"},
+			wantEdgeIds:    []string{"3", "7", "11"},
+			wantCost:       7000,
+		},
+		{
+			name:           "Test topology constraints included node",
+			maxConstraints: map[helper.WeightKey]float64{},
+			includedNodes:  []string{"5"},
+			wantEdgeIds:    []string{"1", "4", "8"},
+			wantCost:       8000,
+		},
+		{
+			name:           "Test topology constraints included edge",
+			maxConstraints: map[helper.WeightKey]float64{},
+			includedEdges:  []string{"5"},
+			wantEdgeIds:    []string{"2", "5", "8"},
+			wantCost:       11000,
+		},
+		{
+			name:           "Test topology constraints included node with max hop count",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 3},
+			includedNodes:  []string{"6"},
+			wantEdgeIds:    []string{"2", "6", "9"},
+			wantCost:       7000,
+		},
+		{
+			name:           "Test topology constraints excluded and included node not satisfiable",
+			maxConstraints: map[helper.WeightKey]float64{},
+			excludedNodes:  []string{"5"},
+			includedNodes:  []string{"5"},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			topologyConstraints := NewTopologyConstraints()
+			for _, nodeId := range tt.excludedNodes {
+				topologyConstraints.excludedNodes[nodeId] = struct{}{}
+			}
+			for _, edgeId := range tt.excludedEdges {
+				topologyConstraints.excludedEdges[edgeId] = struct{}{}
+			}
+			topologyConstraints.includedNodes = append(topologyConstraints.includedNodes, tt.includedNodes...)
+			topologyConstraints.includedEdges = append(topologyConstraints.includedEdges, tt.includedEdges...)
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[8], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, tt.maxConstraints, map[helper.WeightKey]float64{}, nil, topologyConstraints}
+			path, err := NewConstrainedShortestPathCalculation(calculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, path)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(path))
+			assert.True(t, almostEqual(tt.wantCost, path.GetTotalCost()))
+		})
+	}
+}
+
+func TestConstrainedShortestPathCalculation_ExecuteFindsPathMissedByDijkstra(t *testing.T) {
+	nodes, edges := setupConstrainedPathTestElements()
+	networkGraph, err := setupGraph(nodes, edges)
+	assert.NoError(t, err)
+	maxConstraints := map[helper.WeightKey]float64{helper.NormalizedJitterKey: 10}
+	calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, maxConstraints, map[helper.WeightKey]float64{}, nil, nil}
+	_, err = NewShortestPathCalculation(calculationOptions).Execute()
+	assert.Error(t, err)
+	path, err := NewConstrainedShortestPathCalculation(calculationOptions).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, 7.0, path.GetTotalJitter())
+}
+
+func TestConstrainedShortestPathCalculation_dominates(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxConstraints map[helper.WeightKey]float64
+		label          *pathLabel
+		otherLabel     *pathLabel
+		want           bool
+	}{
+		{
+			name:           "Test dominates cheaper label without constraints",
+			maxConstraints: map[helper.WeightKey]float64{},
+			label:          &pathLabel{cost: 1, jitter: 10},
+			otherLabel:     &pathLabel{cost: 2, jitter: 1},
+			want:           true,
+		},
+		{
+			name:           "Test dominates cheaper label with worse constrained metric",
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 10},
+			label:          &pathLabel{cost: 1, jitter: 10},
+			otherLabel:     &pathLabel{cost: 2, jitter: 1},
+			want:           false,
+		},
+		{
+			name:           "Test dominates cheaper label with better constrained metrics",
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedLatencyKey: 10, helper.NormalizedPacketLossKey: 0.1},
+			label:          &pathLabel{cost: 1, latency: 1, packetLoss: 0.01},
+			otherLabel:     &pathLabel{cost: 2, latency: 2, packetLoss: 0.01},
+			want:           true,
+		},
+		{
+			name:           "Test dominates more expensive label",
+			maxConstraints: map[helper.WeightKey]float64{},
+			label:          &pathLabel{cost: 3},
+			otherLabel:     &pathLabel{cost: 2},
+			want:           false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculation := NewConstrainedShortestPathCalculation(&CalculationOptions{maxConstraints: tt.maxConstraints})
+			assert.Equal(t, tt.want, calculation.dominates(tt.label, tt.otherLabel))
+		})
+	}
+}
+
+func TestConstrainedShortestPathCalculation_removeDominatedLabels(t *testing.T) {
+	calculation := NewConstrainedShortestPathCalculation(&CalculationOptions{maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 10}})
+	dominatedLabel := &pathLabel{nodeId: "2", cost: 3, jitter: 5}
+	remainingLabel := &pathLabel{nodeId: "2", cost: 1, jitter: 8}
+	calculation.nodeLabels[labelState{nodeId: "2"}] = []*pathLabel{dominatedLabel, remainingLabel}
+	newLabel := &pathLabel{nodeId: "2", cost: 2, jitter: 2}
+	assert.False(t, calculation.isDominated(newLabel))
+	calculation.removeDominatedLabels(newLabel)
+	assert.True(t, dominatedLabel.dominated)
+	assert.False(t, remainingLabel.dominated)
+	assert.Equal(t, []*pathLabel{remainingLabel}, calculation.nodeLabels[labelState{nodeId: "2"}])
+	assert.True(t, calculation.isDominated(&pathLabel{nodeId: "2", cost: 4, jitter: 9}))
+}
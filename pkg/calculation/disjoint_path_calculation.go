@@ -0,0 +1,374 @@
+package calculation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+)
+
+// DisjointPathCalculation implements Bhandari's algorithm to find a link- or node-disjoint primary and backup path
+type DisjointPathCalculation struct {
+	BaseCalculation
+	disjointnessType domain.DisjointnessType
+	primaryPath      graph.Path
+	primaryEdges     map[string]graph.Edge
+	primaryLinks     map[string]struct{}
+	primaryNodes     map[string]struct{}
+}
+
+type residualEdge struct {
+	from     string
+	to       string
+	cost     float64
+	edge     graph.Edge
+	reversed bool
+}
+
+func NewDisjointPathCalculation(options *CalculationOptions, disjointnessType domain.DisjointnessType) *DisjointPathCalculation {
+	return &DisjointPathCalculation{
+		BaseCalculation:  *NewBaseCalculation(options),
+		disjointnessType: disjointnessType,
+		primaryEdges:     make(map[string]graph.Edge),
+		primaryLinks:     make(map[string]struct{}),
+		primaryNodes:     make(map[string]struct{}),
+	}
+}
+
+func getLinkKey(from, to string) string {
+	return from + "->" + to
+}
+
+func (calculation *DisjointPathCalculation) calculatePrimaryPath() error {
+	primaryPath, err := NewShortestPathCalculation(&CalculationOptions{
+		graph:           calculation.graph,
+		sourceNode:      calculation.source,
+		destinationNode: calculation.destination,
+		weightKeys:      calculation.weightKeys,
+		calculationMode: calculation.calculationMode,
+		maxConstraints:  calculation.maxConstraints,
+		minConstraints:  calculation.minConstraints,
+		weights:         calculation.weights,
+	}).Execute()
+	if err != nil {
+		return err
+	}
+	calculation.primaryPath = primaryPath
+	for _, edge := range primaryPath.GetEdges() {
+		calculation.primaryEdges[edge.GetId()] = edge
+		calculation.primaryLinks[getLinkKey(edge.To().GetId(), edge.From().GetId())] = struct{}{}
+		if edge.To().GetId() != calculation.destination.GetId() {
+			calculation.primaryNodes[edge.To().GetId()] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (calculation *DisjointPathCalculation) isPrimaryNode(nodeId string) bool {
+	_, ok := calculation.primaryNodes[nodeId]
+	return ok
+}
+
+func (calculation *DisjointPathCalculation) isReverseOfPrimaryEdge(edge graph.Edge) bool {
+	_, ok := calculation.primaryLinks[getLinkKey(edge.From().GetId(), edge.To().GetId())]
+	return ok
+}
+
+func (calculation *DisjointPathCalculation) getIncomingNodeId(nodeId string) string {
+	if calculation.disjointnessType == domain.DisjointnessTypeNode && calculation.isPrimaryNode(nodeId) {
+		return nodeId + "/in"
+	}
+	return nodeId
+}
+
+func (calculation *DisjointPathCalculation) getOutgoingNodeId(nodeId string) string {
+	if calculation.disjointnessType == domain.DisjointnessTypeNode && calculation.isPrimaryNode(nodeId) {
+		return nodeId + "/out"
+	}
+	return nodeId
+}
+
+func (calculation *DisjointPathCalculation) getUsableEdges() []graph.Edge {
+	edges := calculation.graph.GetEdges()
+	edgeIds := make([]string, 0, len(edges))
+	for edgeId := range edges {
+		edgeIds = append(edgeIds, edgeId)
+	}
+	sort.Strings(edgeIds)
+	usableEdges := make([]graph.Edge, 0, len(edgeIds))
+	for _, edgeId := range edgeIds {
+		if !calculation.violatesBandwidthMinConstraint(edges[edgeId]) {
+			usableEdges = append(usableEdges, edges[edgeId])
+		}
+	}
+	return usableEdges
+}
+
+func (calculation *DisjointPathCalculation) buildResidualGraph() []residualEdge {
+	residualEdges := make([]residualEdge, 0)
+	for _, edge := range calculation.getUsableEdges() {
+		fromId, toId := edge.From().GetId(), edge.To().GetId()
+		if _, ok := calculation.primaryEdges[edge.GetId()]; ok {
+			residualEdges = append(residualEdges, residualEdge{calculation.getIncomingNodeId(toId), calculation.getOutgoingNodeId(fromId), -calculation.getEdgeCost(edge), edge, true})
+		} else if !calculation.isReverseOfPrimaryEdge(edge) {
+			residualEdges = append(residualEdges, residualEdge{calculation.getOutgoingNodeId(fromId), calculation.getIncomingNodeId(toId), calculation.getEdgeCost(edge), edge, false})
+		}
+	}
+	if calculation.disjointnessType == domain.DisjointnessTypeNode {
+		for nodeId := range calculation.primaryNodes {
+			residualEdges = append(residualEdges, residualEdge{calculation.getOutgoingNodeId(nodeId), calculation.getIncomingNodeId(nodeId), 0, nil, true})
+		}
+	}
+	return residualEdges
+}
+
+func (calculation *DisjointPathCalculation) countSharedElements(edge graph.Edge) int {
+	sharedElements := 0
+	if _, ok := calculation.primaryEdges[edge.GetId()]; ok || calculation.isReverseOfPrimaryEdge(edge) {
+		sharedElements++
+	}
+	if calculation.disjointnessType == domain.DisjointnessTypeNode && calculation.isPrimaryNode(edge.To().GetId()) {
+		sharedElements++
+	}
+	return sharedElements
+}
+
+func (calculation *DisjointPathCalculation) buildPenaltyGraph() []residualEdge {
+	usableEdges := calculation.getUsableEdges()
+	penalty := 1.0
+	for _, edge := range usableEdges {
+		penalty += math.Abs(calculation.getEdgeCost(edge))
+	}
+	residualEdges := make([]residualEdge, 0, len(usableEdges))
+	for _, edge := range usableEdges {
+		cost := calculation.getEdgeCost(edge) + float64(calculation.countSharedElements(edge))*penalty
+		residualEdges = append(residualEdges, residualEdge{edge.From().GetId(), edge.To().GetId(), cost, edge, false})
+	}
+	return residualEdges
+}
+
+func (calculation *DisjointPathCalculation) relaxResidualEdges(residualEdges []residualEdge, distances map[string]float64, previous map[string]residualEdge) bool {
+	updated := false
+	for _, residualEdge := range residualEdges {
+		distance, ok := distances[residualEdge.from]
+		if !ok {
+			continue
+		}
+		if currentDistance, ok := distances[residualEdge.to]; !ok || distance+residualEdge.cost < currentDistance {
+			distances[residualEdge.to] = distance + residualEdge.cost
+			previous[residualEdge.to] = residualEdge
+			updated = true
+		}
+	}
+	return updated
+}
+
+func (calculation *DisjointPathCalculation) performBellmanFord(residualEdges []residualEdge) ([]residualEdge, error) {
+	sourceId, destinationId := calculation.source.GetId(), calculation.destination.GetId()
+	distances := map[string]float64{sourceId: 0}
+	previous := make(map[string]residualEdge)
+	for iteration := 0; iteration <= len(residualEdges); iteration++ {
+		if !calculation.relaxResidualEdges(residualEdges, distances, previous) {
+			break
+		}
+	}
+	if _, ok := distances[destinationId]; !ok {
+		return nil, fmt.Errorf("No disjoint path found from node %s to node %s", sourceId, destinationId)
+	}
+	path := make([]residualEdge, 0)
+	for current := destinationId; current != sourceId; current = previous[current].from {
+		if len(path) > len(residualEdges) {
+			return nil, fmt.Errorf("Negative cycle detected while calculating disjoint path")
+		}
+		path = append([]residualEdge{previous[current]}, path...)
+	}
+	return path, nil
+}
+
+func (calculation *DisjointPathCalculation) combinePaths(residualPath []residualEdge) map[string]graph.Edge {
+	edges := make(map[string]graph.Edge, len(calculation.primaryEdges))
+	for edgeId, edge := range calculation.primaryEdges {
+		edges[edgeId] = edge
+	}
+	for _, residualEdge := range residualPath {
+		if residualEdge.edge == nil {
+			continue
+		}
+		if residualEdge.reversed {
+			delete(edges, residualEdge.edge.GetId())
+		} else {
+			edges[residualEdge.edge.GetId()] = residualEdge.edge
+		}
+	}
+	return edges
+}
+
+func (calculation *DisjointPathCalculation) getOutgoingEdges(edges map[string]graph.Edge) map[string][]graph.Edge {
+	edgeIds := make([]string, 0, len(edges))
+	for edgeId := range edges {
+		edgeIds = append(edgeIds, edgeId)
+	}
+	sort.Strings(edgeIds)
+	outgoingEdges := make(map[string][]graph.Edge)
+	for _, edgeId := range edgeIds {
+		fromId := edges[edgeId].From().GetId()
+		outgoingEdges[fromId] = append(outgoingEdges[fromId], edges[edgeId])
+	}
+	return outgoingEdges
+}
+
+func (calculation *DisjointPathCalculation) splitPaths(edges map[string]graph.Edge) ([]graph.Path, error) {
+	outgoingEdges := calculation.getOutgoingEdges(edges)
+	paths := make([]graph.Path, 0, 2)
+	for len(paths) < 2 {
+		pathEdges := make([]graph.Edge, 0)
+		for current := calculation.source.GetId(); current != calculation.destination.GetId(); {
+			if len(outgoingEdges[current]) == 0 {
+				return nil, fmt.Errorf("Disjoint paths could not be separated at node %s", current)
+			}
+			edge := outgoingEdges[current][0]
+			outgoingEdges[current] = outgoingEdges[current][1:]
+			pathEdges = append(pathEdges, edge)
+			current = edge.To().GetId()
+		}
+		paths = append(paths, calculation.createPathFromEdges(pathEdges))
+	}
+	if calculation.isBetterCost(paths[1].GetTotalCost(), paths[0].GetTotalCost()) {
+		paths[0], paths[1] = paths[1], paths[0]
+	}
+	return paths, nil
+}
+
+func (calculation *DisjointPathCalculation) pathViolatesMaxConstraints(path graph.Path) bool {
+	metrics := map[helper.WeightKey]float64{
+		helper.NormalizedLatencyKey:    path.GetTotalDelay(),
+		helper.NormalizedJitterKey:     path.GetTotalJitter(),
+		helper.NormalizedPacketLossKey: path.GetTotalPacketLoss(),
+	}
+	for key, value := range metrics {
+		if maxValue, ok := calculation.maxConstraints[key]; ok && maxValue < value {
+			calculation.log.Debugf("Path violates %s constraint", key)
+			return true
+		}
+	}
+	if maxHopCount, ok := calculation.getMaxHopCount(); ok && len(path.GetEdges()) > maxHopCount {
+		calculation.log.Debugf("Path exceeds the maximum of %d hops", maxHopCount)
+		return true
+	}
+	return false
+}
+
+func (calculation *DisjointPathCalculation) calculateDisjointPaths() ([]graph.Path, error) {
+	residualPath, err := calculation.performBellmanFord(calculation.buildResidualGraph())
+	if err != nil {
+		return nil, err
+	}
+	paths, err := calculation.splitPaths(calculation.combinePaths(residualPath))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		if calculation.pathViolatesMaxConstraints(path) {
+			return nil, fmt.Errorf("Disjoint paths violate the maximum constraints")
+		}
+	}
+	return paths, nil
+}
+
+func (calculation *DisjointPathCalculation) isPrimaryPath(edges []graph.Edge) bool {
+	if len(edges) != len(calculation.primaryEdges) {
+		return false
+	}
+	for _, edge := range edges {
+		if _, ok := calculation.primaryEdges[edge.GetId()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (calculation *DisjointPathCalculation) calculateMaximallyDisjointPath() (graph.Path, error) {
+	residualPath, err := calculation.performBellmanFord(calculation.buildPenaltyGraph())
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]graph.Edge, 0, len(residualPath))
+	for _, residualEdge := range residualPath {
+		edges = append(edges, residualEdge.edge)
+	}
+	if calculation.isPrimaryPath(edges) {
+		return nil, fmt.Errorf("No backup path available besides the primary path")
+	}
+	backupPath := calculation.createPathFromEdges(edges)
+	if calculation.pathViolatesMaxConstraints(backupPath) {
+		return nil, fmt.Errorf("Backup path violates the maximum constraints")
+	}
+	return backupPath, nil
+}
+
+func (calculation *DisjointPathCalculation) calculateExcludedPath() (graph.Path, error) {
+	backupCalculation := NewShortestPathCalculation(&CalculationOptions{
+		graph:           calculation.graph,
+		sourceNode:      calculation.source,
+		destinationNode: calculation.destination,
+		weightKeys:      calculation.weightKeys,
+		calculationMode: calculation.calculationMode,
+		maxConstraints:  calculation.maxConstraints,
+		minConstraints:  calculation.minConstraints,
+		weights:         calculation.weights,
+	})
+	for _, edge := range calculation.graph.GetEdges() {
+		if calculation.countSharedElements(edge) > 0 {
+			backupCalculation.ExcludeEdge(edge.GetId())
+		}
+	}
+	return backupCalculation.Execute()
+}
+
+func (calculation *DisjointPathCalculation) countSharedPathElements(path graph.Path) int {
+	sharedElements := 0
+	for _, edge := range path.GetEdges() {
+		sharedElements += calculation.countSharedElements(edge)
+	}
+	return sharedElements
+}
+
+func (calculation *DisjointPathCalculation) calculateBackupPath() graph.Path {
+	if calculation.calculationMode != CalculationModeSum {
+		backupPath, err := calculation.calculateExcludedPath()
+		if err != nil {
+			calculation.log.Warnf("No %s-disjoint backup path found: %s", calculation.disjointnessType, err)
+			return nil
+		}
+		return backupPath
+	}
+	backupPath, err := calculation.calculateMaximallyDisjointPath()
+	if err != nil {
+		calculation.log.Warnf("No backup path found: %s", err)
+		return nil
+	}
+	if sharedElements := calculation.countSharedPathElements(backupPath); sharedElements > 0 {
+		calculation.log.Warnf("No fully %s-disjoint path found, backup path shares %d elements with the primary path", calculation.disjointnessType, sharedElements)
+	}
+	return backupPath
+}
+
+func (calculation *DisjointPathCalculation) Execute() (graph.Path, error) {
+	if err := calculation.calculatePrimaryPath(); err != nil {
+		return nil, err
+	}
+	if calculation.calculationMode == CalculationModeSum {
+		paths, err := calculation.calculateDisjointPaths()
+		if err == nil {
+			calculation.log.Debugf("Calculation finished - %s-disjoint primary and backup path found", calculation.disjointnessType)
+			paths[0].SetBackupPath(paths[1])
+			return paths[0], nil
+		}
+		calculation.log.Debugf("No %s-disjoint path pair found: %s", calculation.disjointnessType, err)
+	}
+	calculation.primaryPath.SetBackupPath(calculation.calculateBackupPath())
+	return calculation.primaryPath, nil
+}
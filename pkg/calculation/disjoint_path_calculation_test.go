@@ -0,0 +1,252 @@
+package calculation
+
+import (
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupSharedNodeTestElements() (map[int]graph.Node, map[int]graph.Edge) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+		4: graph.NewNetworkNode("4", "4", srAlgorithm),
+		5: graph.NewNetworkNode("5", "5", srAlgorithm),
+	}
+	//  [1]-1-[2]-1-[5]
+	//   |   / \     |
+	//  1| 1/   \1   |1
+	//   | /     \   |
+	//  [3]      [4]-+
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		2: graph.NewNetworkEdge("2", nodes[2], nodes[5], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		3: graph.NewNetworkEdge("3", nodes[1], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		4: graph.NewNetworkEdge("4", nodes[3], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		5: graph.NewNetworkEdge("5", nodes[2], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		6: graph.NewNetworkEdge("6", nodes[4], nodes[5], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+	}
+	return nodes, edges
+}
+
+func TestNewDisjointPathCalculation(t *testing.T) {
+	calculation := NewDisjointPathCalculation(&CalculationOptions{}, domain.DisjointnessTypeLink)
+	assert.NotNil(t, calculation)
+	assert.Equal(t, domain.DisjointnessTypeLink, calculation.disjointnessType)
+}
+
+func TestDisjointPathCalculation_Execute(t *testing.T) {
+	nodes, edges := setupKShortestPathTestElements()
+	interlacingEdges := make(map[int]graph.Edge)
+	for index, edge := range edges {
+		if index != 1 && index != 4 {
+			interlacingEdges[index] = edge
+		}
+	}
+	sharedNodeNodes, sharedNodeEdges := setupSharedNodeTestElements()
+	tests := []struct {
+		name             string
+		nodes            map[int]graph.Node
+		edges            map[int]graph.Edge
+		from             int
+		to               int
+		weightKey        helper.WeightKey
+		calculationMode  CalculationMode
+		disjointnessType domain.DisjointnessType
+		wantPrimary      []string
+		wantBackup       []string
+		wantErr          bool
+	}{
+		{
+			name:             "Test link-disjoint paths low latency",
+			nodes:            nodes,
+			edges:            edges,
+			from:             1,
+			to:               8,
+			weightKey:        helper.LatencyKey,
+			calculationMode:  CalculationModeSum,
+			disjointnessType: domain.DisjointnessTypeLink,
+			wantPrimary:      []string{"3", "7", "10", "9"},
+			wantBackup:       []string{"1", "4", "8"},
+		},
+		{
+			name:             "Test node-disjoint paths low latency",
+			nodes:            nodes,
+			edges:            edges,
+			from:             1,
+			to:               8,
+			weightKey:        helper.LatencyKey,
+			calculationMode:  CalculationModeSum,
+			disjointnessType: domain.DisjointnessTypeNode,
+			wantPrimary:      []string{"3", "7", "10", "9"},
+			wantBackup:       []string{"1", "4", "8"},
+		},
+		{
+			name:             "Test link-disjoint paths with interlacing primary path",
+			nodes:            nodes,
+			edges:            interlacingEdges,
+			from:             1,
+			to:               8,
+			weightKey:        helper.LatencyKey,
+			calculationMode:  CalculationModeSum,
+			disjointnessType: domain.DisjointnessTypeLink,
+			wantPrimary:      []string{"2", "6", "9"},
+			wantBackup:       []string{"3", "7", "11"},
+		},
+		{
+			name:             "Test maximally link-disjoint paths with shared edge",
+			nodes:            nodes,
+			edges:            edges,
+			from:             4,
+			to:               8,
+			weightKey:        helper.LatencyKey,
+			calculationMode:  CalculationModeSum,
+			disjointnessType: domain.DisjointnessTypeLink,
+			wantPrimary:      []string{"7", "10", "9"},
+			wantBackup:       []string{"7", "11"},
+		},
+		{
+			name:             "Test link-disjoint paths sharing a node",
+			nodes:            sharedNodeNodes,
+			edges:            sharedNodeEdges,
+			from:             1,
+			to:               5,
+			weightKey:        helper.LatencyKey,
+			calculationMode:  CalculationModeSum,
+			disjointnessType: domain.DisjointnessTypeLink,
+			wantPrimary:      []string{"1", "2"},
+			wantBackup:       []string{"3", "4", "5", "6"},
+		},
+		{
+			name:             "Test maximally node-disjoint paths sharing a node",
+			nodes:            sharedNodeNodes,
+			edges:            sharedNodeEdges,
+			from:             1,
+			to:               5,
+			weightKey:        helper.LatencyKey,
+			calculationMode:  CalculationModeSum,
+			disjointnessType: domain.DisjointnessTypeNode,
+			wantPrimary:      []string{"1", "2"},
+			wantBackup:       []string{"3", "4", "5", "6"},
+		},
+		{
+			name:             "Test link-disjoint paths high bandwidth",
+			nodes:            nodes,
+			edges:            edges,
+			from:             1,
+			to:               8,
+			weightKey:        helper.AvailableBandwidthKey,
+			calculationMode:  CalculationModeMax,
+			disjointnessType: domain.DisjointnessTypeLink,
+			wantPrimary:      []string{"3", "7", "10", "9"},
+			wantBackup:       []string{"2", "5", "8"},
+		},
+		{
+			name:             "Test link-disjoint paths high bandwidth without backup",
+			nodes:            nodes,
+			edges:            edges,
+			from:             4,
+			to:               8,
+			weightKey:        helper.AvailableBandwidthKey,
+			calculationMode:  CalculationModeMax,
+			disjointnessType: domain.DisjointnessTypeLink,
+			wantPrimary:      []string{"7", "10", "9"},
+		},
+		{
+			name:             "Test disjoint paths no primary path",
+			nodes:            nodes,
+			edges:            edges,
+			from:             8,
+			to:               1,
+			weightKey:        helper.LatencyKey,
+			calculationMode:  CalculationModeSum,
+			disjointnessType: domain.DisjointnessTypeLink,
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(tt.nodes, tt.edges)
+			assert.NoError(t, err)
+			calculationOptions := &CalculationOptions{networkGraph, tt.nodes[tt.from], tt.nodes[tt.to], []helper.WeightKey{tt.weightKey}, tt.calculationMode, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, nil}
+			calculation := NewDisjointPathCalculation(calculationOptions, tt.disjointnessType)
+			primaryPath, err := calculation.Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, primaryPath)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPrimary, getEdgeIds(primaryPath))
+			if tt.wantBackup == nil {
+				assert.Nil(t, primaryPath.GetBackupPath())
+				return
+			}
+			assert.Equal(t, tt.wantBackup, getEdgeIds(primaryPath.GetBackupPath()))
+		})
+	}
+}
+
+func TestDisjointPathCalculation_buildResidualGraph(t *testing.T) {
+	nodes, edges := setupSharedNodeTestElements()
+	tests := []struct {
+		name              string
+		disjointnessType  domain.DisjointnessType
+		wantResidualEdges int
+		wantReversedEdges int
+	}{
+		{
+			name:              "Test buildResidualGraph link-disjoint",
+			disjointnessType:  domain.DisjointnessTypeLink,
+			wantResidualEdges: 6,
+			wantReversedEdges: 2,
+		},
+		{
+			name:              "Test buildResidualGraph node-disjoint",
+			disjointnessType:  domain.DisjointnessTypeNode,
+			wantResidualEdges: 7,
+			wantReversedEdges: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[5], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, nil}
+			calculation := NewDisjointPathCalculation(calculationOptions, tt.disjointnessType)
+			assert.NoError(t, calculation.calculatePrimaryPath())
+			residualEdges := calculation.buildResidualGraph()
+			assert.Len(t, residualEdges, tt.wantResidualEdges)
+			reversedEdges := 0
+			for _, residualEdge := range residualEdges {
+				if residualEdge.reversed {
+					reversedEdges++
+					assert.LessOrEqual(t, residualEdge.cost, 0.0)
+				}
+			}
+			assert.Equal(t, tt.wantReversedEdges, reversedEdges)
+		})
+	}
+}
+
+func TestDisjointPathCalculation_performBellmanFord(t *testing.T) {
+	nodes, _ := setupSharedNodeTestElements()
+	calculationOptions := &CalculationOptions{sourceNode: nodes[1], destinationNode: nodes[5], weightKeys: []helper.WeightKey{helper.LatencyKey}, calculationMode: CalculationModeSum}
+	calculation := NewDisjointPathCalculation(calculationOptions, domain.DisjointnessTypeLink)
+	residualEdges := []residualEdge{
+		{from: "1", to: "2", cost: 5},
+		{from: "1", to: "3", cost: 1},
+		{from: "3", to: "2", cost: -2},
+		{from: "2", to: "5", cost: 1},
+	}
+	residualPath, err := calculation.performBellmanFord(residualEdges)
+	assert.NoError(t, err)
+	assert.Equal(t, []residualEdge{residualEdges[1], residualEdges[2], residualEdges[3]}, residualPath)
+	_, err = calculation.performBellmanFord(residualEdges[:3])
+	assert.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package calculation
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+)
+
+// igpShortestPathTree holds the IGP distances from a root node and whether each node is reached by a single shortest path.
+// Traffic steered to a node SID follows all equal cost shortest paths, so only a path without ECMP can be left to the IGP.
+type igpShortestPathTree struct {
+	distances  map[string]float64
+	pathCounts map[string]int
+}
+
+func newIgpShortestPathTree(networkGraph graph.Graph, rootId string) *igpShortestPathTree {
+	tree := &igpShortestPathTree{
+		distances:  map[string]float64{rootId: 0},
+		pathCounts: map[string]int{rootId: 1},
+	}
+	tree.build(networkGraph, rootId)
+	return tree
+}
+
+func (tree *igpShortestPathTree) getDistance(nodeId string) float64 {
+	if distance, ok := tree.distances[nodeId]; ok {
+		return distance
+	}
+	return math.Inf(1)
+}
+
+func (tree *igpShortestPathTree) relaxEdge(edge graph.Edge, currentNodeId string, priorityQueue *PriorityQueue) {
+	neighborNodeId := edge.To().GetId()
+	distance := tree.distances[currentNodeId] + edge.GetWeight(helper.IgpMetricKey)
+	switch {
+	case distance < tree.getDistance(neighborNodeId):
+		tree.distances[neighborNodeId] = distance
+		tree.pathCounts[neighborNodeId] = tree.pathCounts[currentNodeId]
+		heap.Push(priorityQueue, &Item{nodeId: neighborNodeId, cost: distance})
+	case distance == tree.getDistance(neighborNodeId):
+		// two paths are enough to know that the IGP load balances, capping avoids overflows in large meshes
+		tree.pathCounts[neighborNodeId] = min(tree.pathCounts[neighborNodeId]+tree.pathCounts[currentNodeId], 2)
+	}
+}
+
+func (tree *igpShortestPathTree) build(networkGraph graph.Graph, rootId string) {
+	priorityQueue := NewMinimumPriorityQueue()
+	heap.Init(priorityQueue)
+	heap.Push(priorityQueue, &Item{nodeId: rootId, cost: 0})
+	visitedNodes := make(map[string]bool)
+	for !priorityQueue.IsEmpty() {
+		currentNodeId := heap.Pop(priorityQueue).(*Item).GetNodeId()
+		if visitedNodes[currentNodeId] {
+			continue
+		}
+		visitedNodes[currentNodeId] = true
+		currentNode := networkGraph.GetNode(currentNodeId)
+		if currentNode == nil {
+			continue
+		}
+		for _, edge := range currentNode.GetEdges() {
+			tree.relaxEdge(edge, currentNodeId, priorityQueue)
+		}
+	}
+}
+
+func (tree *igpShortestPathTree) isUniqueShortestPath(nodeId string, cost float64) bool {
+	return tree.getDistance(nodeId) == cost && tree.pathCounts[nodeId] == 1
+}
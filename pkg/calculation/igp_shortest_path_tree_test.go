@@ -0,0 +1,83 @@
+package calculation
+
+import (
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupIgpShortestPathTestElements() (map[int]graph.Node, map[int]graph.Edge) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+		4: graph.NewNetworkNode("4", "4", srAlgorithm),
+		5: graph.NewNetworkNode("5", "5", srAlgorithm),
+	}
+	//  [1]--10-->[2]--10-->[3]--10-->[4]
+	//   |  \                ^         ^
+	//   |   +--10-->[5]--10-+         |
+	//   +-------------100-------------+
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.IgpMetricKey: 10}),
+		2: graph.NewNetworkEdge("2", nodes[2], nodes[3], map[helper.WeightKey]float64{helper.IgpMetricKey: 10}),
+		3: graph.NewNetworkEdge("3", nodes[3], nodes[4], map[helper.WeightKey]float64{helper.IgpMetricKey: 10}),
+		4: graph.NewNetworkEdge("4", nodes[1], nodes[5], map[helper.WeightKey]float64{helper.IgpMetricKey: 10}),
+		5: graph.NewNetworkEdge("5", nodes[5], nodes[3], map[helper.WeightKey]float64{helper.IgpMetricKey: 10}),
+		6: graph.NewNetworkEdge("6", nodes[1], nodes[4], map[helper.WeightKey]float64{helper.IgpMetricKey: 100}),
+	}
+	return nodes, edges
+}
+
+func TestIgpShortestPathTree(t *testing.T) {
+	nodes, edges := setupIgpShortestPathTestElements()
+	networkGraph, err := setupGraph(nodes, edges)
+	assert.NoError(t, err)
+	tree := newIgpShortestPathTree(networkGraph, "1")
+	tests := []struct {
+		name       string
+		nodeId     string
+		cost       float64
+		wantUnique bool
+	}{
+		{
+			name:       "Test IGP shortest path tree unique shortest path",
+			nodeId:     "2",
+			cost:       10,
+			wantUnique: true,
+		},
+		{
+			name:       "Test IGP shortest path tree equal cost paths",
+			nodeId:     "3",
+			cost:       20,
+			wantUnique: false,
+		},
+		{
+			name:       "Test IGP shortest path tree equal cost paths behind ECMP node",
+			nodeId:     "4",
+			cost:       30,
+			wantUnique: false,
+		},
+		{
+			name:       "Test IGP shortest path tree longer path",
+			nodeId:     "4",
+			cost:       100,
+			wantUnique: false,
+		},
+		{
+			name:       "Test IGP shortest path tree unreachable node",
+			nodeId:     "99",
+			cost:       10,
+			wantUnique: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantUnique, tree.isUniqueShortestPath(tt.nodeId, tt.cost))
+		})
+	}
+	assert.Equal(t, float64(30), tree.getDistance("4"))
+}
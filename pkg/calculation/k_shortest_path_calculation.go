@@ -0,0 +1,159 @@
+package calculation
+
+import (
+	"github.com/hawkv6/hawkeye/pkg/graph"
+)
+
+// KShortestPathCalculation implements Yen's k shortest loopless paths algorithm
+type KShortestPathCalculation struct {
+	BaseCalculation
+	numberOfPaths  int
+	shortestPaths  []graph.Path
+	candidatePaths []graph.Path
+}
+
+func NewKShortestPathCalculation(options *CalculationOptions, numberOfPaths int) *KShortestPathCalculation {
+	return &KShortestPathCalculation{
+		BaseCalculation: *NewBaseCalculation(options),
+		numberOfPaths:   numberOfPaths,
+		shortestPaths:   make([]graph.Path, 0, numberOfPaths),
+		candidatePaths:  make([]graph.Path, 0),
+	}
+}
+
+func (calculation *KShortestPathCalculation) getCalculationOptions(sourceNode graph.Node) *CalculationOptions {
+	return &CalculationOptions{
+		graph:           calculation.graph,
+		sourceNode:      sourceNode,
+		destinationNode: calculation.destination,
+		weightKeys:      calculation.weightKeys,
+		calculationMode: calculation.calculationMode,
+		maxConstraints:  calculation.maxConstraints,
+		minConstraints:  calculation.minConstraints,
+		weights:         calculation.weights,
+	}
+}
+
+func (calculation *KShortestPathCalculation) hasSameRoot(edges, rootEdges []graph.Edge) bool {
+	if len(edges) <= len(rootEdges) {
+		return false
+	}
+	for index, rootEdge := range rootEdges {
+		if edges[index].GetId() != rootEdge.GetId() {
+			return false
+		}
+	}
+	return true
+}
+
+func (calculation *KShortestPathCalculation) excludeUsedElements(spurCalculation *ShortestPathCalculation, rootEdges []graph.Edge) {
+	for _, path := range calculation.shortestPaths {
+		edges := path.GetEdges()
+		if calculation.hasSameRoot(edges, rootEdges) {
+			spurCalculation.ExcludeEdge(edges[len(rootEdges)].GetId())
+		}
+	}
+	for _, rootEdge := range rootEdges {
+		spurCalculation.ExcludeNode(rootEdge.From().GetId())
+	}
+}
+
+func (calculation *KShortestPathCalculation) calculateSpurPath(spurNode graph.Node, rootEdges []graph.Edge) (graph.Path, error) {
+	spurCalculation := NewShortestPathCalculation(calculation.getCalculationOptions(spurNode))
+	rootPath := calculation.createPathFromEdges(rootEdges)
+	spurCalculation.SetInitialSourceNodeMetrics(rootPath.GetTotalCost(), rootPath.GetTotalDelay(), rootPath.GetTotalJitter(), rootPath.GetTotalPacketLoss())
+	spurCalculation.SetInitialSourceNodeHopCount(len(rootEdges))
+	calculation.excludeUsedElements(spurCalculation, rootEdges)
+	spurPath, err := spurCalculation.Execute()
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]graph.Edge, 0, len(rootEdges)+len(spurPath.GetEdges()))
+	edges = append(edges, rootEdges...)
+	edges = append(edges, spurPath.GetEdges()...)
+	return calculation.createPathFromEdges(edges), nil
+}
+
+func (calculation *KShortestPathCalculation) isSamePath(path, otherPath graph.Path) bool {
+	edges := path.GetEdges()
+	otherEdges := otherPath.GetEdges()
+	if len(edges) != len(otherEdges) {
+		return false
+	}
+	for index, edge := range edges {
+		if edge.GetId() != otherEdges[index].GetId() {
+			return false
+		}
+	}
+	return true
+}
+
+func (calculation *KShortestPathCalculation) isKnownPath(path graph.Path) bool {
+	for _, shortestPath := range calculation.shortestPaths {
+		if calculation.isSamePath(path, shortestPath) {
+			return true
+		}
+	}
+	for _, candidatePath := range calculation.candidatePaths {
+		if calculation.isSamePath(path, candidatePath) {
+			return true
+		}
+	}
+	return false
+}
+
+func (calculation *KShortestPathCalculation) addCandidatePaths(previousPath graph.Path) {
+	previousEdges := previousPath.GetEdges()
+	spurNode := calculation.source
+	for index := range previousEdges {
+		if index > 0 {
+			spurNode = previousEdges[index-1].To()
+		}
+		candidatePath, err := calculation.calculateSpurPath(spurNode, previousEdges[:index])
+		if err != nil {
+			calculation.log.Debugf("No spur path found from node %s: %s", spurNode.GetName(), err)
+			continue
+		}
+		if !calculation.isKnownPath(candidatePath) {
+			calculation.candidatePaths = append(calculation.candidatePaths, candidatePath)
+		}
+	}
+}
+
+func (calculation *KShortestPathCalculation) isBetterPath(path, otherPath graph.Path) bool {
+	if path.GetTotalCost() == otherPath.GetTotalCost() {
+		return len(path.GetEdges()) < len(otherPath.GetEdges())
+	}
+	return calculation.isBetterCost(path.GetTotalCost(), otherPath.GetTotalCost())
+}
+
+func (calculation *KShortestPathCalculation) popBestCandidatePath() graph.Path {
+	bestIndex := 0
+	for index, candidatePath := range calculation.candidatePaths {
+		if calculation.isBetterPath(candidatePath, calculation.candidatePaths[bestIndex]) {
+			bestIndex = index
+		}
+	}
+	bestPath := calculation.candidatePaths[bestIndex]
+	calculation.candidatePaths = append(calculation.candidatePaths[:bestIndex], calculation.candidatePaths[bestIndex+1:]...)
+	return bestPath
+}
+
+func (calculation *KShortestPathCalculation) Execute() (graph.Path, error) {
+	bestPath, err := NewShortestPathCalculation(calculation.getCalculationOptions(calculation.source)).Execute()
+	if err != nil {
+		return nil, err
+	}
+	calculation.shortestPaths = append(calculation.shortestPaths, bestPath)
+	for len(calculation.shortestPaths) < calculation.numberOfPaths {
+		calculation.addCandidatePaths(calculation.shortestPaths[len(calculation.shortestPaths)-1])
+		if len(calculation.candidatePaths) == 0 {
+			calculation.log.Debugf("Only %d loopless paths found, %d were requested", len(calculation.shortestPaths), calculation.numberOfPaths)
+			break
+		}
+		calculation.shortestPaths = append(calculation.shortestPaths, calculation.popBestCandidatePath())
+	}
+	calculation.log.Debugf("Calculation finished - %d alternative paths found", len(calculation.shortestPaths)-1)
+	bestPath.SetAlternativePaths(calculation.shortestPaths[1:])
+	return bestPath, nil
+}
@@ -0,0 +1,187 @@
+package calculation
+
+import (
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupKShortestPathTestElements() (map[int]graph.Node, map[int]graph.Edge) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+		4: graph.NewNetworkNode("4", "4", srAlgorithm),
+		5: graph.NewNetworkNode("5", "5", srAlgorithm),
+		6: graph.NewNetworkNode("6", "6", srAlgorithm),
+		7: graph.NewNetworkNode("7", "7", srAlgorithm),
+		8: graph.NewNetworkNode("8", "8", srAlgorithm),
+	}
+	// 	     [1]
+	//      / | \
+	//    1/ 2|  \1
+	//    /   |   \
+	//  [2]  [3]  [4]
+	//   |1   |4   \1
+	//   |    |     \
+	//  [5]  [6]-1-[7]
+	//   \    |    /
+	//    \6  |1  /5
+	//     \  |  /
+	//       [8]
+	edges := map[int]graph.Edge{
+		1:  graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		2:  graph.NewNetworkEdge("2", nodes[1], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 2000, helper.AvailableBandwidthKey: 991000}),
+		3:  graph.NewNetworkEdge("3", nodes[1], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		4:  graph.NewNetworkEdge("4", nodes[2], nodes[5], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 990000}),
+		5:  graph.NewNetworkEdge("5", nodes[3], nodes[5], map[helper.WeightKey]float64{helper.LatencyKey: 3000, helper.AvailableBandwidthKey: 995000}),
+		6:  graph.NewNetworkEdge("6", nodes[3], nodes[6], map[helper.WeightKey]float64{helper.LatencyKey: 4000, helper.AvailableBandwidthKey: 995000}),
+		7:  graph.NewNetworkEdge("7", nodes[4], nodes[7], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		8:  graph.NewNetworkEdge("8", nodes[5], nodes[8], map[helper.WeightKey]float64{helper.LatencyKey: 6000, helper.AvailableBandwidthKey: 999000}),
+		9:  graph.NewNetworkEdge("9", nodes[6], nodes[8], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 999000}),
+		10: graph.NewNetworkEdge("10", nodes[7], nodes[6], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.AvailableBandwidthKey: 998000}),
+		11: graph.NewNetworkEdge("11", nodes[7], nodes[8], map[helper.WeightKey]float64{helper.LatencyKey: 5000, helper.AvailableBandwidthKey: 995000}),
+	}
+	return nodes, edges
+}
+
+func getEdgeIds(path graph.Path) []string {
+	edgeIds := make([]string, 0, len(path.GetEdges()))
+	for _, edge := range path.GetEdges() {
+		edgeIds = append(edgeIds, edge.GetId())
+	}
+	return edgeIds
+}
+
+func TestNewKShortestPathCalculation(t *testing.T) {
+	calculation := NewKShortestPathCalculation(&CalculationOptions{}, 3)
+	assert.NotNil(t, calculation)
+	assert.Equal(t, 3, calculation.numberOfPaths)
+}
+
+func TestKShortestPathCalculation_Execute(t *testing.T) {
+	nodes, edges := setupKShortestPathTestElements()
+	tests := []struct {
+		name            string
+		from            graph.Node
+		to              graph.Node
+		weightKey       helper.WeightKey
+		calculationMode CalculationMode
+		minConstraints  map[helper.WeightKey]float64
+		numberOfPaths   int
+		wantBestPath    []string
+		wantCosts       []float64
+		wantLastEdgeIds []string
+		wantErr         bool
+	}{
+		{
+			name:            "Test k shortest paths low latency",
+			from:            nodes[1],
+			to:              nodes[8],
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			minConstraints:  map[helper.WeightKey]float64{},
+			numberOfPaths:   5,
+			wantBestPath:    []string{"3", "7", "10", "9"},
+			wantCosts:       []float64{4000, 7000, 7000, 8000, 11000},
+			wantLastEdgeIds: []string{"2", "5", "8"},
+		},
+		{
+			name:            "Test k shortest paths low latency fewer paths than requested",
+			from:            nodes[1],
+			to:              nodes[8],
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			minConstraints:  map[helper.WeightKey]float64{},
+			numberOfPaths:   10,
+			wantBestPath:    []string{"3", "7", "10", "9"},
+			wantCosts:       []float64{4000, 7000, 7000, 8000, 11000},
+			wantLastEdgeIds: []string{"2", "5", "8"},
+		},
+		{
+			name:            "Test k shortest paths low latency with bandwidth constraint",
+			from:            nodes[1],
+			to:              nodes[8],
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			minConstraints:  map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 995000},
+			numberOfPaths:   3,
+			wantBestPath:    []string{"3", "7", "10", "9"},
+			wantCosts:       []float64{4000, 7000},
+			wantLastEdgeIds: []string{"3", "7", "11"},
+		},
+		{
+			name:            "Test k shortest paths high bandwidth",
+			from:            nodes[1],
+			to:              nodes[8],
+			weightKey:       helper.AvailableBandwidthKey,
+			calculationMode: CalculationModeMax,
+			minConstraints:  map[helper.WeightKey]float64{},
+			numberOfPaths:   3,
+			wantBestPath:    []string{"3", "7", "10", "9"},
+			wantCosts:       []float64{998000, 995000, 991000},
+		},
+		{
+			name:            "Test k shortest paths no path",
+			from:            nodes[8],
+			to:              nodes[1],
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			minConstraints:  map[helper.WeightKey]float64{},
+			numberOfPaths:   3,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			calculationOptions := &CalculationOptions{networkGraph, tt.from, tt.to, []helper.WeightKey{tt.weightKey}, tt.calculationMode, map[helper.WeightKey]float64{}, tt.minConstraints, nil, nil}
+			calculation := NewKShortestPathCalculation(calculationOptions, tt.numberOfPaths)
+			bestPath, err := calculation.Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, bestPath)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBestPath, getEdgeIds(bestPath))
+			paths := append([]graph.Path{bestPath}, bestPath.GetAlternativePaths()...)
+			assert.Equal(t, len(tt.wantCosts), len(paths))
+			for index, path := range paths {
+				assert.True(t, almostEqual(tt.wantCosts[index], path.GetTotalCost()))
+			}
+			if tt.wantLastEdgeIds != nil {
+				assert.Equal(t, tt.wantLastEdgeIds, getEdgeIds(paths[len(paths)-1]))
+			}
+		})
+	}
+}
+
+func TestKShortestPathCalculation_isSamePath(t *testing.T) {
+	nodes, edges := setupKShortestPathTestElements()
+	calculation := NewKShortestPathCalculation(&CalculationOptions{sourceNode: nodes[1], destinationNode: nodes[8], weightKeys: []helper.WeightKey{helper.LatencyKey}, calculationMode: CalculationModeSum}, 2)
+	path := calculation.createPathFromEdges([]graph.Edge{edges[3], edges[7], edges[11]})
+	samePath := calculation.createPathFromEdges([]graph.Edge{edges[3], edges[7], edges[11]})
+	otherPath := calculation.createPathFromEdges([]graph.Edge{edges[2], edges[6], edges[9]})
+	shorterPath := calculation.createPathFromEdges([]graph.Edge{edges[3], edges[7]})
+	assert.True(t, calculation.isSamePath(path, samePath))
+	assert.False(t, calculation.isSamePath(path, otherPath))
+	assert.False(t, calculation.isSamePath(path, shorterPath))
+}
+
+func TestKShortestPathCalculation_isBetterPath(t *testing.T) {
+	nodes, edges := setupKShortestPathTestElements()
+	calculation := NewKShortestPathCalculation(&CalculationOptions{sourceNode: nodes[1], destinationNode: nodes[8], weightKeys: []helper.WeightKey{helper.LatencyKey}, calculationMode: CalculationModeSum}, 2)
+	longPath := calculation.createPathFromEdges([]graph.Edge{edges[3], edges[7], edges[10], edges[9]})
+	expensivePath := calculation.createPathFromEdges([]graph.Edge{edges[3], edges[7], edges[11]})
+	twoHopPath := calculation.createPathFromEdges([]graph.Edge{edges[1], edges[4]})
+	oneHopPath := calculation.createPathFromEdges([]graph.Edge{edges[2]})
+	assert.True(t, calculation.isBetterPath(longPath, expensivePath))
+	assert.False(t, calculation.isBetterPath(expensivePath, longPath))
+	assert.True(t, calculation.isBetterPath(oneHopPath, twoHopPath))
+	assert.False(t, calculation.isBetterPath(twoHopPath, oneHopPath))
+}
@@ -0,0 +1,147 @@
+package calculation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+)
+
+// lexicographicEpsilon keeps paths with exactly the optimal value inside a zero tolerance band despite floating point sums
+const lexicographicEpsilon = 1e-9
+
+type LexicographicPathCalculation struct {
+	BaseCalculation
+	objectives []helper.WeightKey
+	tolerances []float64
+}
+
+func NewLexicographicPathCalculation(options *CalculationOptions, tolerances []float64) *LexicographicPathCalculation {
+	calculation := &LexicographicPathCalculation{
+		BaseCalculation: *NewBaseCalculation(options),
+		tolerances:      tolerances,
+	}
+	calculation.objectives = make([]helper.WeightKey, 0, len(calculation.weightKeys))
+	for _, weightKey := range calculation.weightKeys {
+		calculation.objectives = append(calculation.objectives, getObjectiveKey(weightKey))
+	}
+	return calculation
+}
+
+func (calculation *LexicographicPathCalculation) copyConstraints(constraints map[helper.WeightKey]float64) map[helper.WeightKey]float64 {
+	copiedConstraints := make(map[helper.WeightKey]float64, len(constraints))
+	for key, value := range constraints {
+		copiedConstraints[key] = value
+	}
+	return copiedConstraints
+}
+
+func (calculation *LexicographicPathCalculation) getTolerance(level int) float64 {
+	if level < len(calculation.tolerances) {
+		return calculation.tolerances[level]
+	}
+	return 0
+}
+
+func (calculation *LexicographicPathCalculation) getTieBreakingKey() helper.WeightKey {
+	for _, objective := range calculation.objectives {
+		if objective != helper.AvailableBandwidthKey && objective != helper.UndefinedKey {
+			return objective
+		}
+	}
+	return helper.IgpMetricKey
+}
+
+func (calculation *LexicographicPathCalculation) getLevelOptions(weightKey helper.WeightKey, maxConstraints, minConstraints map[helper.WeightKey]float64) *CalculationOptions {
+	return &CalculationOptions{calculation.graph, calculation.source, calculation.destination, []helper.WeightKey{weightKey}, CalculationModeSum, maxConstraints, minConstraints, calculation.weights, calculation.topologyConstraints}
+}
+
+func (calculation *LexicographicPathCalculation) getBandwidthCandidates(minimum float64) []float64 {
+	distinctValues := make(map[float64]bool)
+	for _, edge := range calculation.graph.GetEdges() {
+		if bandwidth := edge.GetWeight(helper.AvailableBandwidthKey); bandwidth >= minimum {
+			distinctValues[bandwidth] = true
+		}
+	}
+	candidates := make([]float64, 0, len(distinctValues))
+	for bandwidth := range distinctValues {
+		candidates = append(candidates, bandwidth)
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(candidates)))
+	return candidates
+}
+
+func (calculation *LexicographicPathCalculation) optimizeBandwidth(maxConstraints, minConstraints map[helper.WeightKey]float64) (graph.Path, error) {
+	candidates := calculation.getBandwidthCandidates(minConstraints[helper.AvailableBandwidthKey])
+	paths := make(map[int]graph.Path)
+	index := sort.Search(len(candidates), func(index int) bool {
+		levelMinConstraints := calculation.copyConstraints(minConstraints)
+		levelMinConstraints[helper.AvailableBandwidthKey] = candidates[index]
+		path, err := NewConstrainedShortestPathCalculation(calculation.getLevelOptions(calculation.getTieBreakingKey(), maxConstraints, levelMinConstraints)).Execute()
+		if err != nil {
+			return false
+		}
+		paths[index] = path
+		return true
+	})
+	if index == len(candidates) {
+		return nil, fmt.Errorf("No path found from node %s to node %s satisfying all constraints", calculation.source.GetId(), calculation.destination.GetId())
+	}
+	return paths[index], nil
+}
+
+func (calculation *LexicographicPathCalculation) optimizeObjective(objective helper.WeightKey, maxConstraints, minConstraints map[helper.WeightKey]float64) (graph.Path, error) {
+	if objective == helper.AvailableBandwidthKey {
+		return calculation.optimizeBandwidth(maxConstraints, minConstraints)
+	}
+	return NewConstrainedShortestPathCalculation(calculation.getLevelOptions(objective, maxConstraints, minConstraints)).Execute()
+}
+
+func (calculation *LexicographicPathCalculation) getMaxConstraintKey(objective helper.WeightKey) helper.WeightKey {
+	switch objective {
+	case helper.LatencyKey:
+		return helper.NormalizedLatencyKey
+	case helper.JitterKey:
+		return helper.NormalizedJitterKey
+	default:
+		return helper.NormalizedPacketLossKey
+	}
+}
+
+func (calculation *LexicographicPathCalculation) restrictObjective(objective helper.WeightKey, value, tolerance float64, maxConstraints, minConstraints map[helper.WeightKey]float64) {
+	if objective == helper.AvailableBandwidthKey {
+		minConstraints[helper.AvailableBandwidthKey] = math.Max(minConstraints[helper.AvailableBandwidthKey], value*(1-tolerance))
+		return
+	}
+	constraintKey := calculation.getMaxConstraintKey(objective)
+	bound := value*(1+tolerance) + lexicographicEpsilon
+	if maxValue, ok := maxConstraints[constraintKey]; !ok || bound < maxValue {
+		maxConstraints[constraintKey] = bound
+	}
+}
+
+func (calculation *LexicographicPathCalculation) Execute() (graph.Path, error) {
+	maxConstraints := calculation.copyConstraints(calculation.maxConstraints)
+	minConstraints := calculation.copyConstraints(calculation.minConstraints)
+	var path graph.Path
+	for level, objective := range calculation.objectives {
+		if objective == helper.UndefinedKey {
+			return nil, fmt.Errorf("Lexicographic ordering is not supported for weight key %s", calculation.weightKeys[level])
+		}
+		var err error
+		path, err = calculation.optimizeObjective(objective, maxConstraints, minConstraints)
+		if err != nil {
+			return nil, err
+		}
+		value := math.Abs(getPathObjectiveValue(path, objective))
+		calculation.log.Debugf("Lexicographic level %d optimized %s to %g with tolerance %g", level+1, objective, value, calculation.getTolerance(level))
+		calculation.restrictObjective(objective, value, calculation.getTolerance(level), maxConstraints, minConstraints)
+	}
+	if path == nil {
+		return nil, fmt.Errorf("No lexicographic objectives found for weight keys %v", calculation.weightKeys)
+	}
+	calculation.log.Debugln("Calculation finished - lexicographically best path found")
+	return calculation.createPathFromEdges(path.GetEdges()), nil
+}
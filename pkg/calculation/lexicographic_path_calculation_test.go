@@ -0,0 +1,143 @@
+package calculation
+
+import (
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLexicographicPathCalculation(t *testing.T) {
+	calculation := NewLexicographicPathCalculation(&CalculationOptions{weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.AvailableBandwidthKey}}, []float64{0.1, 0})
+	assert.NotNil(t, calculation)
+	assert.Equal(t, []helper.WeightKey{helper.LatencyKey, helper.AvailableBandwidthKey}, calculation.objectives)
+	assert.Equal(t, []float64{0.1, 0}, calculation.tolerances)
+}
+
+func TestLexicographicPathCalculation_Execute(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	tests := []struct {
+		name            string
+		from            int
+		to              int
+		weightKeys      []helper.WeightKey
+		calculationMode CalculationMode
+		tolerances      []float64
+		maxConstraints  map[helper.WeightKey]float64
+		wantEdgeIds     []string
+		wantErr         bool
+	}{
+		{
+			name:            "Test lexicographic latency then packet loss without tolerance",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0, 0},
+			wantEdgeIds:     []string{"1", "2"},
+		},
+		{
+			name:            "Test lexicographic latency then packet loss with latency tolerance",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0.5, 0},
+			wantEdgeIds:     []string{"5"},
+		},
+		{
+			name:            "Test lexicographic packet loss then latency without tolerance",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.NormalizedPacketLossKey, helper.NormalizedLatencyKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0, 0},
+			wantEdgeIds:     []string{"3", "4"},
+		},
+		{
+			name:            "Test lexicographic bandwidth then latency without tolerance",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.AvailableBandwidthKey, helper.NormalizedLatencyKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0, 0},
+			wantEdgeIds:     []string{"3", "4"},
+		},
+		{
+			name:            "Test lexicographic bandwidth then latency with bandwidth tolerance",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.AvailableBandwidthKey, helper.NormalizedLatencyKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0.5, 0},
+			wantEdgeIds:     []string{"5"},
+		},
+		{
+			name:            "Test lexicographic latency then bandwidth with latency tolerance",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.NormalizedLatencyKey, helper.AvailableBandwidthKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0.5, 0},
+			wantEdgeIds:     []string{"5"},
+		},
+		{
+			name:            "Test lexicographic tolerance respects max constraint",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{1, 0},
+			maxConstraints:  map[helper.WeightKey]float64{helper.NormalizedLatencyKey: 2500},
+			wantEdgeIds:     []string{"1", "2"},
+		},
+		{
+			name:            "Test lexicographic single high bandwidth intent",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.AvailableBandwidthKey},
+			calculationMode: CalculationModeMax,
+			tolerances:      []float64{0},
+			wantEdgeIds:     []string{"3", "4"},
+		},
+		{
+			name:            "Test lexicographic no path",
+			from:            4,
+			to:              1,
+			weightKeys:      []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0, 0},
+			wantErr:         true,
+		},
+		{
+			name:            "Test lexicographic unsupported weight key",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.IgpMetricKey},
+			calculationMode: CalculationModeSum,
+			tolerances:      []float64{0},
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			maxConstraints := tt.maxConstraints
+			if maxConstraints == nil {
+				maxConstraints = map[helper.WeightKey]float64{}
+			}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[tt.from], nodes[tt.to], tt.weightKeys, tt.calculationMode, maxConstraints, map[helper.WeightKey]float64{}, nil, nil}
+			calculation := NewLexicographicPathCalculation(calculationOptions, tt.tolerances)
+			path, err := calculation.Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, path)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(path))
+			assert.Empty(t, calculation.maxConstraints[helper.NormalizedPacketLossKey])
+		})
+	}
+}
@@ -7,4 +7,9 @@ const subsystem = "calculation"
 type Manager interface {
 	CalculateBestPath(domain.PathRequest) (domain.PathResult, error)
 	CalculatePathUpdate(domain.StreamSession) (domain.PathResult, error)
+	AddServiceSessions(domain.PathResult)
+	RemoveServiceSessions(domain.PathResult)
+	ReserveBandwidth(domain.PathResult)
+	ReleaseBandwidth(domain.PathResult)
+	OptimizeSessions([]domain.StreamSession) *OptimizationReport
 }
@@ -39,6 +39,18 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// AddServiceSessions mocks base method.
+func (m *MockManager) AddServiceSessions(arg0 domain.PathResult) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddServiceSessions", arg0)
+}
+
+// AddServiceSessions indicates an expected call of AddServiceSessions.
+func (mr *MockManagerMockRecorder) AddServiceSessions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceSessions", reflect.TypeOf((*MockManager)(nil).AddServiceSessions), arg0)
+}
+
 // CalculateBestPath mocks base method.
 func (m *MockManager) CalculateBestPath(arg0 domain.PathRequest) (domain.PathResult, error) {
 	m.ctrl.T.Helper()
@@ -68,3 +80,53 @@ func (mr *MockManagerMockRecorder) CalculatePathUpdate(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculatePathUpdate", reflect.TypeOf((*MockManager)(nil).CalculatePathUpdate), arg0)
 }
+
+// OptimizeSessions mocks base method.
+func (m *MockManager) OptimizeSessions(arg0 []domain.StreamSession) *OptimizationReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OptimizeSessions", arg0)
+	ret0, _ := ret[0].(*OptimizationReport)
+	return ret0
+}
+
+// OptimizeSessions indicates an expected call of OptimizeSessions.
+func (mr *MockManagerMockRecorder) OptimizeSessions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OptimizeSessions", reflect.TypeOf((*MockManager)(nil).OptimizeSessions), arg0)
+}
+
+// ReleaseBandwidth mocks base method.
+func (m *MockManager) ReleaseBandwidth(arg0 domain.PathResult) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseBandwidth", arg0)
+}
+
+// ReleaseBandwidth indicates an expected call of ReleaseBandwidth.
+func (mr *MockManagerMockRecorder) ReleaseBandwidth(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBandwidth", reflect.TypeOf((*MockManager)(nil).ReleaseBandwidth), arg0)
+}
+
+// RemoveServiceSessions mocks base method.
+func (m *MockManager) RemoveServiceSessions(arg0 domain.PathResult) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveServiceSessions", arg0)
+}
+
+// RemoveServiceSessions indicates an expected call of RemoveServiceSessions.
+func (mr *MockManagerMockRecorder) RemoveServiceSessions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceSessions", reflect.TypeOf((*MockManager)(nil).RemoveServiceSessions), arg0)
+}
+
+// ReserveBandwidth mocks base method.
+func (m *MockManager) ReserveBandwidth(arg0 domain.PathResult) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReserveBandwidth", arg0)
+}
+
+// ReserveBandwidth indicates an expected call of ReserveBandwidth.
+func (mr *MockManagerMockRecorder) ReserveBandwidth(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveBandwidth", reflect.TypeOf((*MockManager)(nil).ReserveBandwidth), arg0)
+}
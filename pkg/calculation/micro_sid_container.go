@@ -0,0 +1,84 @@
+package calculation
+
+import "net/netip"
+
+const ipv6AddressLength = 128
+
+// compressed SID (NEXT-C-SID): a shared locator block followed by micro SIDs of the same length, padded with zeros
+type microSidContainer struct {
+	address        [16]byte
+	blockLength    uint32
+	microSidLength uint32
+	offset         uint32
+}
+
+func getAddressBit(address [16]byte, bit uint32) byte {
+	return address[bit/8] >> (7 - bit%8) & 1
+}
+
+func getAddressBits(address [16]byte, offset, length uint32) uint64 {
+	value := uint64(0)
+	for bit := offset; bit < offset+length; bit++ {
+		value = value<<1 | uint64(getAddressBit(address, bit))
+	}
+	return value
+}
+
+func setAddressBits(address *[16]byte, offset, length uint32, value uint64) {
+	for index := uint32(0); index < length; index++ {
+		if value>>(length-1-index)&1 == 1 {
+			bit := offset + index
+			address[bit/8] |= 1 << (7 - bit%8)
+		}
+	}
+}
+
+func haveEqualPrefix(first, second [16]byte, length uint32) bool {
+	for bit := uint32(0); bit < length; bit++ {
+		if getAddressBit(first, bit) != getAddressBit(second, bit) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasZeroBitsFrom(address [16]byte, offset uint32) bool {
+	for bit := offset; bit < ipv6AddressLength; bit++ {
+		if getAddressBit(address, bit) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func newMicroSidContainer(address [16]byte, blockLength, microSidLength uint32) *microSidContainer {
+	container := &microSidContainer{
+		blockLength:    blockLength,
+		microSidLength: microSidLength,
+		offset:         blockLength,
+	}
+	for bit := uint32(0); bit < blockLength; bit++ {
+		setAddressBits(&container.address, bit, 1, uint64(getAddressBit(address, bit)))
+	}
+	return container
+}
+
+func (container *microSidContainer) sharesBlock(address [16]byte, blockLength, microSidLength uint32) bool {
+	if container.blockLength != blockLength || container.microSidLength != microSidLength {
+		return false
+	}
+	return haveEqualPrefix(container.address, address, blockLength)
+}
+
+func (container *microSidContainer) isFull() bool {
+	return container.offset+container.microSidLength > ipv6AddressLength
+}
+
+func (container *microSidContainer) addMicroSid(microSid uint64) {
+	setAddressBits(&container.address, container.offset, container.microSidLength, microSid)
+	container.offset += container.microSidLength
+}
+
+func (container *microSidContainer) String() string {
+	return netip.AddrFrom16(container.address).String()
+}
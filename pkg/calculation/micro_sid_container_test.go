@@ -0,0 +1,69 @@
+package calculation
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMicroSidContainer(t *testing.T) {
+	tests := []struct {
+		name        string
+		blockSid    string
+		microSids   []uint64
+		otherSid    string
+		wantShares  bool
+		wantFull    bool
+		wantAddress string
+	}{
+		{
+			name:        "Test microSidContainer single micro SID",
+			blockSid:    "fcbb:bb00:1::",
+			microSids:   []uint64{1},
+			otherSid:    "fcbb:bb00:2::",
+			wantShares:  true,
+			wantAddress: "fcbb:bb00:1::",
+		},
+		{
+			name:        "Test microSidContainer multiple micro SIDs",
+			blockSid:    "fcbb:bb00:1::",
+			microSids:   []uint64{1, 2, 0xe001},
+			otherSid:    "fcbb:bb01:2::",
+			wantShares:  false,
+			wantAddress: "fcbb:bb00:1:2:e001::",
+		},
+		{
+			name:        "Test microSidContainer full",
+			blockSid:    "fcbb:bb00:1::",
+			microSids:   []uint64{1, 2, 3, 4, 5, 6},
+			otherSid:    "fcbb:bb00:7::",
+			wantShares:  true,
+			wantFull:    true,
+			wantAddress: "fcbb:bb00:1:2:3:4:5:6",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := newMicroSidContainer(netip.MustParseAddr(tt.blockSid).As16(), 32, 16)
+			for _, microSid := range tt.microSids {
+				container.addMicroSid(microSid)
+			}
+			assert.Equal(t, tt.wantShares, container.sharesBlock(netip.MustParseAddr(tt.otherSid).As16(), 32, 16))
+			assert.Equal(t, tt.wantFull, container.isFull())
+			assert.Equal(t, tt.wantAddress, container.String())
+		})
+	}
+}
+
+func TestMicroSidContainer_sharesBlock_differentStructure(t *testing.T) {
+	container := newMicroSidContainer(netip.MustParseAddr("fcbb:bb00:1::").As16(), 32, 16)
+	assert.False(t, container.sharesBlock(netip.MustParseAddr("fcbb:bb00:1::").As16(), 48, 16))
+	assert.False(t, container.sharesBlock(netip.MustParseAddr("fcbb:bb00:1::").As16(), 32, 32))
+}
+
+func TestHasZeroBitsFrom(t *testing.T) {
+	address := netip.MustParseAddr("fcbb:bb00:1::").As16()
+	assert.True(t, hasZeroBitsFrom(address, 48))
+	assert.False(t, hasZeroBitsFrom(address, 32))
+}
@@ -0,0 +1,270 @@
+package calculation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+)
+
+type paretoLabel struct {
+	nodeId     string
+	latency    float64
+	jitter     float64
+	packetLoss float64
+	bandwidth  float64
+	hopCount   int
+	edge       graph.Edge
+	previous   *paretoLabel
+	dominated  bool
+}
+
+type ParetoPathCalculation struct {
+	BaseCalculation
+	selectionPolicy   domain.SelectionPolicy
+	objectives        []helper.WeightKey
+	nodeLabels        map[string][]*paretoLabel
+	destinationLabels []*paretoLabel
+}
+
+func NewParetoPathCalculation(options *CalculationOptions, selectionPolicy domain.SelectionPolicy) *ParetoPathCalculation {
+	calculation := &ParetoPathCalculation{
+		BaseCalculation: *NewBaseCalculation(options),
+		selectionPolicy: selectionPolicy,
+		nodeLabels:      make(map[string][]*paretoLabel),
+	}
+	calculation.objectives = calculation.getObjectives()
+	return calculation
+}
+
+func (calculation *ParetoPathCalculation) getObjectives() []helper.WeightKey {
+	objectives := make([]helper.WeightKey, 0, len(calculation.weightKeys))
+	for _, weightKey := range calculation.weightKeys {
+		if objective := getObjectiveKey(weightKey); objective != helper.UndefinedKey {
+			objectives = append(objectives, objective)
+		}
+	}
+	return objectives
+}
+
+func (calculation *ParetoPathCalculation) getObjectiveValue(label *paretoLabel, objective helper.WeightKey) float64 {
+	switch objective {
+	case helper.LatencyKey:
+		return label.latency
+	case helper.JitterKey:
+		return label.jitter
+	case helper.PacketLossKey:
+		return label.packetLoss
+	default:
+		// bandwidth is maximized, the negation keeps "smaller is better" for all objectives
+		return -label.bandwidth
+	}
+}
+
+func (calculation *ParetoPathCalculation) dominates(label, otherLabel *paretoLabel) bool {
+	for _, objective := range calculation.objectives {
+		if calculation.getObjectiveValue(label, objective) > calculation.getObjectiveValue(otherLabel, objective) {
+			return false
+		}
+	}
+	if _, ok := calculation.maxConstraints[helper.NormalizedLatencyKey]; ok && label.latency > otherLabel.latency {
+		return false
+	}
+	if _, ok := calculation.maxConstraints[helper.NormalizedJitterKey]; ok && label.jitter > otherLabel.jitter {
+		return false
+	}
+	if _, ok := calculation.maxConstraints[helper.NormalizedPacketLossKey]; ok && label.packetLoss > otherLabel.packetLoss {
+		return false
+	}
+	if calculation.isHopCountConstrained() && label.hopCount > otherLabel.hopCount {
+		return false
+	}
+	return true
+}
+
+func (calculation *ParetoPathCalculation) isDominated(label *paretoLabel) bool {
+	for _, existingLabel := range calculation.nodeLabels[label.nodeId] {
+		if !existingLabel.dominated && calculation.dominates(existingLabel, label) {
+			return true
+		}
+	}
+	for _, destinationLabel := range calculation.destinationLabels {
+		if !destinationLabel.dominated && calculation.dominates(destinationLabel, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func (calculation *ParetoPathCalculation) removeDominatedLabels(label *paretoLabel) {
+	remainingLabels := make([]*paretoLabel, 0, len(calculation.nodeLabels[label.nodeId]))
+	for _, existingLabel := range calculation.nodeLabels[label.nodeId] {
+		if existingLabel.dominated {
+			continue
+		}
+		if calculation.dominates(label, existingLabel) {
+			existingLabel.dominated = true
+			continue
+		}
+		remainingLabels = append(remainingLabels, existingLabel)
+	}
+	calculation.nodeLabels[label.nodeId] = remainingLabels
+}
+
+func (calculation *ParetoPathCalculation) extendLabel(label *paretoLabel, edge graph.Edge) *paretoLabel {
+	return &paretoLabel{
+		nodeId:     edge.To().GetId(),
+		latency:    label.latency + edge.GetWeight(helper.LatencyKey),
+		jitter:     label.jitter + edge.GetWeight(helper.JitterKey),
+		packetLoss: 1 - ((1 - label.packetLoss) * (1 - edge.GetWeight(helper.PacketLossKey)/100)),
+		bandwidth:  math.Min(label.bandwidth, edge.GetWeight(helper.AvailableBandwidthKey)),
+		hopCount:   label.hopCount + 1,
+		edge:       edge,
+		previous:   label,
+	}
+}
+
+func (calculation *ParetoPathCalculation) relaxEdge(label *paretoLabel, edge graph.Edge) *paretoLabel {
+	if calculation.violatesBandwidthMinConstraint(edge) {
+		return nil
+	}
+	newLabel := calculation.extendLabel(label, edge)
+	if calculation.violatesMaxConstraints(edge, newLabel.latency, newLabel.jitter, newLabel.packetLoss) || calculation.violatesHopCountConstraint(edge, newLabel.hopCount) {
+		return nil
+	}
+	if calculation.isDominated(newLabel) {
+		return nil
+	}
+	calculation.removeDominatedLabels(newLabel)
+	calculation.nodeLabels[newLabel.nodeId] = append(calculation.nodeLabels[newLabel.nodeId], newLabel)
+	if newLabel.nodeId == calculation.destination.GetId() {
+		calculation.destinationLabels = append(calculation.destinationLabels, newLabel)
+		return nil
+	}
+	return newLabel
+}
+
+func (calculation *ParetoPathCalculation) performLabelCorrecting() {
+	queue := []*paretoLabel{{nodeId: calculation.source.GetId(), bandwidth: math.Inf(1)}}
+	calculation.nodeLabels[calculation.source.GetId()] = queue
+	for len(queue) > 0 {
+		label := queue[0]
+		queue = queue[1:]
+		if label.dominated {
+			continue
+		}
+		for _, edge := range getSortedEdges(calculation.graph.GetNode(label.nodeId)) {
+			if newLabel := calculation.relaxEdge(label, edge); newLabel != nil {
+				queue = append(queue, newLabel)
+			}
+		}
+	}
+}
+
+func (calculation *ParetoPathCalculation) getEdges(label *paretoLabel) []graph.Edge {
+	edges := make([]graph.Edge, 0)
+	for current := label; current.previous != nil; current = current.previous {
+		edges = append([]graph.Edge{current.edge}, edges...)
+	}
+	return edges
+}
+
+func (calculation *ParetoPathCalculation) getParetoFront() []*paretoLabel {
+	paretoFront := make([]*paretoLabel, 0, len(calculation.destinationLabels))
+	for _, label := range calculation.destinationLabels {
+		if !label.dominated {
+			paretoFront = append(paretoFront, label)
+		}
+	}
+	sort.SliceStable(paretoFront, func(i, j int) bool {
+		for _, objective := range calculation.objectives {
+			value, otherValue := calculation.getObjectiveValue(paretoFront[i], objective), calculation.getObjectiveValue(paretoFront[j], objective)
+			if value != otherValue {
+				return value < otherValue
+			}
+		}
+		return len(calculation.getEdges(paretoFront[i])) < len(calculation.getEdges(paretoFront[j]))
+	})
+	return paretoFront
+}
+
+func (calculation *ParetoPathCalculation) getNormalizedObjectives(paretoFront []*paretoLabel) [][]float64 {
+	normalizedObjectives := make([][]float64, len(paretoFront))
+	for index := range paretoFront {
+		normalizedObjectives[index] = make([]float64, len(calculation.objectives))
+	}
+	for objectiveIndex, objective := range calculation.objectives {
+		best, worst := math.Inf(1), math.Inf(-1)
+		for _, label := range paretoFront {
+			value := calculation.getObjectiveValue(label, objective)
+			best = math.Min(best, value)
+			worst = math.Max(worst, value)
+		}
+		for labelIndex, label := range paretoFront {
+			if worst > best {
+				normalizedObjectives[labelIndex][objectiveIndex] = (calculation.getObjectiveValue(label, objective) - best) / (worst - best)
+			}
+		}
+	}
+	return normalizedObjectives
+}
+
+func (calculation *ParetoPathCalculation) getSelectionWeights() []float64 {
+	if len(calculation.weights) == len(calculation.objectives) && len(calculation.objectives) == len(calculation.weightKeys) {
+		return calculation.weights
+	}
+	return getDefaultWeights(len(calculation.objectives))
+}
+
+func (calculation *ParetoPathCalculation) getSelectionScore(normalizedObjectives []float64) float64 {
+	score := 0.0
+	if calculation.selectionPolicy == domain.SelectionPolicyKnee {
+		for _, value := range normalizedObjectives {
+			score += value * value
+		}
+		return math.Sqrt(score)
+	}
+	weights := calculation.getSelectionWeights()
+	for index, value := range normalizedObjectives {
+		score += weights[index] * value
+	}
+	return score
+}
+
+func (calculation *ParetoPathCalculation) selectPath(paretoFront []*paretoLabel) int {
+	if calculation.selectionPolicy == domain.SelectionPolicyLexicographic {
+		return 0
+	}
+	normalizedObjectives := calculation.getNormalizedObjectives(paretoFront)
+	selectedIndex := 0
+	bestScore := math.Inf(1)
+	for index := range paretoFront {
+		if score := calculation.getSelectionScore(normalizedObjectives[index]); score < bestScore {
+			bestScore = score
+			selectedIndex = index
+		}
+	}
+	return selectedIndex
+}
+
+func (calculation *ParetoPathCalculation) Execute() (graph.Path, error) {
+	if len(calculation.objectives) == 0 {
+		return nil, fmt.Errorf("No Pareto front objectives found for weight keys %v", calculation.weightKeys)
+	}
+	calculation.performLabelCorrecting()
+	paretoFront := calculation.getParetoFront()
+	if len(paretoFront) == 0 {
+		return nil, fmt.Errorf("No path found from node %s to node %s", calculation.source.GetId(), calculation.destination.GetId())
+	}
+	paretoPaths := make([]graph.Path, 0, len(paretoFront))
+	for _, label := range paretoFront {
+		paretoPaths = append(paretoPaths, calculation.createPathFromEdges(calculation.getEdges(label)))
+	}
+	selectedPath := paretoPaths[calculation.selectPath(paretoFront)]
+	selectedPath.SetParetoPaths(paretoPaths)
+	calculation.log.Debugf("Calculation finished - %d Pareto optimal paths found, %s selection applied", len(paretoPaths), calculation.selectionPolicy)
+	return selectedPath, nil
+}
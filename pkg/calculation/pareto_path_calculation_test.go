@@ -0,0 +1,258 @@
+package calculation
+
+import (
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupParetoPathTestElements() (map[int]graph.Node, map[int]graph.Edge) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+		4: graph.NewNetworkNode("4", "4", srAlgorithm),
+	}
+	//  [1]---1ms/1%---[2]---1ms/1%---[4]
+	//   | \             |               |
+	//   |  +--------3ms/0.5%-----------+
+	//   |               | 5ms/1%        |
+	//   +--3ms/0.1%--->[3]---3ms/0.1%---+
+	edges := map[int]graph.Edge{
+		1: graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.PacketLossKey: 1, helper.AvailableBandwidthKey: 100}),
+		2: graph.NewNetworkEdge("2", nodes[2], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 1000, helper.PacketLossKey: 1, helper.AvailableBandwidthKey: 100}),
+		3: graph.NewNetworkEdge("3", nodes[1], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 3000, helper.PacketLossKey: 0.1, helper.AvailableBandwidthKey: 1000}),
+		4: graph.NewNetworkEdge("4", nodes[3], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 3000, helper.PacketLossKey: 0.1, helper.AvailableBandwidthKey: 1000}),
+		5: graph.NewNetworkEdge("5", nodes[1], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 3000, helper.PacketLossKey: 0.5, helper.AvailableBandwidthKey: 500}),
+		6: graph.NewNetworkEdge("6", nodes[2], nodes[3], map[helper.WeightKey]float64{helper.LatencyKey: 5000, helper.PacketLossKey: 1, helper.AvailableBandwidthKey: 1000}),
+	}
+	return nodes, edges
+}
+
+func TestNewParetoPathCalculation(t *testing.T) {
+	calculation := NewParetoPathCalculation(&CalculationOptions{weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey, helper.AvailableBandwidthKey}}, domain.SelectionPolicyKnee)
+	assert.NotNil(t, calculation)
+	assert.Equal(t, domain.SelectionPolicyKnee, calculation.selectionPolicy)
+	assert.Equal(t, []helper.WeightKey{helper.LatencyKey, helper.PacketLossKey, helper.AvailableBandwidthKey}, calculation.objectives)
+}
+
+func TestParetoPathCalculation_Execute(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	latencyAndPacketLoss := []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey}
+	tests := []struct {
+		name            string
+		from            int
+		to              int
+		weightKeys      []helper.WeightKey
+		calculationMode CalculationMode
+		selectionPolicy domain.SelectionPolicy
+		maxConstraints  map[helper.WeightKey]float64
+		minConstraints  map[helper.WeightKey]float64
+		wantSelected    []string
+		wantFront       [][]string
+		wantErr         bool
+	}{
+		{
+			name:            "Test pareto front latency and packet loss lexicographic",
+			from:            1,
+			to:              4,
+			weightKeys:      latencyAndPacketLoss,
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyLexicographic,
+			wantSelected:    []string{"1", "2"},
+			wantFront:       [][]string{{"1", "2"}, {"5"}, {"3", "4"}},
+		},
+		{
+			name:            "Test pareto front packet loss and latency lexicographic",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.NormalizedPacketLossKey, helper.NormalizedLatencyKey},
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyLexicographic,
+			wantSelected:    []string{"3", "4"},
+			wantFront:       [][]string{{"3", "4"}, {"5"}, {"1", "2"}},
+		},
+		{
+			name:            "Test pareto front latency and packet loss weighted",
+			from:            1,
+			to:              4,
+			weightKeys:      latencyAndPacketLoss,
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyWeighted,
+			wantSelected:    []string{"5"},
+			wantFront:       [][]string{{"1", "2"}, {"5"}, {"3", "4"}},
+		},
+		{
+			name:            "Test pareto front latency and packet loss knee",
+			from:            1,
+			to:              4,
+			weightKeys:      latencyAndPacketLoss,
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyKnee,
+			wantSelected:    []string{"5"},
+			wantFront:       [][]string{{"1", "2"}, {"5"}, {"3", "4"}},
+		},
+		{
+			name:            "Test pareto front latency and bandwidth lexicographic",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.NormalizedLatencyKey, helper.AvailableBandwidthKey},
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyLexicographic,
+			wantSelected:    []string{"1", "2"},
+			wantFront:       [][]string{{"1", "2"}, {"5"}, {"3", "4"}},
+		},
+		{
+			name:            "Test pareto front single high bandwidth intent",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.AvailableBandwidthKey},
+			calculationMode: CalculationModeMax,
+			selectionPolicy: domain.SelectionPolicyWeighted,
+			wantSelected:    []string{"3", "4"},
+			wantFront:       [][]string{{"3", "4"}},
+		},
+		{
+			name:            "Test pareto front with max latency constraint",
+			from:            1,
+			to:              4,
+			weightKeys:      latencyAndPacketLoss,
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyKnee,
+			maxConstraints:  map[helper.WeightKey]float64{helper.NormalizedLatencyKey: 2500},
+			wantSelected:    []string{"1", "2"},
+			wantFront:       [][]string{{"1", "2"}},
+		},
+		{
+			name:            "Test pareto front with min bandwidth constraint",
+			from:            1,
+			to:              4,
+			weightKeys:      latencyAndPacketLoss,
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyLexicographic,
+			minConstraints:  map[helper.WeightKey]float64{helper.AvailableBandwidthKey: 200},
+			wantSelected:    []string{"5"},
+			wantFront:       [][]string{{"5"}, {"3", "4"}},
+		},
+		{
+			name:            "Test pareto front no path",
+			from:            4,
+			to:              1,
+			weightKeys:      latencyAndPacketLoss,
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyWeighted,
+			wantErr:         true,
+		},
+		{
+			name:            "Test pareto front without objectives",
+			from:            1,
+			to:              4,
+			weightKeys:      []helper.WeightKey{helper.IgpMetricKey},
+			calculationMode: CalculationModeSum,
+			selectionPolicy: domain.SelectionPolicyWeighted,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			maxConstraints, minConstraints := tt.maxConstraints, tt.minConstraints
+			if maxConstraints == nil {
+				maxConstraints = map[helper.WeightKey]float64{}
+			}
+			if minConstraints == nil {
+				minConstraints = map[helper.WeightKey]float64{}
+			}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[tt.from], nodes[tt.to], tt.weightKeys, tt.calculationMode, maxConstraints, minConstraints, nil, nil}
+			calculation := NewParetoPathCalculation(calculationOptions, tt.selectionPolicy)
+			path, err := calculation.Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, path)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSelected, getEdgeIds(path))
+			front := make([][]string, 0)
+			for _, paretoPath := range path.GetParetoPaths() {
+				front = append(front, getEdgeIds(paretoPath))
+			}
+			assert.Equal(t, tt.wantFront, front)
+		})
+	}
+}
+
+func TestParetoPathCalculation_dominates(t *testing.T) {
+	tests := []struct {
+		name           string
+		weightKeys     []helper.WeightKey
+		maxConstraints map[helper.WeightKey]float64
+		label          *paretoLabel
+		otherLabel     *paretoLabel
+		want           bool
+	}{
+		{
+			name:       "Test dominates better in all objectives",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.AvailableBandwidthKey},
+			label:      &paretoLabel{latency: 1000, bandwidth: 200},
+			otherLabel: &paretoLabel{latency: 2000, bandwidth: 100},
+			want:       true,
+		},
+		{
+			name:       "Test dominates trade-off between objectives",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.AvailableBandwidthKey},
+			label:      &paretoLabel{latency: 1000, bandwidth: 100},
+			otherLabel: &paretoLabel{latency: 2000, bandwidth: 200},
+			want:       false,
+		},
+		{
+			name:           "Test dominates worse in constrained metric",
+			weightKeys:     []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedPacketLossKey},
+			maxConstraints: map[helper.WeightKey]float64{helper.NormalizedJitterKey: 100},
+			label:          &paretoLabel{latency: 1000, packetLoss: 0.01, jitter: 50},
+			otherLabel:     &paretoLabel{latency: 2000, packetLoss: 0.02, jitter: 10},
+			want:           false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculation := NewParetoPathCalculation(&CalculationOptions{weightKeys: tt.weightKeys, maxConstraints: tt.maxConstraints}, domain.SelectionPolicyWeighted)
+			assert.Equal(t, tt.want, calculation.dominates(tt.label, tt.otherLabel))
+		})
+	}
+}
+
+func TestParetoPathCalculation_getSelectionWeights(t *testing.T) {
+	tests := []struct {
+		name       string
+		weightKeys []helper.WeightKey
+		want       []float64
+	}{
+		{
+			name:       "Test getSelectionWeights two objectives",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey},
+			want:       helper.TwoFactorWeights,
+		},
+		{
+			name:       "Test getSelectionWeights three objectives",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey, helper.NormalizedPacketLossKey},
+			want:       helper.ThreeFactorWeights,
+		},
+		{
+			name:       "Test getSelectionWeights four objectives",
+			weightKeys: []helper.WeightKey{helper.NormalizedLatencyKey, helper.NormalizedJitterKey, helper.NormalizedPacketLossKey, helper.AvailableBandwidthKey},
+			want:       []float64{0.25, 0.25, 0.25, 0.25},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculation := NewParetoPathCalculation(&CalculationOptions{weightKeys: tt.weightKeys}, domain.SelectionPolicyWeighted)
+			assert.Equal(t, tt.want, calculation.getSelectionWeights())
+		})
+	}
+}
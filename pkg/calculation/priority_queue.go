@@ -7,9 +7,11 @@ import (
 // Modified from https://pkg.go.dev/container/heap#example-package-PriorityQueue
 
 type Item struct {
-	nodeId string
-	cost   float64
-	index  int
+	nodeId        string
+	layer         uint64
+	waypointIndex int
+	cost          float64
+	index         int
 }
 
 func (item *Item) GetNodeId() string {
@@ -1,190 +1,318 @@
 package calculation
 
 import (
+	"container/heap"
 	"fmt"
 	"math"
 
+	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
 )
 
+// the layered graph contains one copy of the topology per set of applied services, a router hosting a service
+// connects the layer without the service to the layer with it. An ordered chain only uses the layers of its prefixes.
+// Included nodes and links are tracked like in the constrained search by the number of waypoints already passed.
+type layeredNode struct {
+	nodeId        string
+	layer         uint64
+	waypointIndex int
+}
+
+type layeredNodeLabel struct {
+	cost                  float64
+	latency               float64
+	jitter                float64
+	packetLoss            float64
+	serviceCost           float64
+	hopCount              int
+	previousEdge          graph.Edge
+	previousLayer         uint64
+	previousWaypointIndex int
+}
+
 type ServiceFunctionChainCalculation struct {
 	BaseCalculation
-	serviceFunctionChain [][]string
-	routerServiceMap     map[string]string
+	serviceRouters      []map[string]struct{}
+	routerServiceMap    map[string]string
+	unordered           bool
+	servicePredecessors []uint64
+	serviceLoads        map[string]float64
+	serviceLoadCost     float64
+	serviceMetadata     map[string]domain.ServiceMetadata
+	waypoints           []waypoint
+	labels              map[layeredNode]*layeredNodeLabel
+	visitedNodes        map[layeredNode]bool
+	priorityQueue       PriorityQueue
+	initialNodeCost     float64
 }
 
 func NewServiceFunctionChainCalculation(options *CalculationOptions, sfcCalculationOptions *SfcCalculationOptions) *ServiceFunctionChainCalculation {
-	return &ServiceFunctionChainCalculation{
-		BaseCalculation:      *NewBaseCalculation(options),
-		serviceFunctionChain: sfcCalculationOptions.serviceFunctionChain,
-		routerServiceMap:     sfcCalculationOptions.routerServiceMap,
-	}
-}
-
-func (calculation *ServiceFunctionChainCalculation) calculatePathSourceToFirstService(firstServiceRouterId string) (graph.Path, error) {
-	firstServiceNode := calculation.graph.GetNode(firstServiceRouterId)
-	calculation.log.Debugf("Calculating path from source node %s to first service router %s", calculation.source.GetName(), firstServiceNode.GetName())
-	calculationOptions := &CalculationOptions{calculation.graph, calculation.source, firstServiceNode, calculation.weightKeys, calculation.calculationMode, calculation.maxConstraints, calculation.minConstraints}
-	firstCalculation := NewShortestPathCalculation(calculationOptions)
-	path, err := firstCalculation.Execute()
-	return path, err
-}
-
-func (calculation *ServiceFunctionChainCalculation) calculatePathsBetweenServices(previousPath graph.Path, serviceFunctionChain []string) ([]graph.Path, float64, error) {
-	calculation.log.Debugln("Calculating paths between services")
-	cost := float64(0)
-	paths := make([]graph.Path, 0)
-	for i := 0; i < len(serviceFunctionChain)-1; i++ {
-		sourceNode := calculation.graph.GetNode(serviceFunctionChain[i])
-		destinationNode := calculation.graph.GetNode(serviceFunctionChain[i+1])
-		calculation.log.Debugf("Calculating path from service router %s to service router %s", sourceNode.GetName(), destinationNode.GetName())
-		calculationOptions := &CalculationOptions{calculation.graph, sourceNode, destinationNode, calculation.weightKeys, calculation.calculationMode, calculation.maxConstraints, calculation.minConstraints}
-		serviceCalculation := NewShortestPathCalculation(calculationOptions)
-		serviceCalculation.SetInitialSourceNodeMetrics(previousPath.GetTotalCost(), previousPath.GetTotalDelay(), previousPath.GetTotalJitter(), previousPath.GetTotalPacketLoss())
-		path, err := serviceCalculation.Execute()
-		if err != nil {
-			return nil, 0, err
+	calculation := &ServiceFunctionChainCalculation{
+		BaseCalculation:     *NewBaseCalculation(options),
+		serviceRouters:      make([]map[string]struct{}, len(sfcCalculationOptions.serviceRouters)),
+		routerServiceMap:    sfcCalculationOptions.routerServiceMap,
+		unordered:           sfcCalculationOptions.unordered,
+		servicePredecessors: sfcCalculationOptions.servicePredecessors,
+		serviceLoads:        sfcCalculationOptions.serviceLoads,
+		serviceMetadata:     sfcCalculationOptions.serviceMetadata,
+		labels:              make(map[layeredNode]*layeredNodeLabel),
+		visitedNodes:        make(map[layeredNode]bool),
+	}
+	for index, routerIds := range sfcCalculationOptions.serviceRouters {
+		calculation.serviceRouters[index] = make(map[string]struct{}, len(routerIds))
+		for _, routerId := range routerIds {
+			calculation.serviceRouters[index][routerId] = struct{}{}
 		}
-		cost += path.GetTotalCost()
-		paths = append(paths, path)
-		previousPath = path
 	}
-	return paths, cost, nil
+	calculation.reserveServiceSids()
+	return calculation
 }
 
-func (calculation *ServiceFunctionChainCalculation) calculatePathLastServiceToDestination(previousPath graph.Path, lastServiceRouterId string) (graph.Path, error) {
-	lastServiceNode := calculation.graph.GetNode(lastServiceRouterId)
-	calculation.log.Debugf("Calculating path from last service router %s to destination node %s", lastServiceNode.GetName(), calculation.destination.GetName())
-	calculationOptions := &CalculationOptions{calculation.graph, lastServiceNode, calculation.destination, calculation.weightKeys, calculation.calculationMode, calculation.maxConstraints, calculation.minConstraints}
-	lastCalculation := NewShortestPathCalculation(calculationOptions)
-	lastCalculation.SetInitialSourceNodeMetrics(previousPath.GetTotalCost(), previousPath.GetTotalDelay(), previousPath.GetTotalJitter(), previousPath.GetTotalPacketLoss())
-	path, err := lastCalculation.Execute()
-	return path, err
+// each service adds its service SID to the SID list, which leaves fewer SIDs for the hops of the sub paths
+func (calculation *ServiceFunctionChainCalculation) reserveServiceSids() {
+	maxSidDepth, ok := calculation.maxConstraints[helper.SidDepthKey]
+	if !ok || len(calculation.serviceRouters) == 0 {
+		return
+	}
+	maxConstraints := make(map[helper.WeightKey]float64, len(calculation.maxConstraints))
+	for key, value := range calculation.maxConstraints {
+		maxConstraints[key] = value
+	}
+	maxConstraints[helper.SidDepthKey] = maxSidDepth - float64(len(calculation.serviceRouters))
+	calculation.maxConstraints = maxConstraints
 }
 
-func (calculation *ServiceFunctionChainCalculation) evaluateFistSubPath(serviceFunctionChain []string, bestCost float64, cost *float64) (graph.Path, bool, error) {
-	firstPath, err := calculation.calculatePathSourceToFirstService(serviceFunctionChain[0])
-	if err != nil {
-		return nil, true, err
+// the load is scaled by the average link cost to blend it with the path cost independent of the metric,
+// a fully loaded instance costs blend/(1-blend) average links
+func (calculation *ServiceFunctionChainCalculation) initializeServiceLoadCost() {
+	calculation.serviceLoadCost = 0
+	if calculation.calculationMode != CalculationModeSum || helper.ServiceLoadBlend <= 0 || len(calculation.serviceLoads) == 0 {
+		return
+	}
+	edges := calculation.graph.GetEdges()
+	if len(edges) == 0 {
+		return
 	}
-	*cost = firstPath.GetTotalCost()
-	if *cost > bestCost {
-		return nil, true, nil
+	totalCost := 0.0
+	for _, edge := range edges {
+		totalCost += calculation.getEdgeCost(edge)
 	}
-	return firstPath, false, nil
+	calculation.serviceLoadCost = helper.ServiceLoadBlend / (1 - helper.ServiceLoadBlend) * totalCost / float64(len(edges))
 }
 
-func (calculation *ServiceFunctionChainCalculation) evaluateSecondSubPath(firstPath graph.Path, serviceFunctionChain []string, cost *float64, bestCost float64) ([]graph.Path, bool, error) {
-	intermediatePaths, intermediateCost, err := calculation.calculatePathsBetweenServices(firstPath, serviceFunctionChain)
-	if err != nil {
-		return nil, true, err
+func (calculation *ServiceFunctionChainCalculation) getServiceLoadCost(routerId string) float64 {
+	return calculation.serviceLoadCost * calculation.serviceLoads[calculation.routerServiceMap[routerId]]
+}
+
+// the processing of a service instance only adds to the cost if the chain is optimized for latency, jitter or packet loss
+func (calculation *ServiceFunctionChainCalculation) getServiceMetricCost(metadata domain.ServiceMetadata) float64 {
+	if metadata == nil || calculation.calculationMode != CalculationModeSum || len(calculation.weightKeys) != 1 {
+		return 0
 	}
-	*cost = intermediateCost
-	if *cost > bestCost {
-		return nil, true, nil
+	switch calculation.weightKeys[0] {
+	case helper.LatencyKey:
+		return metadata.GetDelay()
+	case helper.JitterKey:
+		return metadata.GetJitter()
+	case helper.PacketLossKey:
+		return -math.Log(1 - metadata.GetPacketLoss()/100)
 	}
-	return intermediatePaths, false, nil
+	return 0
 }
 
-func (calculation *ServiceFunctionChainCalculation) evaluateLastSubPath(lastIntermediatePath graph.Path, serviceFunctionChain []string, bestCost float64, cost *float64) (graph.Path, bool, error) {
-	lastPath, err := calculation.calculatePathLastServiceToDestination(lastIntermediatePath, serviceFunctionChain[len(serviceFunctionChain)-1])
-	if err != nil {
-		return nil, true, err
+func (calculation *ServiceFunctionChainCalculation) addServiceMetrics(label *layeredNodeLabel, metadata domain.ServiceMetadata) (float64, float64, float64) {
+	if metadata == nil {
+		return label.latency, label.jitter, label.packetLoss
 	}
-	*cost = lastPath.GetTotalCost()
-	if *cost > bestCost {
-		return nil, true, nil
-	}
-	return lastPath, false, nil
+	latency := label.latency + metadata.GetDelay()
+	jitter := label.jitter + metadata.GetJitter()
+	packetLoss := 1 - ((1 - label.packetLoss) * (1 - metadata.GetPacketLoss()/100))
+	return latency, jitter, packetLoss
 }
 
-func (calculation *ServiceFunctionChainCalculation) processServiceFunctionChain(serviceFunctionChain []string, bestCost float64) (float64, []graph.Path, error) {
-	paths := make([]graph.Path, 0)
-	cost := float64(0)
-	firstPath, shouldReturn, err := calculation.evaluateFistSubPath(serviceFunctionChain, bestCost, &cost)
-	if shouldReturn {
-		return cost, nil, err
+func (calculation *ServiceFunctionChainCalculation) getNodeCost(node layeredNode) float64 {
+	if label, ok := calculation.labels[node]; ok {
+		return label.cost
 	}
-	paths = append(paths, firstPath)
+	return calculation.initialNodeCost
+}
+
+func (calculation *ServiceFunctionChainCalculation) pushNode(node layeredNode, label *layeredNodeLabel) {
+	calculation.labels[node] = label
+	heap.Push(&calculation.priorityQueue, &Item{nodeId: node.nodeId, layer: node.layer, waypointIndex: node.waypointIndex, cost: label.cost})
+}
 
-	var intermediatePaths []graph.Path
-	intermediatePaths, shouldReturn, err = calculation.evaluateSecondSubPath(firstPath, serviceFunctionChain, &cost, bestCost)
-	if shouldReturn {
-		return cost, nil, err
+func (calculation *ServiceFunctionChainCalculation) initializeDijkstra() {
+	sourceNodeCost := 0.0
+	calculation.initialNodeCost = math.Inf(1)
+	calculation.priorityQueue = *NewMinimumPriorityQueue()
+	switch calculation.calculationMode {
+	case CalculationModeMax:
+		calculation.initialNodeCost = 0
+		sourceNodeCost = math.Inf(1)
+		calculation.priorityQueue = *NewMaximumPriorityQueue()
+	case CalculationModeMin:
+		sourceNodeCost = math.Inf(1)
 	}
-	paths = append(paths, intermediatePaths...)
+	heap.Init(&calculation.priorityQueue)
+	calculation.pushNode(calculation.getSource(), &layeredNodeLabel{cost: sourceNodeCost})
+}
 
-	lastPath, shouldReturn, err := calculation.evaluateLastSubPath(paths[len(paths)-1], serviceFunctionChain, bestCost, &cost)
-	if shouldReturn {
-		return cost, nil, err
+func (calculation *ServiceFunctionChainCalculation) getAlternativeCost(cost float64, edge graph.Edge) float64 {
+	if calculation.calculationMode == CalculationModeSum {
+		return cost + calculation.getEdgeCost(edge)
 	}
-	paths = append(paths, lastPath)
-	return cost, paths, nil
+	return math.Min(cost, calculation.getEdgeCost(edge))
 }
 
-func (calculation *ServiceFunctionChainCalculation) createPathFromSubPaths(subPaths []graph.Path) graph.Path {
-	edges := make([]graph.Edge, 0)
-	var bottleneckEdge graph.Edge
-	bottleneckValue := math.Inf(1)
-	for _, subPath := range subPaths {
-		edges = append(edges, subPath.GetEdges()...)
-		if subPath.GetBottleneckValue() < bottleneckValue {
-			bottleneckEdge = subPath.GetBottleneckEdge()
-			bottleneckValue = subPath.GetBottleneckValue()
-		}
+func (calculation *ServiceFunctionChainCalculation) relaxEdge(current layeredNode, label *layeredNodeLabel, edge graph.Edge) {
+	neighbor := layeredNode{nodeId: edge.To().GetId(), layer: current.layer, waypointIndex: getWaypointIndex(calculation.waypoints, current.waypointIndex, edge.To().GetId(), edge)}
+	if calculation.visitedNodes[neighbor] || calculation.isExcluded(edge) {
+		return
+	}
+	cost := calculation.getAlternativeCost(label.cost, edge)
+	hopCount := label.hopCount + 1
+	var currentEdge graph.Edge
+	currentHopCount := 0
+	if neighborLabel, ok := calculation.labels[neighbor]; ok {
+		currentEdge, currentHopCount = neighborLabel.previousEdge, neighborLabel.hopCount
+	}
+	if !calculation.isBetterCandidate(cost, calculation.getNodeCost(neighbor), edge, currentEdge, hopCount, currentHopCount) {
+		return
 	}
-	lastPath := subPaths[len(subPaths)-1]
-	return graph.NewShortestPath(edges, lastPath.GetTotalCost(), lastPath.GetTotalDelay(), lastPath.GetTotalJitter(), lastPath.GetTotalPacketLoss(), bottleneckValue, bottleneckEdge)
+	latency := label.latency + edge.GetWeight(helper.LatencyKey)
+	jitter := label.jitter + edge.GetWeight(helper.JitterKey)
+	packetLoss := 1 - ((1 - label.packetLoss) * (1 - edge.GetWeight(helper.PacketLossKey)/100))
+	if calculation.violatesMaxConstraints(edge, latency, jitter, packetLoss) || calculation.violatesBandwidthMinConstraint(edge) || calculation.violatesHopCountConstraint(edge, hopCount) {
+		return
+	}
+	calculation.pushNode(neighbor, &layeredNodeLabel{cost: cost, latency: latency, jitter: jitter, packetLoss: packetLoss, serviceCost: label.serviceCost, hopCount: hopCount, previousEdge: edge, previousWaypointIndex: current.waypointIndex})
+}
+
+func (calculation *ServiceFunctionChainCalculation) getLastLayer() uint64 {
+	return 1<<len(calculation.serviceRouters) - 1
 }
 
-func (calculation *ServiceFunctionChainCalculation) updateBestPath(currentCost float64, bestCost *float64, currentPaths []graph.Path, bestSubPaths *[]graph.Path) bool {
-	if len(currentPaths) > 0 && currentCost < *bestCost {
-		*bestCost = currentCost
-		*bestSubPaths = currentPaths
-		calculation.log.Debugf("Found new best path with cost %f", currentCost)
+// an ordered chain applies the services one after the other, an unordered chain only has to respect the service order
+func (calculation *ServiceFunctionChainCalculation) canApplyService(service int, layer uint64) bool {
+	if layer&(1<<service) != 0 {
+		return false
+	}
+	if !calculation.unordered {
+		return layer == 1<<service-1
+	}
+	if service >= len(calculation.servicePredecessors) {
 		return true
 	}
-	calculation.log.Debugf("Path with cost %f is not better than the best path with cost %f", currentCost, *bestCost)
-	return false
+	predecessors := calculation.servicePredecessors[service]
+	return layer&predecessors == predecessors
 }
 
-func (calculation *ServiceFunctionChainCalculation) updateRouterServiceMap(serviceFunctionChain []string, routerServiceMap map[string]string) {
-	for _, serviceRouterId := range serviceFunctionChain {
-		routerServiceMap[serviceRouterId] = calculation.routerServiceMap[serviceRouterId]
+// applying a service does not add a hop, the metrics grow by the processing of the service instance
+// and the cost additionally by its load
+func (calculation *ServiceFunctionChainCalculation) relaxServiceTransitions(current layeredNode, label *layeredNodeLabel) {
+	metadata := calculation.serviceMetadata[calculation.routerServiceMap[current.nodeId]]
+	latency, jitter, packetLoss := calculation.addServiceMetrics(label, metadata)
+	for service, serviceRouters := range calculation.serviceRouters {
+		if _, ok := serviceRouters[current.nodeId]; !ok || !calculation.canApplyService(service, current.layer) {
+			continue
+		}
+		next := layeredNode{nodeId: current.nodeId, layer: current.layer | 1<<service, waypointIndex: current.waypointIndex}
+		serviceCost := calculation.getServiceLoadCost(current.nodeId) + calculation.getServiceMetricCost(metadata)
+		cost := label.cost + serviceCost
+		if calculation.visitedNodes[next] || !calculation.isBetterCost(cost, calculation.getNodeCost(next)) {
+			continue
+		}
+		if key, ok := calculation.getViolatedMaxConstraint(latency, jitter, packetLoss); ok {
+			calculation.log.Debugf("Service %d at router %s violates %s constraint, returning", service+1, current.nodeId, key)
+			continue
+		}
+		calculation.log.Debugf("Service %d can be applied at router %s", service+1, current.nodeId)
+		calculation.pushNode(next, &layeredNodeLabel{cost: cost, latency: latency, jitter: jitter, packetLoss: packetLoss, serviceCost: label.serviceCost + serviceCost, hopCount: label.hopCount, previousLayer: current.layer})
 	}
 }
 
-func (calculation *ServiceFunctionChainCalculation) calculateBestServiceChain() ([]graph.Path, map[string]string, error) {
-	bestCost := math.Inf(1)
-	var bestSubPaths []graph.Path
-	bestServiceFunctionChain := make([]string, 0)
+func (calculation *ServiceFunctionChainCalculation) getSource() layeredNode {
+	sourceId := calculation.source.GetId()
+	return layeredNode{nodeId: sourceId, layer: 0, waypointIndex: getWaypointIndex(calculation.waypoints, 0, sourceId, nil)}
+}
+
+// the destination is the last waypoint, so it is only reached once all services are applied and all waypoints are passed
+func (calculation *ServiceFunctionChainCalculation) getDestination() layeredNode {
+	return layeredNode{nodeId: calculation.destination.GetId(), layer: calculation.getLastLayer(), waypointIndex: len(calculation.waypoints)}
+}
 
-	for index, serviceFunctionChain := range calculation.serviceFunctionChain {
-		calculation.log.Debugf("Processing %d. service function chain", index+1)
-		cost, paths, err := calculation.processServiceFunctionChain(serviceFunctionChain, bestCost)
-		if err != nil {
-			calculation.log.Errorf("Error processing service function chain: %s", err)
+func (calculation *ServiceFunctionChainCalculation) performDijkstra() {
+	destination := calculation.getDestination()
+	for !calculation.priorityQueue.IsEmpty() {
+		item := heap.Pop(&calculation.priorityQueue).(*Item)
+		current := layeredNode{nodeId: item.GetNodeId(), layer: item.layer, waypointIndex: item.waypointIndex}
+		if calculation.visitedNodes[current] {
+			continue
+		}
+		calculation.visitedNodes[current] = true
+		if current == destination {
+			return
+		}
+		label := calculation.labels[current]
+		calculation.relaxServiceTransitions(current, label)
+		currentNode := calculation.graph.GetNode(current.nodeId)
+		if currentNode == nil {
 			continue
 		}
-		if calculation.updateBestPath(cost, &bestCost, paths, &bestSubPaths) {
-			bestServiceFunctionChain = serviceFunctionChain
+		for _, edge := range getSortedEdges(currentNode) {
+			calculation.relaxEdge(current, label, edge)
 		}
 	}
-	if len(bestSubPaths) == 0 {
+}
+
+func (calculation *ServiceFunctionChainCalculation) reconstructPath() ([]graph.Edge, map[string]string, error) {
+	source := calculation.getSource()
+	current := calculation.getDestination()
+	if !calculation.visitedNodes[current] {
 		return nil, nil, fmt.Errorf("No valid path for service function chain found")
 	}
-
+	edges := make([]graph.Edge, 0)
 	routerServiceMap := make(map[string]string)
-	calculation.updateRouterServiceMap(bestServiceFunctionChain, routerServiceMap)
-	return bestSubPaths, routerServiceMap, nil
+	for current != source {
+		label := calculation.labels[current]
+		edge := label.previousEdge
+		if edge == nil {
+			routerServiceMap[current.nodeId] = calculation.routerServiceMap[current.nodeId]
+			current.layer = label.previousLayer
+			continue
+		}
+		edges = append([]graph.Edge{edge}, edges...)
+		current.nodeId = edge.From().GetId()
+		current.waypointIndex = label.previousWaypointIndex
+	}
+	return edges, routerServiceMap, nil
 }
 
 func (calculation *ServiceFunctionChainCalculation) Execute() (graph.Path, error) {
-	bestSubPaths, routerServiceMap, err := calculation.calculateBestServiceChain()
+	calculation.log.Debugf("Calculating service function chain with %d services from %s to %s", len(calculation.serviceRouters), calculation.source.GetName(), calculation.destination.GetName())
+	waypoints, err := calculation.getWaypoints()
+	if err != nil {
+		return nil, err
+	}
+	calculation.waypoints = waypoints
+	calculation.initializeServiceLoadCost()
+	calculation.initializeDijkstra()
+	calculation.performDijkstra()
+	edges, routerServiceMap, err := calculation.reconstructPath()
 	if err != nil {
 		return nil, err
 	}
-	path := calculation.createPathFromSubPaths(bestSubPaths)
+	path := calculation.createPathFromEdges(edges)
 	path.SetRouterServiceMap(routerServiceMap)
+	destinationLabel := calculation.labels[calculation.getDestination()]
+	// the chain is selected including the processing and the load of the service instances, so the path reports the same cost
+	path.SetServiceCost(destinationLabel.serviceCost)
+	path.SetTotalCost(path.GetTotalCost() + destinationLabel.serviceCost)
+	path.SetTotalDelay(destinationLabel.latency)
+	path.SetTotalJitter(destinationLabel.jitter)
+	path.SetTotalPacketLoss(destinationLabel.packetLoss)
+	calculation.log.Debugf("Service function chain found with cost %g via service routers %v", path.GetTotalCost(), routerServiceMap)
 	return path, nil
 }
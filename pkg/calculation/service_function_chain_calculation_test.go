@@ -7,6 +7,7 @@ import (
 	reflect "reflect"
 	"testing"
 
+	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/hawkv6/hawkeye/pkg/graph"
 	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/stretchr/testify/assert"
@@ -56,14 +57,14 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 		totalCost   float64
 	}
 	type args struct {
-		from                 graph.Node
-		to                   graph.Node
-		weightTypes          []helper.WeightKey
-		calculationType      CalculationMode
-		minConstraints       map[helper.WeightKey]float64
-		maxConstraints       map[helper.WeightKey]float64
-		serviceFunctionChain [][]string
-		routerServiceMap     map[string]string
+		from             graph.Node
+		to               graph.Node
+		weightTypes      []helper.WeightKey
+		calculationType  CalculationMode
+		minConstraints   map[helper.WeightKey]float64
+		maxConstraints   map[helper.WeightKey]float64
+		serviceRouters   [][]string
+		routerServiceMap map[string]string
 	}
 	tests := []struct {
 		name    string
@@ -74,14 +75,14 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 		{
 			name: "Test sfc path igp metric",
 			args: args{
-				from:                 nodes[1],
-				to:                   nodes[8],
-				weightTypes:          []helper.WeightKey{helper.IgpMetricKey},
-				calculationType:      CalculationModeSum,
-				minConstraints:       make(map[helper.WeightKey]float64),
-				maxConstraints:       make(map[helper.WeightKey]float64),
-				serviceFunctionChain: [][]string{{"2", "5"}, {"2", "7"}, {"4", "7"}, {"4", "5"}},
-				routerServiceMap:     map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
+				from:             nodes[1],
+				to:               nodes[8],
+				weightTypes:      []helper.WeightKey{helper.IgpMetricKey},
+				calculationType:  CalculationModeSum,
+				minConstraints:   make(map[helper.WeightKey]float64),
+				maxConstraints:   make(map[helper.WeightKey]float64),
+				serviceRouters:   [][]string{{"2", "4"}, {"5", "7"}},
+				routerServiceMap: map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
 			},
 			want: Result{
 				edgeNumbers: []int{1, 4, 8},
@@ -92,14 +93,32 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 		{
 			name: "Test sfc path igp metric non optimal order",
 			args: args{
-				from:                 nodes[1],
-				to:                   nodes[8],
-				weightTypes:          []helper.WeightKey{helper.IgpMetricKey},
-				calculationType:      CalculationModeSum,
-				minConstraints:       make(map[helper.WeightKey]float64),
-				maxConstraints:       make(map[helper.WeightKey]float64),
-				serviceFunctionChain: [][]string{{"4", "7"}, {"4", "5"}, {"2", "5"}, {"2", "7"}},
-				routerServiceMap:     map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
+				from:             nodes[1],
+				to:               nodes[8],
+				weightTypes:      []helper.WeightKey{helper.IgpMetricKey},
+				calculationType:  CalculationModeSum,
+				minConstraints:   make(map[helper.WeightKey]float64),
+				maxConstraints:   make(map[helper.WeightKey]float64),
+				serviceRouters:   [][]string{{"4", "2"}, {"7", "5"}},
+				routerServiceMap: map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
+			},
+			want: Result{
+				edgeNumbers: []int{1, 4, 8},
+				totalCost:   edges[1].GetWeight(helper.IgpMetricKey) + edges[4].GetWeight(helper.IgpMetricKey) + edges[8].GetWeight(helper.IgpMetricKey),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test sfc path igp metric service on destination router",
+			args: args{
+				from:             nodes[1],
+				to:               nodes[8],
+				weightTypes:      []helper.WeightKey{helper.IgpMetricKey},
+				calculationType:  CalculationModeSum,
+				minConstraints:   make(map[helper.WeightKey]float64),
+				maxConstraints:   make(map[helper.WeightKey]float64),
+				serviceRouters:   [][]string{{"2", "4"}, {"5", "7"}, {"6", "8"}},
+				routerServiceMap: map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "6": "2001:db8:f6::", "7": "2001:db8:f7::", "8": "2001:db8:f8::"},
 			},
 			want: Result{
 				edgeNumbers: []int{1, 4, 8},
@@ -110,14 +129,14 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 		{
 			name: "Test sfc path igp metric no sfc found",
 			args: args{
-				from:                 nodes[1],
-				to:                   nodes[8],
-				weightTypes:          []helper.WeightKey{helper.IgpMetricKey},
-				calculationType:      CalculationModeSum,
-				minConstraints:       make(map[helper.WeightKey]float64),
-				maxConstraints:       make(map[helper.WeightKey]float64),
-				serviceFunctionChain: [][]string{{"2", "7"}, {"4", "5"}},
-				routerServiceMap:     map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
+				from:             nodes[1],
+				to:               nodes[8],
+				weightTypes:      []helper.WeightKey{helper.IgpMetricKey},
+				calculationType:  CalculationModeSum,
+				minConstraints:   make(map[helper.WeightKey]float64),
+				maxConstraints:   make(map[helper.WeightKey]float64),
+				serviceRouters:   [][]string{{"2"}, {"7"}},
+				routerServiceMap: map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
 			},
 			want: Result{
 				edgeNumbers: []int{},
@@ -128,14 +147,14 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 		{
 			name: "Test sfc path low latency metric",
 			args: args{
-				from:                 nodes[1],
-				to:                   nodes[8],
-				weightTypes:          []helper.WeightKey{helper.LatencyKey},
-				calculationType:      CalculationModeSum,
-				minConstraints:       make(map[helper.WeightKey]float64),
-				maxConstraints:       make(map[helper.WeightKey]float64),
-				serviceFunctionChain: [][]string{{"2", "5"}, {"2", "7"}, {"4", "7"}, {"4", "5"}},
-				routerServiceMap:     map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
+				from:             nodes[1],
+				to:               nodes[8],
+				weightTypes:      []helper.WeightKey{helper.LatencyKey},
+				calculationType:  CalculationModeSum,
+				minConstraints:   make(map[helper.WeightKey]float64),
+				maxConstraints:   make(map[helper.WeightKey]float64),
+				serviceRouters:   [][]string{{"2", "4"}, {"5", "7"}},
+				routerServiceMap: map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
 			},
 			want: Result{
 				edgeNumbers: []int{3, 7, 10, 9},
@@ -146,14 +165,14 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 		{
 			name: "Test sfc path low packet loss metric",
 			args: args{
-				from:                 nodes[1],
-				to:                   nodes[8],
-				weightTypes:          []helper.WeightKey{helper.PacketLossKey},
-				calculationType:      CalculationModeSum,
-				minConstraints:       make(map[helper.WeightKey]float64),
-				maxConstraints:       make(map[helper.WeightKey]float64),
-				serviceFunctionChain: [][]string{{"2", "5"}, {"2", "7"}, {"4", "7"}, {"4", "5"}},
-				routerServiceMap:     map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
+				from:             nodes[1],
+				to:               nodes[8],
+				weightTypes:      []helper.WeightKey{helper.PacketLossKey},
+				calculationType:  CalculationModeSum,
+				minConstraints:   make(map[helper.WeightKey]float64),
+				maxConstraints:   make(map[helper.WeightKey]float64),
+				serviceRouters:   [][]string{{"2", "4"}, {"5", "7"}},
+				routerServiceMap: map[string]string{"2": "2001:db8:f2::", "4": "2001:db8:f4::", "5": "2001:db8:f5::", "7": "2001:db8:f7::"},
 			},
 			want: Result{
 				edgeNumbers: []int{1, 4, 8},
@@ -168,8 +187,8 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error setting up graph")
 			}
-			sfcCalculationOptions := &SfcCalculationOptions{tt.args.serviceFunctionChain, tt.args.routerServiceMap}
-			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints}
+			sfcCalculationOptions := &SfcCalculationOptions{tt.args.serviceRouters, tt.args.routerServiceMap, false, nil, nil, nil, nil}
+			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints, nil, nil}
 			calculation := NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions)
 			got, err := calculation.Execute()
 			if tt.wantErr {
@@ -201,3 +220,354 @@ func TestServiceFunctionChainCalculation_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceFunctionChainCalculation_Execute_ExcludedElements(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	tests := []struct {
+		name          string
+		excludedNodes []string
+		excludedEdges []string
+		wantEdgeIds   []string
+		wantService   string
+		wantErr       bool
+	}{
+		{
+			name:        "Test service function chain without exclusions",
+			wantEdgeIds: []string{"1", "2"},
+			wantService: "2",
+		},
+		{
+			name:          "Test service function chain with excluded service router",
+			excludedNodes: []string{"2"},
+			wantEdgeIds:   []string{"3", "4"},
+			wantService:   "3",
+		},
+		{
+			name:          "Test service function chain with excluded link",
+			excludedEdges: []string{"1"},
+			wantEdgeIds:   []string{"3", "4"},
+			wantService:   "3",
+		},
+		{
+			name:          "Test service function chain with all service routers excluded",
+			excludedNodes: []string{"2", "3"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			topologyConstraints := NewTopologyConstraints()
+			for _, nodeId := range tt.excludedNodes {
+				topologyConstraints.excludedNodes[nodeId] = struct{}{}
+			}
+			for _, edgeId := range tt.excludedEdges {
+				topologyConstraints.excludedEdges[edgeId] = struct{}{}
+			}
+			sfcCalculationOptions := &SfcCalculationOptions{[][]string{{"2", "3"}}, map[string]string{"2": "2001:db8:f2::", "3": "2001:db8:f3::"}, false, nil, nil, nil, nil}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, topologyConstraints}
+			got, err := NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+			assert.Contains(t, got.GetRouterServiceMap(), tt.wantService)
+			assert.Len(t, got.GetRouterServiceMap(), 1)
+		})
+	}
+}
+
+func TestServiceFunctionChainCalculation_Execute_IncludedElements(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	tests := []struct {
+		name          string
+		includedNodes []string
+		includedEdges []string
+		wantEdgeIds   []string
+		wantService   string
+		wantErr       bool
+	}{
+		{
+			name:          "Test service function chain with included node",
+			includedNodes: []string{"3"},
+			wantEdgeIds:   []string{"3", "4"},
+			wantService:   "3",
+		},
+		{
+			name:          "Test service function chain with included link",
+			includedEdges: []string{"6"},
+			wantEdgeIds:   []string{"1", "6", "4"},
+		},
+		{
+			name:          "Test service function chain with included nodes in unreachable order",
+			includedNodes: []string{"3", "2"},
+			wantErr:       true,
+		},
+		{
+			name:          "Test service function chain with included node not in graph",
+			includedNodes: []string{"5"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			topologyConstraints := NewTopologyConstraints()
+			topologyConstraints.includedNodes = append(topologyConstraints.includedNodes, tt.includedNodes...)
+			topologyConstraints.includedEdges = append(topologyConstraints.includedEdges, tt.includedEdges...)
+			sfcCalculationOptions := &SfcCalculationOptions{[][]string{{"2", "3"}}, map[string]string{"2": "2001:db8:f2::", "3": "2001:db8:f3::"}, false, nil, nil, nil, nil}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, topologyConstraints}
+			got, err := NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+			assert.Len(t, got.GetRouterServiceMap(), 1)
+			if tt.wantService != "" {
+				assert.Contains(t, got.GetRouterServiceMap(), tt.wantService)
+			}
+		})
+	}
+}
+
+func TestServiceFunctionChainCalculation_Execute_ServiceLoads(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	serviceLoadBlend := helper.ServiceLoadBlend
+	defer func() { helper.ServiceLoadBlend = serviceLoadBlend }()
+	tests := []struct {
+		name             string
+		serviceLoadBlend float64
+		serviceLoads     map[string]float64
+		wantEdgeIds      []string
+		wantService      string
+		wantTotalCost    float64
+	}{
+		{
+			name:             "Test service function chain without service loads",
+			serviceLoadBlend: 0.5,
+			wantEdgeIds:      []string{"1", "2"},
+			wantService:      "2",
+			wantTotalCost:    2000,
+		},
+		{
+			name:             "Test service function chain with loaded instance on the shorter path",
+			serviceLoadBlend: 0.5,
+			serviceLoads:     map[string]float64{"2001:db8:f2::": 1, "2001:db8:f3::": 0},
+			wantEdgeIds:      []string{"1", "2"},
+			wantService:      "2",
+			wantTotalCost:    2000 + 8000.0/3,
+		},
+		{
+			name:             "Test service function chain with loaded instance and high load blend",
+			serviceLoadBlend: 0.8,
+			serviceLoads:     map[string]float64{"2001:db8:f2::": 1, "2001:db8:f3::": 0},
+			wantEdgeIds:      []string{"3", "4"},
+			wantService:      "3",
+			wantTotalCost:    6000,
+		},
+		{
+			name:             "Test service function chain with loaded instance and disabled load blend",
+			serviceLoadBlend: 0,
+			serviceLoads:     map[string]float64{"2001:db8:f2::": 1, "2001:db8:f3::": 0},
+			wantEdgeIds:      []string{"1", "2"},
+			wantService:      "2",
+			wantTotalCost:    2000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helper.ServiceLoadBlend = tt.serviceLoadBlend
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			sfcCalculationOptions := &SfcCalculationOptions{[][]string{{"2", "3"}}, map[string]string{"2": "2001:db8:f2::", "3": "2001:db8:f3::"}, false, nil, nil, tt.serviceLoads, nil}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, nil}
+			got, err := NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions).Execute()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+			assert.Contains(t, got.GetRouterServiceMap(), tt.wantService)
+			assert.InDelta(t, tt.wantTotalCost, got.GetTotalCost(), tolerance)
+		})
+	}
+}
+
+func TestServiceFunctionChainCalculation_Execute_ServiceMetrics(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	lowDelayMetadata, err := domain.NewDomainServiceMetadata(0, 1, 0, 1000, 100, 0)
+	assert.NoError(t, err)
+	highDelayMetadata, err := domain.NewDomainServiceMetadata(0, 1, 0, 6000, 100, 0)
+	assert.NoError(t, err)
+	tests := []struct {
+		name            string
+		serviceMetadata map[string]domain.ServiceMetadata
+		maxConstraints  map[helper.WeightKey]float64
+		wantEdgeIds     []string
+		wantService     string
+		wantTotalCost   float64
+		wantTotalDelay  float64
+		wantTotalJitter float64
+		wantErr         bool
+	}{
+		{
+			name:            "Test service function chain without service processing metrics",
+			maxConstraints:  map[helper.WeightKey]float64{},
+			wantEdgeIds:     []string{"1", "2"},
+			wantService:     "2",
+			wantTotalCost:   2000,
+			wantTotalDelay:  2000,
+			wantTotalJitter: 0,
+		},
+		{
+			name:            "Test service function chain with service delay added to the path metrics",
+			serviceMetadata: map[string]domain.ServiceMetadata{"2001:db8:f2::": lowDelayMetadata},
+			maxConstraints:  map[helper.WeightKey]float64{},
+			wantEdgeIds:     []string{"1", "2"},
+			wantService:     "2",
+			wantTotalCost:   3000,
+			wantTotalDelay:  3000,
+			wantTotalJitter: 100,
+		},
+		{
+			name:            "Test service function chain with high service delay on the shorter path",
+			serviceMetadata: map[string]domain.ServiceMetadata{"2001:db8:f2::": highDelayMetadata, "2001:db8:f3::": lowDelayMetadata},
+			maxConstraints:  map[helper.WeightKey]float64{},
+			wantEdgeIds:     []string{"3", "4"},
+			wantService:     "3",
+			wantTotalCost:   7000,
+			wantTotalDelay:  7000,
+			wantTotalJitter: 100,
+		},
+		{
+			name:            "Test service function chain with max latency violated by service delay",
+			serviceMetadata: map[string]domain.ServiceMetadata{"2001:db8:f2::": lowDelayMetadata, "2001:db8:f3::": lowDelayMetadata},
+			maxConstraints:  map[helper.WeightKey]float64{helper.NormalizedLatencyKey: 2500},
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			sfcCalculationOptions := &SfcCalculationOptions{[][]string{{"2", "3"}}, map[string]string{"2": "2001:db8:f2::", "3": "2001:db8:f3::"}, false, nil, nil, nil, tt.serviceMetadata}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, tt.maxConstraints, map[helper.WeightKey]float64{}, nil, nil}
+			got, err := NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+			assert.Contains(t, got.GetRouterServiceMap(), tt.wantService)
+			assert.Equal(t, tt.wantTotalCost, got.GetTotalCost())
+			assert.Equal(t, tt.wantTotalDelay, got.GetTotalDelay())
+			assert.Equal(t, tt.wantTotalJitter, got.GetTotalJitter())
+		})
+	}
+}
+
+func TestServiceFunctionChainCalculation_Execute_HopCountConstraints(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	tests := []struct {
+		name           string
+		maxConstraints map[helper.WeightKey]float64
+		wantEdgeIds    []string
+		wantErr        bool
+	}{
+		{
+			name:           "Test service function chain without hop count constraints",
+			maxConstraints: map[helper.WeightKey]float64{},
+			wantEdgeIds:    []string{"1", "6", "4"},
+		},
+		{
+			name:           "Test service function chain with satisfied max hop count",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 3},
+			wantEdgeIds:    []string{"1", "6", "4"},
+		},
+		{
+			name:           "Test service function chain with exceeded max hop count",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 2},
+			wantErr:        true,
+		},
+		{
+			name:           "Test service function chain with SID depth including service SIDs",
+			maxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 5},
+			wantEdgeIds:    []string{"1", "6", "4"},
+		},
+		{
+			name:           "Test service function chain with SID depth exceeded by service SIDs",
+			maxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 4},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			sfcCalculationOptions := &SfcCalculationOptions{[][]string{{"2"}, {"3"}}, map[string]string{"2": "2001:db8:f2::", "3": "2001:db8:f3::"}, false, nil, nil, nil, nil}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, tt.maxConstraints, map[helper.WeightKey]float64{}, nil, nil}
+			got, err := NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+			assert.Equal(t, tt.maxConstraints, calculationOptions.maxConstraints)
+		})
+	}
+}
+
+func TestServiceFunctionChainCalculation_Execute_Unordered(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	tests := []struct {
+		name                string
+		unordered           bool
+		servicePredecessors []uint64
+		wantEdgeIds         []string
+		wantErr             bool
+	}{
+		{
+			name:    "Test ordered service function chain without valid order",
+			wantErr: true,
+		},
+		{
+			name:        "Test unordered service function chain",
+			unordered:   true,
+			wantEdgeIds: []string{"1", "6", "4"},
+		},
+		{
+			name:                "Test unordered service function chain with satisfiable service order",
+			unordered:           true,
+			servicePredecessors: []uint64{2, 0},
+			wantEdgeIds:         []string{"1", "6", "4"},
+		},
+		{
+			name:                "Test unordered service function chain with unsatisfiable service order",
+			unordered:           true,
+			servicePredecessors: []uint64{0, 1},
+			wantErr:             true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			routerServiceMap := map[string]string{"2": "2001:db8:f2::", "3": "2001:db8:f3::"}
+			sfcCalculationOptions := &SfcCalculationOptions{[][]string{{"3"}, {"2"}}, routerServiceMap, tt.unordered, tt.servicePredecessors, nil, nil, nil}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, nil}
+			got, err := NewServiceFunctionChainCalculation(calculationOptions, sfcCalculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+			assert.Equal(t, routerServiceMap, got.GetRouterServiceMap())
+		})
+	}
+}
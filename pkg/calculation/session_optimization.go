@@ -0,0 +1,187 @@
+package calculation
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+)
+
+// proposed move of a session from its current path to a new path
+type SessionMove struct {
+	Session           domain.StreamSession
+	CurrentPathResult domain.PathResult
+	NewPathResult     domain.PathResult
+}
+
+// result of a global optimization pass, the maximum utilization only considers links with reserved bandwidth
+type OptimizationReport struct {
+	Moves                   []SessionMove
+	CurrentMaxUtilization   float64
+	OptimizedMaxUtilization float64
+}
+
+// utilization of a link by the measured traffic and the bandwidth reserved by the sessions
+func getLinkUtilization(maximumBandwidth, availableBandwidth, reservedBandwidth float64) float64 {
+	if maximumBandwidth <= 0 {
+		return 0
+	}
+	return (maximumBandwidth - availableBandwidth + reservedBandwidth) / maximumBandwidth
+}
+
+func (manager *CalculationManager) getMostUtilizedEdge() (string, float64) {
+	manager.lockElements()
+	defer manager.unlockElements()
+	mostUtilizedEdgeId, maxUtilization := "", 0.0
+	for edgeId, reservedBandwidth := range manager.cache.GetBandwidthReservations() {
+		edge := manager.graph.GetEdge(edgeId)
+		if edge == nil {
+			continue
+		}
+		utilization := getLinkUtilization(edge.GetWeight(helper.MaximumLinkBandwidthKey), edge.GetWeight(helper.AvailableBandwidthKey), reservedBandwidth)
+		if utilization > maxUtilization || (utilization == maxUtilization && edgeId < mostUtilizedEdgeId) {
+			mostUtilizedEdgeId, maxUtilization = edgeId, utilization
+		}
+	}
+	return mostUtilizedEdgeId, maxUtilization
+}
+
+func (manager *CalculationManager) addSession(pathResult domain.PathResult) {
+	manager.AddServiceSessions(pathResult)
+	manager.ReserveBandwidth(pathResult)
+}
+
+func (manager *CalculationManager) removeSession(pathResult domain.PathResult) {
+	manager.RemoveServiceSessions(pathResult)
+	manager.ReleaseBandwidth(pathResult)
+}
+
+// the whole available bandwidth of the link is reserved, so no session with a bandwidth demand can use it
+func (manager *CalculationManager) blockEdge(edgeId string) func() {
+	manager.lockElements()
+	defer manager.unlockElements()
+	edge := manager.graph.GetEdge(edgeId)
+	if edge == nil {
+		return func() {}
+	}
+	availableBandwidth := edge.GetWeight(helper.AvailableBandwidthKey)
+	manager.cache.AddBandwidthReservation(edgeId, availableBandwidth)
+	return func() {
+		manager.cache.Lock()
+		defer manager.cache.Unlock()
+		manager.cache.RemoveBandwidthReservation(edgeId, availableBandwidth)
+	}
+}
+
+func usesEdge(pathResult domain.PathResult, edgeId string) bool {
+	for _, edge := range pathResult.GetEdges() {
+		if edge.GetId() == edgeId {
+			return true
+		}
+	}
+	return false
+}
+
+// only sessions with a bandwidth demand contribute to the link utilization, the largest demands are placed first
+func getOptimizableSessions(sessions []domain.StreamSession) []domain.StreamSession {
+	optimizableSessions := make([]domain.StreamSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.GetPathRequest().GetBandwidthDemand() > 0 {
+			optimizableSessions = append(optimizableSessions, session)
+		}
+	}
+	slices.SortStableFunc(optimizableSessions, func(first, second domain.StreamSession) int {
+		if order := cmp.Compare(second.GetPathRequest().GetBandwidthDemand(), first.GetPathRequest().GetBandwidthDemand()); order != 0 {
+			return order
+		}
+		return cmp.Compare(first.GetPathRequest().Serialize(), second.GetPathRequest().Serialize())
+	})
+	return optimizableSessions
+}
+
+// all sessions are ripped up and placed again one after the other, every session sees the reservations of the sessions placed before
+func (manager *CalculationManager) placeSessions(sessions []domain.StreamSession) []domain.PathResult {
+	for _, session := range sessions {
+		manager.removeSession(session.GetPathResult())
+	}
+	placements := make([]domain.PathResult, len(sessions))
+	for index, session := range sessions {
+		pathResult, err := manager.CalculateBestPath(session.GetPathRequest())
+		if err != nil {
+			manager.log.Debugf("Session %s can not be placed again, keeping its current path: %s", session.GetPathRequest().Serialize(), err)
+			pathResult = session.GetPathResult()
+		}
+		manager.addSession(pathResult)
+		placements[index] = pathResult
+	}
+	return placements
+}
+
+// a session on the most utilized link is rerouted around it if this lowers the maximum utilization
+func (manager *CalculationManager) rerouteFromMostUtilizedEdge(sessions []domain.StreamSession, placements []domain.PathResult) bool {
+	edgeId, maxUtilization := manager.getMostUtilizedEdge()
+	for index, session := range sessions {
+		if !usesEdge(placements[index], edgeId) {
+			continue
+		}
+		manager.removeSession(placements[index])
+		unblockEdge := manager.blockEdge(edgeId)
+		pathResult, err := manager.CalculateBestPath(session.GetPathRequest())
+		unblockEdge()
+		if err == nil {
+			manager.addSession(pathResult)
+			if _, utilization := manager.getMostUtilizedEdge(); utilization < maxUtilization {
+				manager.log.Debugf("Session %s is rerouted around link %s", session.GetPathRequest().Serialize(), edgeId)
+				placements[index] = pathResult
+				return true
+			}
+			manager.removeSession(pathResult)
+		}
+		manager.addSession(placements[index])
+	}
+	return false
+}
+
+func (manager *CalculationManager) getSessionMoves(sessions []domain.StreamSession, placements []domain.PathResult) []SessionMove {
+	moves := make([]SessionMove, 0)
+	for index, session := range sessions {
+		currentPathResult := session.GetPathResult()
+		if !slices.Equal(currentPathResult.GetIpv6SidAddresses(), placements[index].GetIpv6SidAddresses()) {
+			moves = append(moves, SessionMove{Session: session, CurrentPathResult: currentPathResult, NewPathResult: placements[index]})
+		}
+	}
+	return moves
+}
+
+// OptimizeSessions places all sessions with a bandwidth demand together to minimize the maximum link utilization.
+// The sessions are placed greedily by decreasing demand with their own intents, afterwards sessions are ripped up from
+// the most utilized link and rerouted around it as long as the maximum utilization decreases. The moves are only
+// proposed if they lower the maximum utilization, the reservations of the current paths are restored before returning.
+func (manager *CalculationManager) OptimizeSessions(sessions []domain.StreamSession) *OptimizationReport {
+	sessions = getOptimizableSessions(sessions)
+	report := &OptimizationReport{Moves: make([]SessionMove, 0)}
+	_, report.CurrentMaxUtilization = manager.getMostUtilizedEdge()
+	report.OptimizedMaxUtilization = report.CurrentMaxUtilization
+	if len(sessions) == 0 {
+		return report
+	}
+
+	placements := manager.placeSessions(sessions)
+	for iteration := 0; iteration < len(sessions); iteration++ {
+		if !manager.rerouteFromMostUtilizedEdge(sessions, placements) {
+			break
+		}
+	}
+	_, optimizedMaxUtilization := manager.getMostUtilizedEdge()
+	if optimizedMaxUtilization < report.CurrentMaxUtilization {
+		report.OptimizedMaxUtilization = optimizedMaxUtilization
+		report.Moves = manager.getSessionMoves(sessions, placements)
+	}
+
+	for index, session := range sessions {
+		manager.removeSession(placements[index])
+		manager.addSession(session.GetPathResult())
+	}
+	return report
+}
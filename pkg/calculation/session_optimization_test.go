@@ -0,0 +1,164 @@
+package calculation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/api"
+	"github.com/hawkv6/hawkeye/pkg/cache"
+	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetLinkUtilization(t *testing.T) {
+	tests := []struct {
+		name               string
+		maximumBandwidth   float64
+		availableBandwidth float64
+		reservedBandwidth  float64
+		want               float64
+	}{
+		{
+			name:               "Test getLinkUtilization with measured traffic and reservations",
+			maximumBandwidth:   10000,
+			availableBandwidth: 8000,
+			reservedBandwidth:  3000,
+			want:               0.5,
+		},
+		{
+			name:               "Test getLinkUtilization oversubscribed link",
+			maximumBandwidth:   10000,
+			availableBandwidth: 10000,
+			reservedBandwidth:  12000,
+			want:               1.2,
+		},
+		{
+			name:               "Test getLinkUtilization without maximum bandwidth",
+			maximumBandwidth:   0,
+			availableBandwidth: 0,
+			reservedBandwidth:  1000,
+			want:               0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, getLinkUtilization(tt.maximumBandwidth, tt.availableBandwidth, tt.reservedBandwidth), tolerance)
+		})
+	}
+}
+
+func TestCalculationManager_OptimizeSessions(t *testing.T) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		3: graph.NewNetworkNode("3", "3", srAlgorithm),
+		4: graph.NewNetworkNode("4", "4", srAlgorithm),
+	}
+	getWeights := func(latency float64) map[helper.WeightKey]float64 {
+		return map[helper.WeightKey]float64{
+			helper.LatencyKey:              latency,
+			helper.MaximumLinkBandwidthKey: 10000,
+			helper.AvailableBandwidthKey:   10000,
+		}
+	}
+	edges := map[int]graph.Edge{
+		12: graph.NewNetworkEdge("12", nodes[1], nodes[2], getWeights(10)),
+		24: graph.NewNetworkEdge("24", nodes[2], nodes[4], getWeights(10)),
+		13: graph.NewNetworkEdge("13", nodes[1], nodes[3], getWeights(20)),
+		34: graph.NewNetworkEdge("34", nodes[3], nodes[4], getWeights(20)),
+	}
+	tests := []struct {
+		name                        string
+		bandwidthDemands            []uint32
+		wantMoves                   int
+		wantCurrentMaxUtilization   float64
+		wantOptimizedMaxUtilization float64
+	}{
+		{
+			name:                        "Test OptimizeSessions moves a session from the oversubscribed path",
+			bandwidthDemands:            []uint32{6000, 6000},
+			wantMoves:                   1,
+			wantCurrentMaxUtilization:   1.2,
+			wantOptimizedMaxUtilization: 0.6,
+		},
+		{
+			name:                        "Test OptimizeSessions spreads sessions which already fit",
+			bandwidthDemands:            []uint32{3000, 3000},
+			wantMoves:                   1,
+			wantCurrentMaxUtilization:   0.6,
+			wantOptimizedMaxUtilization: 0.3,
+		},
+		{
+			name:                        "Test OptimizeSessions keeps a session if no move lowers the utilization",
+			bandwidthDemands:            []uint32{6000},
+			wantMoves:                   0,
+			wantCurrentMaxUtilization:   0.6,
+			wantOptimizedMaxUtilization: 0.6,
+		},
+		{
+			name:                        "Test OptimizeSessions without bandwidth demand",
+			bandwidthDemands:            []uint32{0, 0},
+			wantMoves:                   0,
+			wantCurrentMaxUtilization:   0,
+			wantOptimizedMaxUtilization: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			network, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			inMemoryCache := cache.NewInMemoryCache()
+			calculationSetup := NewMockCalculationSetup(controller)
+			calculationTransformer := NewMockCalculationTransformer(controller)
+			manager := NewCalculationManager(inMemoryCache, network, calculationSetup, calculationTransformer, NewMockCalculationUpdater(controller))
+
+			calculationSetup.EXPECT().PerformSetup(gomock.Any()).DoAndReturn(func(pathRequest domain.PathRequest) (*CalculationOptions, error) {
+				minConstraints := map[helper.WeightKey]float64{helper.AvailableBandwidthKey: float64(pathRequest.GetBandwidthDemand())}
+				return &CalculationOptions{network, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, minConstraints, []float64{1}, nil}, nil
+			}).AnyTimes()
+			calculationSetup.EXPECT().GetLexicographicTolerances(gomock.Any()).Return(nil).AnyTimes()
+			calculationTransformer.EXPECT().TransformResult(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(path graph.Path, pathRequest domain.PathRequest, algorithm uint32) domain.PathResult {
+				sids := make([]string, 0)
+				for _, edge := range path.GetEdges() {
+					sids = append(sids, fmt.Sprintf("fc00::%s", edge.To().GetId()))
+				}
+				pathResult, err := domain.NewDomainPathResult(pathRequest, path, sids)
+				assert.NoError(t, err)
+				return pathResult
+			}).AnyTimes()
+
+			currentPath := graph.NewShortestPath([]graph.Edge{edges[12], edges[24]}, 20, 20, 0, 0, 0, nil)
+			sessions := make([]domain.StreamSession, 0, len(tt.bandwidthDemands))
+			for index, bandwidthDemand := range tt.bandwidthDemands {
+				intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+				pathRequest, err := domain.NewDomainPathRequest("2001:db8::1", fmt.Sprintf("2001:db8::1%d", index), intents, api.NewMockIntentController_GetIntentPathServer(controller), context.Background())
+				assert.NoError(t, err)
+				pathRequest.SetBandwidthDemand(bandwidthDemand)
+				pathResult, err := domain.NewDomainPathResult(pathRequest, currentPath, []string{"fc00::2", "fc00::4"})
+				assert.NoError(t, err)
+				manager.ReserveBandwidth(pathResult)
+				sessions = append(sessions, domain.NewDomainStreamSession(pathRequest, pathResult))
+			}
+			reservations := inMemoryCache.GetBandwidthReservations()
+
+			report := manager.OptimizeSessions(sessions)
+			assert.Len(t, report.Moves, tt.wantMoves)
+			assert.InDelta(t, tt.wantCurrentMaxUtilization, report.CurrentMaxUtilization, tolerance)
+			assert.InDelta(t, tt.wantOptimizedMaxUtilization, report.OptimizedMaxUtilization, tolerance)
+			for _, move := range report.Moves {
+				assert.Equal(t, []string{"fc00::3", "fc00::4"}, move.NewPathResult.GetIpv6SidAddresses())
+				assert.Equal(t, []string{"fc00::2", "fc00::4"}, move.CurrentPathResult.GetIpv6SidAddresses())
+			}
+			assert.Equal(t, reservations, inMemoryCache.GetBandwidthReservations())
+			for _, edge := range edges {
+				assert.Equal(t, float64(10000), edge.GetWeight(helper.AvailableBandwidthKey))
+			}
+		})
+	}
+}
@@ -9,6 +9,11 @@ import (
 	"github.com/hawkv6/hawkeye/pkg/helper"
 )
 
+type waypoint struct {
+	node graph.Node
+	edge graph.Edge
+}
+
 type ShortestPathCalculation struct {
 	BaseCalculation
 	nodeWeights      map[string]float64
@@ -18,11 +23,12 @@ type ShortestPathCalculation struct {
 	nodeLatencies    map[string]float64
 	nodeJitters      map[string]float64
 	nodePacketLosses map[string]float64
+	nodeHopCounts    map[string]int
 	sourceNodeCost   float64
 }
 
 func NewShortestPathCalculation(options *CalculationOptions) *ShortestPathCalculation {
-	return &ShortestPathCalculation{
+	calculation := &ShortestPathCalculation{
 		BaseCalculation:  *NewBaseCalculation(options),
 		nodeWeights:      make(map[string]float64),
 		EdgeToPrevious:   make(map[string]graph.Edge),
@@ -30,11 +36,18 @@ func NewShortestPathCalculation(options *CalculationOptions) *ShortestPathCalcul
 		nodeLatencies:    make(map[string]float64),
 		nodeJitters:      make(map[string]float64),
 		nodePacketLosses: make(map[string]float64),
+		nodeHopCounts:    make(map[string]int),
 		sourceNodeCost:   0,
 	}
+	// nodes and links excluded by the k-shortest and the disjoint path calculations only apply to this calculation
+	calculation.topologyConstraints = options.topologyConstraints.clone()
+	return calculation
 }
 
 func (calculation *ShortestPathCalculation) Execute() (graph.Path, error) {
+	if calculation.hasWaypoints() {
+		return calculation.executeWithWaypoints()
+	}
 	calculation.initializeDijkstra()
 	calculation.performDijkstra()
 	return calculation.reconstructPath()
@@ -48,6 +61,18 @@ func (calculation *ShortestPathCalculation) SetInitialSourceNodeMetrics(cost flo
 	calculation.nodePacketLosses[source] = packetLoss
 }
 
+func (calculation *ShortestPathCalculation) SetInitialSourceNodeHopCount(hopCount int) {
+	calculation.nodeHopCounts[calculation.source.GetId()] = hopCount
+}
+
+func (calculation *ShortestPathCalculation) ExcludeNode(nodeId string) {
+	calculation.topologyConstraints.excludedNodes[nodeId] = struct{}{}
+}
+
+func (calculation *ShortestPathCalculation) ExcludeEdge(edgeId string) {
+	calculation.topologyConstraints.excludedEdges[edgeId] = struct{}{}
+}
+
 func (calculation *ShortestPathCalculation) initializeNodeMetrics(initialNodeCost float64, sourceNodeId string) {
 	for id := range calculation.graph.GetNodes() {
 		if id != sourceNodeId {
@@ -55,6 +80,7 @@ func (calculation *ShortestPathCalculation) initializeNodeMetrics(initialNodeCos
 			calculation.nodeLatencies[id] = 0
 			calculation.nodeJitters[id] = 0
 			calculation.nodePacketLosses[id] = 0
+			calculation.nodeHopCounts[id] = 0
 		}
 	}
 }
@@ -98,42 +124,16 @@ func (calculation *ShortestPathCalculation) getMetrics(edge graph.Edge, currentN
 	return latency, jitter, packetLoss
 }
 
-func (calculation *ShortestPathCalculation) violatesMaxConstraints(edge graph.Edge, latency, jitter, packetLoss float64) bool {
-	metrics := map[helper.WeightKey]float64{
-		helper.NormalizedLatencyKey:    latency,
-		helper.NormalizedJitterKey:     jitter,
-		helper.NormalizedPacketLossKey: packetLoss,
-	}
-	for key, value := range metrics {
-		if maxValue, ok := calculation.maxConstraints[key]; ok {
-			if maxValue < value {
-				calculation.log.Debugf("Edge from %s to %s violates %s constraint, returning", edge.From().GetName(), edge.To().GetName(), key)
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func (calculation *ShortestPathCalculation) violatesBandwidthMinConstraint(edge graph.Edge) bool {
-	if minValue, ok := calculation.minConstraints[helper.AvailableBandwidthKey]; ok {
-		bandwidth := edge.GetWeight(helper.AvailableBandwidthKey)
-		if minValue > bandwidth {
-			calculation.log.Debugf("Edge from %s to %s violates %s constraint, returning", edge.From().GetName(), edge.To().GetName(), helper.AvailableBandwidthKey)
-			return true
-		}
-	}
-	return false
-}
-
 func (calculation *ShortestPathCalculation) updateMetricsAndPrevious(currentNodeId, neighborNodeId string, weight float64, edge graph.Edge) {
 	latency, jitter, packetLoss := calculation.getMetrics(edge, currentNodeId)
-	if !calculation.violatesMaxConstraints(edge, latency, jitter, packetLoss) && !calculation.violatesBandwidthMinConstraint(edge) {
+	hopCount := calculation.nodeHopCounts[currentNodeId] + 1
+	if !calculation.violatesMaxConstraints(edge, latency, jitter, packetLoss) && !calculation.violatesBandwidthMinConstraint(edge) && !calculation.violatesHopCountConstraint(edge, hopCount) {
 		calculation.nodeWeights[neighborNodeId] = weight
 		calculation.EdgeToPrevious[neighborNodeId] = edge
 		calculation.nodeLatencies[neighborNodeId] = latency
 		calculation.nodeJitters[neighborNodeId] = jitter
 		calculation.nodePacketLosses[neighborNodeId] = packetLoss
+		calculation.nodeHopCounts[neighborNodeId] = hopCount
 		heap.Push(&calculation.priorityQueue, &Item{nodeId: neighborNodeId, cost: weight})
 	}
 }
@@ -146,23 +146,27 @@ func (calculation *ShortestPathCalculation) calculateAlternativeDistance(current
 	return calculation.nodeWeights[currentNodeId] + edgeWeight
 }
 
+func (calculation *ShortestPathCalculation) isBetterDistance(currentNodeId, neighborNodeId string, distance float64, edge graph.Edge) bool {
+	return calculation.isBetterCandidate(distance, calculation.nodeWeights[neighborNodeId], edge, calculation.EdgeToPrevious[neighborNodeId], calculation.nodeHopCounts[currentNodeId]+1, calculation.nodeHopCounts[neighborNodeId])
+}
+
 func (calculation *ShortestPathCalculation) handleDefaultCalculation(currentNodeId, neighborNodeId string, edgeWeight float64, edge graph.Edge) {
 	alternativeDistance := calculation.calculateAlternativeDistance(currentNodeId, edgeWeight)
-	if alternativeDistance < calculation.nodeWeights[neighborNodeId] {
+	if calculation.isBetterDistance(currentNodeId, neighborNodeId, alternativeDistance, edge) {
 		calculation.updateMetricsAndPrevious(currentNodeId, neighborNodeId, alternativeDistance, edge)
 	}
 }
 
 func (calculation *ShortestPathCalculation) handleMaxCalculation(currentNodeId, neighborNodeId string, weight float64, edge graph.Edge) {
 	minimum := math.Min(calculation.nodeWeights[currentNodeId], weight)
-	if minimum > calculation.nodeWeights[neighborNodeId] {
+	if calculation.isBetterDistance(currentNodeId, neighborNodeId, minimum, edge) {
 		calculation.updateMetricsAndPrevious(currentNodeId, neighborNodeId, minimum, edge)
 	}
 }
 
 func (calculation *ShortestPathCalculation) handleMinCalculation(currentNodeId, neighborNodeId string, weight float64, edge graph.Edge) {
 	minimum := math.Min(calculation.nodeWeights[currentNodeId], weight)
-	if minimum < calculation.nodeWeights[neighborNodeId] {
+	if calculation.isBetterDistance(currentNodeId, neighborNodeId, minimum, edge) {
 		calculation.updateMetricsAndPrevious(currentNodeId, neighborNodeId, minimum, edge)
 	}
 }
@@ -177,25 +181,15 @@ func (calculation *ShortestPathCalculation) handleCalculation(currentNodeId, nei
 	}
 }
 
-func (calculation *ShortestPathCalculation) getEdgeWeight(edge graph.Edge) float64 {
-	weight := 0.0
-	if len(calculation.weightKeys) == 2 {
-		weight = edge.GetWeight(calculation.weightKeys[0])*float64(helper.TwoFactorWeights[0]) + edge.GetWeight(calculation.weightKeys[1])*float64(helper.TwoFactorWeights[1])
-
-	} else if len(calculation.weightKeys) == 3 {
-		weight = edge.GetWeight(calculation.weightKeys[0])*float64(helper.ThreeFactorWeights[0]) + edge.GetWeight(calculation.weightKeys[1])*float64(helper.ThreeFactorWeights[1]) + edge.GetWeight(calculation.weightKeys[2])*float64(helper.ThreeFactorWeights[2])
-	} else {
-		weight = edge.GetWeight(calculation.weightKeys[0])
-	}
-	return weight
-}
-
 func (calculation *ShortestPathCalculation) relaxEdge(currentNodeId string, edge graph.Edge) {
 	neighborNode := edge.To()
 	neighborNodeId := neighborNode.GetId()
 	if _, ok := calculation.visitedNodes[neighborNodeId]; ok {
 		return
 	}
+	if calculation.isExcluded(edge) {
+		return
+	}
 	edgeWeight := calculation.getEdgeWeight(edge)
 	calculation.handleCalculation(currentNodeId, neighborNodeId, edgeWeight, edge)
 }
@@ -209,7 +203,7 @@ func (calculation *ShortestPathCalculation) performDijkstra() {
 			break
 		}
 		currentNode := calculation.graph.GetNode(currentNodeId)
-		for _, edge := range currentNode.GetEdges() {
+		for _, edge := range getSortedEdges(currentNode) {
 			calculation.relaxEdge(currentNodeId, edge)
 		}
 	}
@@ -249,3 +243,125 @@ func (calculation ShortestPathCalculation) reconstructPath() (graph.Path, error)
 	calculation.log.Debugf("Available bandwidth %g, bottleneck edge form %s to %s", bottleneckBandwidth, bottleneckEdge.From().GetName(), bottleneckEdge.To().GetName())
 	return graph.NewShortestPath(path, totalCost, latency, jitter, packetLoss, bottleneckBandwidth, bottleneckEdge), nil
 }
+
+func (calculation *ShortestPathCalculation) hasWaypoints() bool {
+	constraints := calculation.topologyConstraints
+	return constraints != nil && (len(constraints.includedNodes) > 0 || len(constraints.includedEdges) > 0)
+}
+
+// an edge is excluded if the link itself or the node it leads to is excluded
+func (calculation *BaseCalculation) isExcluded(edge graph.Edge) bool {
+	if calculation.topologyConstraints == nil {
+		return false
+	}
+	if _, ok := calculation.topologyConstraints.excludedEdges[edge.GetId()]; ok {
+		return true
+	}
+	_, ok := calculation.topologyConstraints.excludedNodes[edge.To().GetId()]
+	return ok
+}
+
+// included nodes and links are passed in order, the destination is always the last waypoint
+func (calculation *BaseCalculation) getWaypoints() ([]waypoint, error) {
+	waypoints := make([]waypoint, 0)
+	if calculation.topologyConstraints == nil {
+		return append(waypoints, waypoint{node: calculation.destination}), nil
+	}
+	for _, nodeId := range calculation.topologyConstraints.includedNodes {
+		node := calculation.graph.GetNode(nodeId)
+		if node == nil {
+			return nil, fmt.Errorf("Included node %s is not part of the graph", nodeId)
+		}
+		waypoints = append(waypoints, waypoint{node: node})
+	}
+	for _, edgeId := range calculation.topologyConstraints.includedEdges {
+		edge := calculation.graph.GetEdge(edgeId)
+		if edge == nil {
+			return nil, fmt.Errorf("Included link %s is not part of the graph", edgeId)
+		}
+		waypoints = append(waypoints, waypoint{node: edge.From()}, waypoint{node: edge.To(), edge: edge})
+	}
+	return append(waypoints, waypoint{node: calculation.destination}), nil
+}
+
+// the waypoint index counts the waypoints the path has already passed in order, an included link is only passed by traversing it
+func getWaypointIndex(waypoints []waypoint, waypointIndex int, nodeId string, edge graph.Edge) int {
+	if waypointIndex < len(waypoints) && waypoints[waypointIndex].edge != nil {
+		if edge == nil || waypoints[waypointIndex].edge.GetId() != edge.GetId() {
+			return waypointIndex
+		}
+		waypointIndex++
+	}
+	for waypointIndex < len(waypoints) && waypoints[waypointIndex].edge == nil && waypoints[waypointIndex].node.GetId() == nodeId {
+		waypointIndex++
+	}
+	return waypointIndex
+}
+
+func (calculation *ShortestPathCalculation) calculateSegment(from graph.Node, to waypoint, previousPath graph.Path, hopCount int) (graph.Path, error) {
+	segmentConstraints := &TopologyConstraints{excludedNodes: calculation.topologyConstraints.excludedNodes, excludedEdges: calculation.topologyConstraints.excludedEdges}
+	calculationOptions := &CalculationOptions{calculation.graph, from, to.node, calculation.weightKeys, calculation.calculationMode, calculation.maxConstraints, calculation.minConstraints, calculation.weights, segmentConstraints}
+	segmentCalculation := NewShortestPathCalculation(calculationOptions)
+	segmentCalculation.incumbentEdges = calculation.incumbentEdges
+	if to.edge != nil {
+		for _, edge := range from.GetEdges() {
+			if edge.GetId() != to.edge.GetId() {
+				segmentCalculation.ExcludeEdge(edge.GetId())
+			}
+		}
+	}
+	if previousPath != nil {
+		segmentCalculation.SetInitialSourceNodeMetrics(previousPath.GetTotalCost(), previousPath.GetTotalDelay(), previousPath.GetTotalJitter(), previousPath.GetTotalPacketLoss())
+	}
+	segmentCalculation.SetInitialSourceNodeHopCount(hopCount)
+	return segmentCalculation.Execute()
+}
+
+func (calculation *ShortestPathCalculation) createPathFromSegments(segments []graph.Path) graph.Path {
+	edges := make([]graph.Edge, 0)
+	var bottleneckEdge graph.Edge
+	bottleneckValue := math.Inf(1)
+	totalCost := segments[len(segments)-1].GetTotalCost()
+	for _, segment := range segments {
+		edges = append(edges, segment.GetEdges()...)
+		if segment.GetBottleneckValue() < bottleneckValue {
+			bottleneckEdge = segment.GetBottleneckEdge()
+			bottleneckValue = segment.GetBottleneckValue()
+		}
+		if calculation.calculationMode != CalculationModeSum {
+			totalCost = math.Min(totalCost, segment.GetTotalCost())
+		}
+	}
+	lastSegment := segments[len(segments)-1]
+	return graph.NewShortestPath(edges, totalCost, lastSegment.GetTotalDelay(), lastSegment.GetTotalJitter(), lastSegment.GetTotalPacketLoss(), bottleneckValue, bottleneckEdge)
+}
+
+func (calculation *ShortestPathCalculation) executeWithWaypoints() (graph.Path, error) {
+	waypoints, err := calculation.getWaypoints()
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]graph.Path, 0, len(waypoints))
+	current := calculation.source
+	var previousPath graph.Path
+	hopCount := 0
+	for _, waypoint := range waypoints {
+		if waypoint.node.GetId() == current.GetId() && waypoint.edge == nil {
+			continue
+		}
+		calculation.log.Debugf("Calculating path segment from node %s to waypoint %s", current.GetName(), waypoint.node.GetName())
+		path, err := calculation.calculateSegment(current, waypoint, previousPath, hopCount)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, path)
+		hopCount += len(path.GetEdges())
+		current = waypoint.node
+		previousPath = path
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("No path found from node %s to node %s", calculation.source.GetId(), calculation.destination.GetId())
+	}
+	calculation.log.Debugln("Calculation finished - shortest path through all included elements found")
+	return calculation.createPathFromSegments(segments), nil
+}
@@ -160,7 +160,7 @@ func TestShortestPathCalculation_Execute_SingleIntentSum(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error setting up graph")
 			}
-			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints}
+			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints, nil, nil}
 			calculation := NewShortestPathCalculation(calculationOptions)
 			got, err := calculation.Execute()
 			if (err != nil) != tt.wantErr {
@@ -267,7 +267,7 @@ func TestShortestPathCalculation_Execute_SingleIntentMax(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error setting up graph")
 			}
-			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints}
+			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints, nil, nil}
 			calculation := NewShortestPathCalculation(calculationOptions)
 			got, err := calculation.Execute()
 			if (err != nil) != tt.wantErr {
@@ -375,7 +375,7 @@ func TestShortestPathCalculation_Execute_SingleIntentMin(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error setting up graph")
 			}
-			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints}
+			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints, nil, nil}
 			calculation := NewShortestPathCalculation(calculationOptions)
 			got, err := calculation.Execute()
 			if (err != nil) != tt.wantErr {
@@ -574,7 +574,7 @@ func TestShortestPathCalculation_Execute_MultipleIntents(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error setting up graph")
 			}
-			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints}
+			calculationOptions := &CalculationOptions{networkGraph, tt.args.from, tt.args.to, tt.args.weightTypes, tt.args.calculationType, tt.args.maxConstraints, tt.args.minConstraints, nil, nil}
 			calculation := NewShortestPathCalculation(calculationOptions)
 			got, err := calculation.Execute()
 			if tt.wantErr {
@@ -669,3 +669,364 @@ func generatePlantUMLDiagram(nodes map[int]graph.Node, shortestPath []graph.Edge
 
 	return builder.String()
 }
+
+func TestShortestPathCalculation_Execute_Exclusions(t *testing.T) {
+	nodes, edges := setupKShortestPathTestElements()
+	tests := []struct {
+		name          string
+		excludedNodes []string
+		excludedEdges []string
+		wantEdgeIds   []string
+	}{
+		{
+			name:        "Test shortest path without exclusions",
+			wantEdgeIds: []string{"3", "7", "10", "9"},
+		},
+		{
+			name:          "Test shortest path with excluded node",
+			excludedNodes: []string{"7"},
+			wantEdgeIds:   []string{"2", "6", "9"},
+		},
+		{
+			name:          "Test shortest path with excluded edge",
+			excludedEdges: []string{"9"},
+			wantEdgeIds:   []string{"3", "7", "11"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[8], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, nil}
+			calculation := NewShortestPathCalculation(calculationOptions)
+			for _, nodeId := range tt.excludedNodes {
+				calculation.ExcludeNode(nodeId)
+			}
+			for _, edgeId := range tt.excludedEdges {
+				calculation.ExcludeEdge(edgeId)
+			}
+			got, err := calculation.Execute()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+		})
+	}
+}
+
+func TestShortestPathCalculation_Execute_TopologyConstraints(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	tests := []struct {
+		name            string
+		weightKey       helper.WeightKey
+		calculationMode CalculationMode
+		excludedNodes   []string
+		excludedEdges   []string
+		includedNodes   []string
+		includedEdges   []string
+		wantEdgeIds     []string
+		wantCost        float64
+		wantErr         bool
+	}{
+		{
+			name:            "Test topology constraints without elements",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			wantEdgeIds:     []string{"1", "2"},
+			wantCost:        2000,
+		},
+		{
+			name:            "Test topology constraints excluded node",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			excludedNodes:   []string{"2"},
+			wantEdgeIds:     []string{"5"},
+			wantCost:        3000,
+		},
+		{
+			name:            "Test topology constraints excluded node and link",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			excludedNodes:   []string{"2"},
+			excludedEdges:   []string{"5"},
+			wantEdgeIds:     []string{"3", "4"},
+			wantCost:        6000,
+		},
+		{
+			name:            "Test topology constraints included node",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			includedNodes:   []string{"3"},
+			wantEdgeIds:     []string{"3", "4"},
+			wantCost:        6000,
+		},
+		{
+			name:            "Test topology constraints included link",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			includedEdges:   []string{"6"},
+			wantEdgeIds:     []string{"1", "6", "4"},
+			wantCost:        9000,
+		},
+		{
+			name:            "Test topology constraints included node with excluded link",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			excludedEdges:   []string{"3"},
+			includedNodes:   []string{"3"},
+			wantEdgeIds:     []string{"1", "6", "4"},
+			wantCost:        9000,
+		},
+		{
+			name:            "Test topology constraints included destination node",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			includedNodes:   []string{"4"},
+			wantEdgeIds:     []string{"1", "2"},
+			wantCost:        2000,
+		},
+		{
+			name:            "Test topology constraints unreachable included node",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			excludedEdges:   []string{"3", "6"},
+			includedNodes:   []string{"3"},
+			wantErr:         true,
+		},
+		{
+			name:            "Test topology constraints unknown included link",
+			weightKey:       helper.LatencyKey,
+			calculationMode: CalculationModeSum,
+			includedEdges:   []string{"99"},
+			wantErr:         true,
+		},
+		{
+			name:            "Test topology constraints included node high bandwidth",
+			weightKey:       helper.AvailableBandwidthKey,
+			calculationMode: CalculationModeMax,
+			includedNodes:   []string{"2"},
+			wantEdgeIds:     []string{"1", "6", "4"},
+			wantCost:        100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			topologyConstraints := NewTopologyConstraints()
+			for _, nodeId := range tt.excludedNodes {
+				topologyConstraints.excludedNodes[nodeId] = struct{}{}
+			}
+			for _, edgeId := range tt.excludedEdges {
+				topologyConstraints.excludedEdges[edgeId] = struct{}{}
+			}
+			topologyConstraints.includedNodes = append(topologyConstraints.includedNodes, tt.includedNodes...)
+			topologyConstraints.includedEdges = append(topologyConstraints.includedEdges, tt.includedEdges...)
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{tt.weightKey}, tt.calculationMode, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, topologyConstraints}
+			got, err := NewShortestPathCalculation(calculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+			assert.InDelta(t, tt.wantCost, got.GetTotalCost(), tolerance)
+		})
+	}
+}
+
+func TestShortestPathCalculation_Execute_HopCountConstraints(t *testing.T) {
+	nodes, edges := setupParetoPathTestElements()
+	tests := []struct {
+		name           string
+		maxConstraints map[helper.WeightKey]float64
+		includedEdges  []string
+		wantEdgeIds    []string
+		wantErr        bool
+	}{
+		{
+			name:           "Test hop count constraint satisfied by shortest path",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 2},
+			wantEdgeIds:    []string{"1", "2"},
+		},
+		{
+			name:           "Test hop count constraint requires longer path",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 1},
+			wantEdgeIds:    []string{"5"},
+		},
+		{
+			name:           "Test SID depth constraint requires longer path",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 3, helper.SidDepthKey: 1},
+			wantEdgeIds:    []string{"5"},
+		},
+		{
+			name:           "Test hop count constraint not satisfiable",
+			maxConstraints: map[helper.WeightKey]float64{helper.SidDepthKey: 0},
+			wantErr:        true,
+		},
+		{
+			name:           "Test hop count constraint counts hops of all segments",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 3},
+			includedEdges:  []string{"6"},
+			wantEdgeIds:    []string{"1", "6", "4"},
+		},
+		{
+			name:           "Test hop count constraint not satisfiable with included link",
+			maxConstraints: map[helper.WeightKey]float64{helper.HopCountKey: 2},
+			includedEdges:  []string{"6"},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			var topologyConstraints *TopologyConstraints
+			if len(tt.includedEdges) > 0 {
+				topologyConstraints = NewTopologyConstraints()
+				topologyConstraints.includedEdges = append(topologyConstraints.includedEdges, tt.includedEdges...)
+			}
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, tt.maxConstraints, map[helper.WeightKey]float64{}, nil, topologyConstraints}
+			got, err := NewShortestPathCalculation(calculationOptions).Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEdgeIds, getEdgeIds(got))
+		})
+	}
+}
+
+func TestShortestPathCalculation_Execute_TieBreaking(t *testing.T) {
+	srAlgorithm := []uint32{0}
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", srAlgorithm),
+		2: graph.NewNetworkNode("2", "2", srAlgorithm),
+		4: graph.NewNetworkNode("4", "4", srAlgorithm),
+		5: graph.NewNetworkNode("5", "5", srAlgorithm),
+		7: graph.NewNetworkNode("7", "7", srAlgorithm),
+	}
+	//  [1]-500-[2]-500-[5]
+	//   |               |
+	//  1000           1000
+	//   |               |
+	//  [7]----1000-----[4]
+	edges := map[int]graph.Edge{
+		12: graph.NewNetworkEdge("12", nodes[1], nodes[2], map[helper.WeightKey]float64{helper.LatencyKey: 500}),
+		25: graph.NewNetworkEdge("25", nodes[2], nodes[5], map[helper.WeightKey]float64{helper.LatencyKey: 500}),
+		54: graph.NewNetworkEdge("54", nodes[5], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 1000}),
+		17: graph.NewNetworkEdge("17", nodes[1], nodes[7], map[helper.WeightKey]float64{helper.LatencyKey: 1000}),
+		74: graph.NewNetworkEdge("74", nodes[7], nodes[4], map[helper.WeightKey]float64{helper.LatencyKey: 1000}),
+	}
+	tests := []struct {
+		name              string
+		tieBreakingPolicy TieBreakingPolicy
+		incumbentEdges    []graph.Edge
+		want              []string
+	}{
+		{
+			name:              "Test tie-breaking with lowest router ID",
+			tieBreakingPolicy: TieBreakingLowestRouterId,
+			want:              []string{"12", "25", "54"},
+		},
+		{
+			name:              "Test tie-breaking with fewest hops",
+			tieBreakingPolicy: TieBreakingFewestHops,
+			want:              []string{"17", "74"},
+		},
+		{
+			name:              "Test tie-breaking with incumbent path",
+			tieBreakingPolicy: TieBreakingIncumbent,
+			incumbentEdges:    []graph.Edge{edges[17], edges[74]},
+			want:              []string{"17", "74"},
+		},
+		{
+			name:              "Test tie-breaking with incumbent path falling back to lowest router ID",
+			tieBreakingPolicy: TieBreakingIncumbent,
+			want:              []string{"12", "25", "54"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkGraph, err := setupGraph(nodes, edges)
+			assert.NoError(t, err)
+			calculationOptions := &CalculationOptions{networkGraph, nodes[1], nodes[4], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, nil}
+			for iteration := 0; iteration < 10; iteration++ {
+				calculation := NewShortestPathCalculation(calculationOptions)
+				calculation.tieBreakingPolicy = tt.tieBreakingPolicy
+				if tt.incumbentEdges != nil {
+					calculation.setIncumbentPath(graph.NewShortestPath(tt.incumbentEdges, 2000, 2000, 0, 0, 0, nil))
+				}
+				path, err := calculation.Execute()
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, getEdgeIds(path))
+			}
+		})
+	}
+}
+
+func TestBaseCalculation_isExcluded(t *testing.T) {
+	nodes := map[int]graph.Node{
+		1: graph.NewNetworkNode("1", "1", []uint32{0}),
+		2: graph.NewNetworkNode("2", "2", []uint32{0}),
+	}
+	edge := graph.NewNetworkEdge("1", nodes[1], nodes[2], map[helper.WeightKey]float64{})
+	tests := []struct {
+		name                string
+		withoutConstraints  bool
+		excludedNodes       []string
+		excludedEdges       []string
+		calculationExcluded bool
+		wantExcluded        bool
+	}{
+		{
+			name:               "Test isExcluded without topology constraints",
+			withoutConstraints: true,
+			wantExcluded:       false,
+		},
+		{
+			name:          "Test isExcluded with excluded link",
+			excludedEdges: []string{"1"},
+			wantExcluded:  true,
+		},
+		{
+			name:          "Test isExcluded with excluded target node",
+			excludedNodes: []string{"2"},
+			wantExcluded:  true,
+		},
+		{
+			name:          "Test isExcluded with excluded source node",
+			excludedNodes: []string{"1"},
+			wantExcluded:  false,
+		},
+		{
+			name:                "Test isExcluded with link excluded by the calculation",
+			calculationExcluded: true,
+			wantExcluded:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var topologyConstraints *TopologyConstraints
+			if !tt.withoutConstraints {
+				topologyConstraints = NewTopologyConstraints()
+				for _, nodeId := range tt.excludedNodes {
+					topologyConstraints.excludedNodes[nodeId] = struct{}{}
+				}
+				for _, edgeId := range tt.excludedEdges {
+					topologyConstraints.excludedEdges[edgeId] = struct{}{}
+				}
+			}
+			calculationOptions := &CalculationOptions{graph.NewNetworkGraph(), nodes[1], nodes[2], []helper.WeightKey{helper.LatencyKey}, CalculationModeSum, map[helper.WeightKey]float64{}, map[helper.WeightKey]float64{}, nil, topologyConstraints}
+			assert.Equal(t, tt.wantExcluded && !tt.calculationExcluded, NewBaseCalculation(calculationOptions).isExcluded(edge))
+			calculation := NewShortestPathCalculation(calculationOptions)
+			if tt.calculationExcluded {
+				calculation.ExcludeEdge("1")
+				// links excluded by one calculation do not apply to other calculations of the same path request
+				assert.False(t, NewBaseCalculation(calculationOptions).isExcluded(edge))
+			}
+			assert.Equal(t, tt.wantExcluded, calculation.isExcluded(edge))
+		})
+	}
+}
@@ -1,57 +1,147 @@
 package controller
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/hawkv6/hawkeye/pkg/calculation"
 	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/hawkv6/hawkeye/pkg/logging"
 	"github.com/hawkv6/hawkeye/pkg/messaging"
 	"github.com/sirupsen/logrus"
 )
 
 type SessionController struct {
-	log             *logrus.Entry
-	manager         calculation.Manager
-	openSessions    map[string]domain.StreamSession
-	pathRequestChan chan domain.PathRequest
-	pathResultChan  chan domain.PathResult
-	errorChan       chan error
-	mu              sync.Mutex
-	updateChan      chan struct{}
-	quitChan        chan struct{}
+	log              *logrus.Entry
+	manager          calculation.Manager
+	openSessions     map[string]domain.StreamSession
+	pendingRequests  []domain.PathRequest
+	pathRequestChan  chan domain.PathRequest
+	pathResultChan   chan domain.PathResult
+	errorChan        chan error
+	mu               sync.Mutex
+	updateChan       chan struct{}
+	admissionChan    chan struct{}
+	optimizationChan chan bool
+	quitChan         chan struct{}
+	// sessions closed while a global optimization is calculated release their resources once the optimization has restored them
+	optimizing     bool
+	closedSessions []domain.StreamSession
 }
 
 func NewSessionController(manager calculation.Manager, messagingChannels messaging.MessagingChannels, updateChan chan struct{}) *SessionController {
 	return &SessionController{
-		log:             logging.DefaultLogger.WithField("subsystem", Subsystem),
-		manager:         manager,
-		openSessions:    make(map[string]domain.StreamSession, 0),
-		pathRequestChan: messagingChannels.GetPathRequestChan(),
-		pathResultChan:  messagingChannels.GetPathResponseChan(),
-		errorChan:       messagingChannels.GetErrorChan(),
-		mu:              sync.Mutex{},
-		updateChan:      updateChan,
-		quitChan:        make(chan struct{}),
+		log:              logging.DefaultLogger.WithField("subsystem", Subsystem),
+		manager:          manager,
+		openSessions:     make(map[string]domain.StreamSession, 0),
+		pendingRequests:  make([]domain.PathRequest, 0),
+		pathRequestChan:  messagingChannels.GetPathRequestChan(),
+		pathResultChan:   messagingChannels.GetPathResponseChan(),
+		errorChan:        messagingChannels.GetErrorChan(),
+		mu:               sync.Mutex{},
+		updateChan:       updateChan,
+		admissionChan:    make(chan struct{}, 1),
+		optimizationChan: make(chan bool),
+		quitChan:         make(chan struct{}),
 	}
 }
 
-func (controller *SessionController) watchForContextCancellation(pathRequest domain.PathRequest, serializedPathRequest string) {
+// equal path requests of different streams share one session, every stream is a subscriber of the session. A bandwidth
+// demand is admitted and reserved once per session, so path requests with a demand only share sessions within their stream
+func getSessionKey(pathRequest domain.PathRequest) string {
+	if pathRequest.GetBandwidthDemand() > 0 {
+		return fmt.Sprintf("%s,Stream:%p", pathRequest.Serialize(), pathRequest.GetStream())
+	}
+	return pathRequest.Serialize()
+}
+
+// the result of a shared session is addressed to the stream and the path request of the subscriber
+func getSubscriberPathResult(pathResult domain.PathResult, subscriber domain.PathRequest) domain.PathResult {
+	if pathResult.GetStream() == subscriber.GetStream() && pathResult.GetContext() == subscriber.GetContext() {
+		return pathResult
+	}
+	return domain.NewDomainSubscriberPathResult(pathResult, subscriber)
+}
+
+// the session identifier is assigned to the path request so that it is echoed in every path result of the session
+func newSession(pathRequest domain.PathRequest, pathResult domain.PathResult) domain.StreamSession {
+	session := domain.NewDomainStreamSession(pathRequest, pathResult)
+	pathRequest.SetSessionId(session.GetSessionId())
+	return session
+}
+
+// the session is only closed when the last subscribed stream is closed. The session stored under the key is only
+// touched if the path request subscribes to it, it may have been displaced and replaced by a session of an equal path request
+func (controller *SessionController) watchForContextCancellation(pathRequest domain.PathRequest, sessionKey string) {
 	<-pathRequest.GetContext().Done()
 	controller.mu.Lock()
 	defer controller.mu.Unlock()
-	controller.log.Debugf("Context of path request %s has been cancelled", serializedPathRequest)
-	delete(controller.openSessions, serializedPathRequest)
+	controller.log.Debugf("Context of path request %s has been cancelled", pathRequest.Serialize())
+	session, ok := controller.openSessions[sessionKey]
+	if !ok || !slices.Contains(session.GetSubscribers(), pathRequest) {
+		controller.log.Debugf("Session of path request %s has already been closed", pathRequest.Serialize())
+		return
+	}
+	if subscriberCount := session.RemoveSubscriber(pathRequest); subscriberCount > 0 {
+		controller.log.Debugf("Session %s is kept for %d remaining subscribers", pathRequest.Serialize(), subscriberCount)
+		return
+	}
+	controller.releaseSessionResources(session)
+	delete(controller.openSessions, sessionKey)
+}
+
+func (controller *SessionController) releaseSessionResources(session domain.StreamSession) {
+	if controller.optimizing {
+		controller.closedSessions = append(controller.closedSessions, session)
+		return
+	}
+	controller.manager.RemoveServiceSessions(session.GetPathResult())
+	controller.manager.ReleaseBandwidth(session.GetPathResult())
+	if session.GetPathRequest().GetBandwidthDemand() > 0 {
+		controller.signalAdmission()
+	}
+}
+
+// released bandwidth might admit queued path requests, the main loop is only signaled once for several releases
+func (controller *SessionController) signalAdmission() {
+	select {
+	case controller.admissionChan <- struct{}{}:
+	default:
+	}
+}
+
+func (controller *SessionController) getSubscribers(session domain.StreamSession) []domain.PathRequest {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	return session.GetSubscribers()
+}
+
+// results of a shared session fan out to all subscribed streams
+func (controller *SessionController) sendPathResult(session domain.StreamSession, pathResult domain.PathResult) {
+	for _, subscriber := range controller.getSubscribers(session) {
+		controller.pathResultChan <- getSubscriberPathResult(pathResult, subscriber)
+	}
+}
+
+func (controller *SessionController) sendSessionError(session domain.StreamSession, err error) {
+	for _, subscriber := range controller.getSubscribers(session) {
+		controller.errorChan <- domain.NewSessionError(subscriber, err)
+	}
 }
 
 func (controller *SessionController) recalculatePathUpdate(session domain.StreamSession) {
 	result, err := controller.manager.CalculatePathUpdate(session)
 	if err != nil {
 		controller.log.Errorln("Failed to recalculate path update: ", err)
-		controller.errorChan <- err
+		controller.sendSessionError(session, err)
 	} else if result != nil {
-		controller.pathResultChan <- result
+		result.SetUpdateReason(domain.UpdateReasonNetworkChange)
+		controller.sendPathResult(session, result)
 	} else {
 		controller.log.Debugln("No path update available")
 	}
@@ -74,8 +164,19 @@ func (controller *SessionController) recalculateSessions() {
 	}
 
 	controller.log.Debugln("Pending updates trigger recalculations of all open sessions")
+	sessionSnapshot := controller.getSessionSnapshot()
+	for priority := domain.HighestPriority; priority <= domain.LowestPriority; priority++ {
+		controller.recalculateSessionsWithPriority(sessionSnapshot, priority)
+	}
+}
+
+// sessions are recalculated in order of their hold priority, so higher priority sessions get the resources first
+func (controller *SessionController) recalculateSessionsWithPriority(sessionSnapshot map[string]domain.StreamSession, priority uint32) {
 	wg := sync.WaitGroup{}
-	for sessionKey, session := range controller.getSessionSnapshot() {
+	for sessionKey, session := range sessionSnapshot {
+		if session.GetPathRequest().GetHoldPriority() != priority {
+			continue
+		}
 		controller.log.Debugln("Recalculating for session: ", sessionKey)
 		wg.Add(1)
 		go func(sessionKey string, session domain.StreamSession) {
@@ -87,63 +188,352 @@ func (controller *SessionController) recalculateSessions() {
 	wg.Wait()
 }
 
-func (controller *SessionController) sessionExists(sessionSnapshot map[string]domain.StreamSession, pathRequest domain.PathRequest) bool {
-	serializedPathRequest := pathRequest.Serialize()
-	_, ok := sessionSnapshot[serializedPathRequest]
-	return ok
+// a path request equal to an open session subscribes its stream to the session and receives the current path result,
+// false if there is no such session
+func (controller *SessionController) subscribeToSession(sessionKey string, pathRequest domain.PathRequest) bool {
+	controller.mu.Lock()
+	session, ok := controller.openSessions[sessionKey]
+	if !ok {
+		controller.mu.Unlock()
+		return false
+	}
+	subscribed := session.AddSubscriber(pathRequest)
+	pathRequest.SetSessionId(session.GetSessionId())
+	subscriberCount := len(session.GetSubscribers())
+	pathResult := session.GetPathResult()
+	controller.mu.Unlock()
+
+	if subscribed {
+		controller.log.Debugf("Stream subscribed to existing session %s with %d subscribers", pathRequest.Serialize(), subscriberCount)
+		go controller.watchForContextCancellation(pathRequest, sessionKey)
+	} else {
+		controller.log.Debugln("Path request already exists - returning existing path result for path request: ", pathRequest.Serialize())
+	}
+	controller.pathResultChan <- getSubscriberPathResult(pathResult, pathRequest)
+	return true
 }
 
-func (controller *SessionController) sendExistingPathResult(sessionSnapshot map[string]domain.StreamSession, pathRequest domain.PathRequest) {
-	serializedPathRequest := pathRequest.Serialize()
-	controller.log.Debugln("Path request already exists - returning existing path result for path request: ", serializedPathRequest)
-	controller.pathResultChan <- sessionSnapshot[serializedPathRequest].GetPathResult()
+// subscribers which disconnected while the session was preempted are removed, the remaining number of subscribers is returned
+func (controller *SessionController) removeClosedSubscribers(session domain.StreamSession) int {
+	subscriberCount := len(session.GetSubscribers())
+	for _, subscriber := range session.GetSubscribers() {
+		if subscriber.GetContext().Err() != nil {
+			subscriberCount = session.RemoveSubscriber(subscriber)
+		}
+	}
+	return subscriberCount
 }
 
-func (controller *SessionController) calculateAndCreateSession(serializedRequest string, pathRequest domain.PathRequest) (domain.PathResult, error) {
+func (controller *SessionController) calculateAndCreateSession(sessionKey string, pathRequest domain.PathRequest) (domain.PathResult, error) {
 	pathResult, err := controller.manager.CalculateBestPath(pathRequest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate path result: %w", err)
 	}
 
 	controller.mu.Lock()
-	controller.openSessions[serializedRequest] = domain.NewDomainStreamSession(pathRequest, pathResult)
+	controller.openSessions[sessionKey] = newSession(pathRequest, pathResult)
 	controller.mu.Unlock()
+	controller.manager.AddServiceSessions(pathResult)
+	controller.manager.ReserveBandwidth(pathResult)
 
 	return pathResult, nil
 }
 
-func (controller *SessionController) handleError(err error) {
+// sessions holding their resources with a lower priority than the setup priority of the path request can be preempted,
+// the sessions with the lowest hold priority and the largest bandwidth demand are preempted first
+func (controller *SessionController) getPreemptableSessions(pathRequest domain.PathRequest) []domain.StreamSession {
+	preemptableSessions := make([]domain.StreamSession, 0)
+	for _, session := range controller.openSessions {
+		if session.GetPathRequest().GetHoldPriority() > pathRequest.GetSetupPriority() {
+			preemptableSessions = append(preemptableSessions, session)
+		}
+	}
+	slices.SortFunc(preemptableSessions, func(first, second domain.StreamSession) int {
+		firstRequest, secondRequest := first.GetPathRequest(), second.GetPathRequest()
+		if order := cmp.Compare(secondRequest.GetHoldPriority(), firstRequest.GetHoldPriority()); order != 0 {
+			return order
+		}
+		if order := cmp.Compare(secondRequest.GetBandwidthDemand(), firstRequest.GetBandwidthDemand()); order != 0 {
+			return order
+		}
+		return cmp.Compare(firstRequest.Serialize(), secondRequest.Serialize())
+	})
+	return preemptableSessions
+}
+
+// the resources of preemptable sessions are released one after the other until the path request can be placed,
+// if it can not be placed at all the resources of the sessions are reserved again
+func (controller *SessionController) preemptSessions(sessionKey string, pathRequest domain.PathRequest) (domain.PathResult, []domain.StreamSession) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	preemptedSessions := make([]domain.StreamSession, 0)
+	for _, session := range controller.getPreemptableSessions(pathRequest) {
+		controller.manager.RemoveServiceSessions(session.GetPathResult())
+		controller.manager.ReleaseBandwidth(session.GetPathResult())
+		preemptedSessions = append(preemptedSessions, session)
+		pathResult, err := controller.manager.CalculateBestPath(pathRequest)
+		if err != nil {
+			continue
+		}
+		for _, preemptedSession := range preemptedSessions {
+			delete(controller.openSessions, getSessionKey(preemptedSession.GetPathRequest()))
+		}
+		controller.openSessions[sessionKey] = newSession(pathRequest, pathResult)
+		controller.manager.AddServiceSessions(pathResult)
+		controller.manager.ReserveBandwidth(pathResult)
+		return pathResult, preemptedSessions
+	}
+	for _, preemptedSession := range preemptedSessions {
+		controller.manager.AddServiceSessions(preemptedSession.GetPathResult())
+		controller.manager.ReserveBandwidth(preemptedSession.GetPathResult())
+	}
+	return nil, nil
+}
+
+// preempted sessions are rerouted without preempting further sessions, sessions without a remaining path are displaced
+func (controller *SessionController) reroutePreemptedSessions(preemptedSessions []domain.StreamSession) {
+	for _, session := range preemptedSessions {
+		pathRequest := session.GetPathRequest()
+		serializedPathRequest := pathRequest.Serialize()
+		pathResult, err := controller.manager.CalculateBestPath(pathRequest)
+		if err != nil {
+			controller.log.Infof("Preempted session %s has been displaced, no path remains: %v", serializedPathRequest, err)
+			controller.sendPathResult(session, domain.NewDomainDisplacedPathResult(pathRequest))
+			continue
+		}
+		controller.mu.Lock()
+		if controller.removeClosedSubscribers(session) == 0 {
+			controller.mu.Unlock()
+			continue
+		}
+		currentPathResult := session.GetPathResult()
+		pathResult.SetUpdateReason(domain.UpdateReasonPreemption)
+		session.SetPathResult(pathResult, domain.UpdateReasonPreemption)
+		controller.openSessions[getSessionKey(pathRequest)] = session
+		controller.manager.AddServiceSessions(pathResult)
+		controller.manager.ReserveBandwidth(pathResult)
+		controller.mu.Unlock()
+		if !slices.Equal(currentPathResult.GetIpv6SidAddresses(), pathResult.GetIpv6SidAddresses()) {
+			controller.log.Infof("Preempted session %s has been rerouted", serializedPathRequest)
+			controller.sendPathResult(session, pathResult)
+		}
+	}
+}
+
+// a path request which can not be placed besides the active sessions for lack of bandwidth may preempt sessions with
+// a lower hold priority, any other calculation error can not be resolved by preemption
+func (controller *SessionController) admitSession(sessionKey string, pathRequest domain.PathRequest) (domain.PathResult, error) {
+	pathResult, err := controller.calculateAndCreateSession(sessionKey, pathRequest)
+	if err == nil {
+		return pathResult, nil
+	}
+	if !errors.Is(err, calculation.ErrInsufficientCapacity) {
+		return nil, err
+	}
+	pathResult, preemptedSessions := controller.preemptSessions(sessionKey, pathRequest)
+	if pathResult == nil {
+		return nil, err
+	}
+	controller.log.Infof("Path request %s has preempted %d sessions", pathRequest.Serialize(), len(preemptedSessions))
+	controller.reroutePreemptedSessions(preemptedSessions)
+	return pathResult, nil
+}
+
+// only path requests with a bandwidth demand are queued, each stream gets its own session once it is admitted
+func (controller *SessionController) isPending(pathRequest domain.PathRequest) bool {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	sessionKey := getSessionKey(pathRequest)
+	for _, pendingRequest := range controller.pendingRequests {
+		if getSessionKey(pendingRequest) == sessionKey && pendingRequest.GetStream() == pathRequest.GetStream() {
+			return true
+		}
+	}
+	return false
+}
+
+// a path request with a bandwidth demand which can not be admitted besides the reservations of the active sessions
+// is either rejected or queued until bandwidth is released or the network changes
+func (controller *SessionController) handleUnadmittedRequest(pathRequest domain.PathRequest, err error) {
+	if !helper.QueueUnadmittedRequests {
+		controller.handleError(pathRequest, fmt.Errorf("bandwidth demand of %d kbit/s can not be admitted: %w", pathRequest.GetBandwidthDemand(), err))
+		return
+	}
+	controller.log.Infof("Queueing path request %s until its bandwidth demand of %d kbit/s can be admitted", pathRequest.Serialize(), pathRequest.GetBandwidthDemand())
+	controller.mu.Lock()
+	controller.pendingRequests = append(controller.pendingRequests, pathRequest)
+	controller.mu.Unlock()
+}
+
+// queued path requests are admitted in the order they arrived, requests which still do not fit stay queued
+func (controller *SessionController) admitPendingRequests() {
+	controller.mu.Lock()
+	pendingRequests := controller.pendingRequests
+	controller.pendingRequests = make([]domain.PathRequest, 0)
+	controller.mu.Unlock()
+
+	for _, pathRequest := range pendingRequests {
+		serializedPathRequest := pathRequest.Serialize()
+		if pathRequest.GetContext().Err() != nil {
+			controller.log.Debugf("Context of queued path request %s has been cancelled", serializedPathRequest)
+			continue
+		}
+		sessionKey := getSessionKey(pathRequest)
+		if controller.subscribeToSession(sessionKey, pathRequest) {
+			continue
+		}
+		pathResult, err := controller.admitSession(sessionKey, pathRequest)
+		if err != nil {
+			controller.log.Debugf("Queued path request %s can not be admitted yet: %s", serializedPathRequest, err)
+			controller.mu.Lock()
+			controller.pendingRequests = append(controller.pendingRequests, pathRequest)
+			controller.mu.Unlock()
+			continue
+		}
+		controller.log.Infof("Queued path request %s has been admitted", serializedPathRequest)
+		go controller.watchForContextCancellation(pathRequest, sessionKey)
+		controller.pathResultChan <- pathResult
+	}
+}
+
+// errors are delivered to the stream of the path request they belong to
+func (controller *SessionController) handleError(pathRequest domain.PathRequest, err error) {
 	controller.log.Warnln(err)
-	controller.errorChan <- err
+	controller.errorChan <- domain.NewSessionError(pathRequest, err)
 }
 
 func (controller *SessionController) handlePathRequest(pathRequest domain.PathRequest) {
 	serializedPathRequest := pathRequest.Serialize()
 	controller.log.Debugln("Received path request: ", serializedPathRequest)
-	sessionSnapshot := controller.getSessionSnapshot()
-	if controller.sessionExists(sessionSnapshot, pathRequest) {
-		controller.sendExistingPathResult(sessionSnapshot, pathRequest)
+	sessionKey := getSessionKey(pathRequest)
+	if controller.subscribeToSession(sessionKey, pathRequest) {
+		return
+	}
+	if controller.isPending(pathRequest) {
+		controller.log.Debugln("Path request is already queued: ", serializedPathRequest)
 		return
 	}
 
-	pathResult, err := controller.calculateAndCreateSession(serializedPathRequest, pathRequest)
-	if err != nil {
-		controller.handleError(err)
+	pathResult, err := controller.admitSession(sessionKey, pathRequest)
+	if err != nil && pathRequest.GetBandwidthDemand() > 0 {
+		controller.handleUnadmittedRequest(pathRequest, err)
+		return
+	} else if err != nil {
+		controller.handleError(pathRequest, err)
 		return
 	}
 
-	go controller.watchForContextCancellation(pathRequest, serializedPathRequest)
+	go controller.watchForContextCancellation(pathRequest, sessionKey)
 	controller.pathResultChan <- pathResult
 }
 
+func (controller *SessionController) logOptimizationReport(report *calculation.OptimizationReport, dryRun bool) {
+	action := "Applying"
+	if dryRun {
+		action = "Dry run of"
+	}
+	controller.log.Infof("%s global optimization with %d session moves, maximum link utilization %.1f%% instead of %.1f%%", action, len(report.Moves), report.OptimizedMaxUtilization*100, report.CurrentMaxUtilization*100)
+	for _, move := range report.Moves {
+		controller.log.Infof("Session %s moves from %v to %v", move.Session.GetPathRequest().Serialize(), move.CurrentPathResult.GetIpv6SidAddresses(), move.NewPathResult.GetIpv6SidAddresses())
+	}
+}
+
+func (controller *SessionController) applySessionMove(move calculation.SessionMove) {
+	controller.manager.RemoveServiceSessions(move.CurrentPathResult)
+	controller.manager.ReleaseBandwidth(move.CurrentPathResult)
+	move.NewPathResult.SetUpdateReason(domain.UpdateReasonOptimization)
+	move.Session.SetPathResult(move.NewPathResult, domain.UpdateReasonOptimization)
+	controller.manager.AddServiceSessions(move.NewPathResult)
+	controller.manager.ReserveBandwidth(move.NewPathResult)
+}
+
+func (controller *SessionController) startOptimization() []domain.StreamSession {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	controller.optimizing = true
+	sessions := make([]domain.StreamSession, 0, len(controller.openSessions))
+	for _, session := range controller.openSessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+// the optimization restores the reservations of all sessions it has been started with, sessions closed in the meantime release them now
+func (controller *SessionController) finishOptimization() {
+	controller.optimizing = false
+	for _, session := range controller.closedSessions {
+		controller.releaseSessionResources(session)
+	}
+	controller.closedSessions = nil
+}
+
+// moves of sessions which have been closed during the optimization are dropped
+func (controller *SessionController) getApplicableMoves(moves []calculation.SessionMove) []calculation.SessionMove {
+	applicableMoves := make([]calculation.SessionMove, 0, len(moves))
+	for _, move := range moves {
+		if controller.openSessions[getSessionKey(move.Session.GetPathRequest())] != move.Session {
+			controller.log.Debugf("Session %s has been closed during the optimization, dropping its move", move.Session.GetPathRequest().Serialize())
+			continue
+		}
+		applicableMoves = append(applicableMoves, move)
+	}
+	return applicableMoves
+}
+
+// all open sessions are placed together by the manager without holding the lock, so streams can still be closed
+// during the calculation, the moves are applied to the sessions which are still open afterwards
+func (controller *SessionController) optimizeSessions(dryRun bool) {
+	sessions := controller.startOptimization()
+	report := controller.manager.OptimizeSessions(sessions)
+
+	controller.mu.Lock()
+	controller.finishOptimization()
+	controller.logOptimizationReport(report, dryRun)
+	if dryRun {
+		controller.mu.Unlock()
+		return
+	}
+	moves := controller.getApplicableMoves(report.Moves)
+	for _, move := range moves {
+		controller.applySessionMove(move)
+	}
+	controller.mu.Unlock()
+
+	for _, move := range moves {
+		controller.sendPathResult(move.Session, move.NewPathResult)
+	}
+}
+
+// TriggerOptimization starts a global optimization of all open sessions, with dryRun the proposed moves are only reported
+func (controller *SessionController) TriggerOptimization(dryRun bool) {
+	select {
+	case controller.optimizationChan <- dryRun:
+	case <-controller.quitChan:
+		controller.log.Warnln("Session controller has been stopped, global optimization is not started")
+	}
+}
+
 func (controller *SessionController) Start() {
 	controller.log.Infoln("Starting controller")
+	var optimizationTicker <-chan time.Time
+	if helper.GlobalOptimizationInterval > 0 {
+		ticker := time.NewTicker(helper.GlobalOptimizationInterval)
+		defer ticker.Stop()
+		optimizationTicker = ticker.C
+	}
 	for {
 		select {
 		case <-controller.quitChan:
 			return
+		case <-optimizationTicker:
+			controller.optimizeSessions(helper.GlobalOptimizationDryRun)
+		case dryRun := <-controller.optimizationChan:
+			controller.optimizeSessions(dryRun)
 		case <-controller.updateChan:
 			controller.recalculateSessions()
+			controller.admitPendingRequests()
+		case <-controller.admissionChan:
+			controller.admitPendingRequests()
 		case pathRequest := <-controller.pathRequestChan:
 			controller.handlePathRequest(pathRequest)
 		}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/hawkv6/hawkeye/pkg/api"
 	"github.com/hawkv6/hawkeye/pkg/calculation"
 	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/hawkv6/hawkeye/pkg/graph"
+	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/hawkv6/hawkeye/pkg/messaging"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -33,6 +35,48 @@ func TestNewSessionController(t *testing.T) {
 	}
 }
 
+func TestGetSessionKey(t *testing.T) {
+	intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+	tests := []struct {
+		name            string
+		bandwidthDemand uint32
+		sameStream      bool
+		wantShared      bool
+	}{
+		{
+			name:       "TestGetSessionKey equal path requests of different streams",
+			wantShared: true,
+		},
+		{
+			name:            "TestGetSessionKey equal path requests with bandwidth demand of different streams",
+			bandwidthDemand: 1000,
+			wantShared:      false,
+		},
+		{
+			name:            "TestGetSessionKey equal path requests with bandwidth demand of the same stream",
+			bandwidthDemand: 1000,
+			sameStream:      true,
+			wantShared:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+			otherStream := stream
+			if !tt.sameStream {
+				otherStream = api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+			}
+			pathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, stream, context.Background())
+			assert.NoError(t, err)
+			pathRequest.SetBandwidthDemand(tt.bandwidthDemand)
+			otherPathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, otherStream, context.Background())
+			assert.NoError(t, err)
+			otherPathRequest.SetBandwidthDemand(tt.bandwidthDemand)
+			assert.Equal(t, tt.wantShared, getSessionKey(pathRequest) == getSessionKey(otherPathRequest))
+		})
+	}
+}
+
 func TestSessionController_watchForContextCancellation(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -40,6 +84,9 @@ func TestSessionController_watchForContextCancellation(t *testing.T) {
 		destinationIpv6Address string
 		intents                []domain.Intent
 		stream                 api.IntentController_GetIntentPathServer
+		withSubscriber         bool
+		replaced               bool
+		wantOpenSessions       int
 	}{
 		{
 			name:                   "TestSessionController_watchForContextCancellation",
@@ -47,6 +94,25 @@ func TestSessionController_watchForContextCancellation(t *testing.T) {
 			destinationIpv6Address: "2001:db8::0:2",
 			intents:                []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})},
 			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			wantOpenSessions:       0,
+		},
+		{
+			name:                   "TestSessionController_watchForContextCancellation session handed over to remaining subscriber",
+			sourceIpv6Address:      "2001:db8::0:1",
+			destinationIpv6Address: "2001:db8::0:2",
+			intents:                []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})},
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			withSubscriber:         true,
+			wantOpenSessions:       1,
+		},
+		{
+			name:                   "TestSessionController_watchForContextCancellation session replaced under the same key",
+			sourceIpv6Address:      "2001:db8::0:1",
+			destinationIpv6Address: "2001:db8::0:2",
+			intents:                []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})},
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			replaced:               true,
+			wantOpenSessions:       1,
 		},
 	}
 	for _, tt := range tests {
@@ -57,22 +123,46 @@ func TestSessionController_watchForContextCancellation(t *testing.T) {
 
 			ctx, cancel := context.WithCancel(context.Background())
 			pathRequest, err := domain.NewDomainPathRequest(tt.sourceIpv6Address, tt.destinationIpv6Address, tt.intents, tt.stream, ctx)
-			serializedPathRequest := pathRequest.Serialize()
+			sessionKey := getSessionKey(pathRequest)
 			assert.NoError(t, err)
 			shortestPath := graph.NewMockPath(gomock.NewController(t))
 			pathResult, err := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:1", "fc::0:2"})
 			assert.NoError(t, err)
 			session := domain.NewDomainStreamSession(pathRequest, pathResult)
-			sessionController.openSessions[serializedPathRequest] = session
+			var subscriber domain.PathRequest
+			if tt.withSubscriber {
+				subscriber, err = domain.NewDomainPathRequest(tt.sourceIpv6Address, tt.destinationIpv6Address, tt.intents, api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)), context.Background())
+				assert.NoError(t, err)
+				assert.True(t, session.AddSubscriber(subscriber))
+			}
+			sessionController.openSessions[sessionKey] = session
+			var replacement domain.StreamSession
+			if tt.replaced {
+				otherPathRequest, err := domain.NewDomainPathRequest(tt.sourceIpv6Address, tt.destinationIpv6Address, tt.intents, tt.stream, context.Background())
+				assert.NoError(t, err)
+				replacement = domain.NewDomainStreamSession(otherPathRequest, pathResult)
+				sessionController.openSessions[sessionKey] = replacement
+			}
+			if !tt.withSubscriber && !tt.replaced {
+				calculationManager.EXPECT().RemoveServiceSessions(pathResult).Times(1)
+				calculationManager.EXPECT().ReleaseBandwidth(pathResult).Times(1)
+			}
 			wg := sync.WaitGroup{}
 			wg.Add(1)
 			go func() {
-				sessionController.watchForContextCancellation(pathRequest, pathRequest.Serialize())
+				sessionController.watchForContextCancellation(pathRequest, sessionKey)
 				wg.Done()
 			}()
 			cancel()
 			wg.Wait()
-			assert.Equal(t, 0, len(sessionController.openSessions))
+			assert.Equal(t, tt.wantOpenSessions, len(sessionController.openSessions))
+			if tt.withSubscriber {
+				assert.Equal(t, subscriber, sessionController.openSessions[sessionKey].GetPathRequest())
+				assert.Equal(t, []domain.PathRequest{subscriber}, sessionController.openSessions[sessionKey].GetSubscribers())
+			}
+			if tt.replaced {
+				assert.Equal(t, replacement, sessionController.openSessions[sessionKey])
+			}
 		})
 	}
 }
@@ -138,6 +228,9 @@ func TestSessionController_recalculatePathUpdate(t *testing.T) {
 			shortestPath := graph.NewMockPath(gomock.NewController(t))
 			pathResult, err := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:1", "fc::0:2"})
 			assert.NoError(t, err)
+			if tt.want != nil {
+				tt.want.(*domain.DomainPathResult).PathRequest = pathRequest
+			}
 			session := domain.NewDomainStreamSession(pathRequest, pathResult)
 			go sessionController.recalculatePathUpdate(session)
 
@@ -149,6 +242,7 @@ func TestSessionController_recalculatePathUpdate(t *testing.T) {
 			} else if tt.want != nil {
 				result := <-messagingChannels.GetPathResponseChan()
 				assert.Equal(t, tt.want, result)
+				assert.Equal(t, domain.UpdateReasonNetworkChange, result.GetUpdateReason())
 			} else {
 				select {
 				case <-messagingChannels.GetErrorChan():
@@ -190,7 +284,7 @@ func TestSessionController_getSessionSnapshot(t *testing.T) {
 			pathResult, err := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:1", "fc::0:2"})
 			assert.NoError(t, err)
 			session := domain.NewDomainStreamSession(pathRequest, pathResult)
-			sessionController.openSessions[pathRequest.Serialize()] = session
+			sessionController.openSessions[getSessionKey(pathRequest)] = session
 			snapshot := sessionController.getSessionSnapshot()
 			assert.Equal(t, 1, len(snapshot))
 		})
@@ -222,7 +316,7 @@ func TestSessionController_recalculateSessions(t *testing.T) {
 				pathResult, _ := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:1", "fc::0:2"})
 				calculationManager.EXPECT().CalculatePathUpdate(gomock.Any()).Return(pathResult, nil).AnyTimes()
 				session := domain.NewDomainStreamSession(pathRequest, pathResult)
-				sessionController.openSessions[pathRequest.Serialize()] = session
+				sessionController.openSessions[getSessionKey(pathRequest)] = session
 			},
 			wantResult: true,
 		},
@@ -241,76 +335,106 @@ func TestSessionController_recalculateSessions(t *testing.T) {
 	}
 }
 
-func TestSessionController_sessionExists(t *testing.T) {
+func TestSessionController_subscribeToSession(t *testing.T) {
 	calculationManager := calculation.NewMockManager(gomock.NewController(t))
 	messagingChannels := messaging.NewPathMessagingChannels()
 	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
-	ctx := context.Background()
 	shortestPath := graph.NewMockPath(gomock.NewController(t))
 	sourceIpv6Address := "2001:db8::0:1"
 	destinationIpv6Address := "2001:db8::0:2"
 	intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
 	sidAddresses := []string{"fc::0:1", "fc::0:2"}
 	tests := []struct {
-		name          string
-		sessionExists bool
+		name            string
+		sessionExists   bool
+		otherStream     bool
+		wantSubscribers int
 	}{
 		{
-			name:          "TestSessionController_sessionExists session exists",
-			sessionExists: true,
+			name:          "TestSessionController_subscribeToSession session does not exist",
+			sessionExists: false,
 		},
 		{
-			name:          "TestSessionController_sessionExists session does not exist",
-			sessionExists: false,
+			name:            "TestSessionController_subscribeToSession same stream sends existing path result",
+			sessionExists:   true,
+			otherStream:     false,
+			wantSubscribers: 1,
+		},
+		{
+			name:            "TestSessionController_subscribeToSession other stream subscribes to session",
+			sessionExists:   true,
+			otherStream:     true,
+			wantSubscribers: 2,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			sessionController := NewSessionController(calculationManager, messagingChannels, make(chan struct{}))
-			pathRequest, _ := domain.NewDomainPathRequest(sourceIpv6Address, destinationIpv6Address, intents, stream, ctx)
+			pathRequest, _ := domain.NewDomainPathRequest(sourceIpv6Address, destinationIpv6Address, intents, stream, context.Background())
 			pathResult, _ := domain.NewDomainPathResult(pathRequest, shortestPath, sidAddresses)
 			session := domain.NewDomainStreamSession(pathRequest, pathResult)
-			if tt.sessionExists {
-				sessionController.openSessions[pathRequest.Serialize()] = session
+			sessionKey := getSessionKey(pathRequest)
+			if !tt.sessionExists {
+				assert.False(t, sessionController.subscribeToSession(sessionKey, pathRequest))
+				return
 			}
-			snapshot := sessionController.getSessionSnapshot()
-			result := sessionController.sessionExists(snapshot, pathRequest)
-			assert.Equal(t, tt.sessionExists, result)
+			sessionController.openSessions[sessionKey] = session
+
+			subscriberStream := stream
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.otherStream {
+				subscriberStream = api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+			}
+			subscriber, _ := domain.NewDomainPathRequest(sourceIpv6Address, destinationIpv6Address, intents, subscriberStream, ctx)
+			subscriber.SetRequestId("subscriber-request")
+			go func() {
+				assert.True(t, sessionController.subscribeToSession(sessionKey, subscriber))
+			}()
+			result := <-messagingChannels.GetPathResponseChan()
+			assert.Equal(t, subscriberStream, result.GetStream())
+			assert.Equal(t, sidAddresses, result.GetIpv6SidAddresses())
+			assert.Equal(t, "subscriber-request", result.GetRequestId())
+			assert.Equal(t, session.GetSessionId(), result.GetSessionId())
+			assert.Len(t, sessionController.getSubscribers(session), tt.wantSubscribers)
+			if !tt.otherStream {
+				return
+			}
+
+			cancel()
+			assert.Eventually(t, func() bool {
+				return len(sessionController.getSubscribers(session)) == 1
+			}, time.Second, 10*time.Millisecond)
+			assert.Contains(t, sessionController.getSessionSnapshot(), sessionKey)
 		})
 	}
 }
 
-func TestSessionController_sendExistingPathResult(t *testing.T) {
-	calculationManager := calculation.NewMockManager(gomock.NewController(t))
+func TestSessionController_sendPathResult(t *testing.T) {
 	messagingChannels := messaging.NewPathMessagingChannels()
-	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
-	ctx := context.Background()
-	shortestPath := graph.NewMockPath(gomock.NewController(t))
-	sourceIpv6Address := "2001:db8::0:1"
-	destinationIpv6Address := "2001:db8::0:2"
+	sessionController := NewSessionController(calculation.NewMockManager(gomock.NewController(t)), messagingChannels, make(chan struct{}))
 	intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
-	sidAddresses := []string{"fc::0:1", "fc::0:2"}
-	tests := []struct {
-		name          string
-		sessionExists bool
-	}{
-		{
-			name:          "TestSessionController_sendExistingPathResult",
-			sessionExists: true,
-		},
+	streams := []api.IntentController_GetIntentPathServer{
+		api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+		api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+		api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			sessionController := NewSessionController(calculationManager, messagingChannels, make(chan struct{}))
-			pathRequest, _ := domain.NewDomainPathRequest(sourceIpv6Address, destinationIpv6Address, intents, stream, ctx)
-			pathResult, _ := domain.NewDomainPathResult(pathRequest, shortestPath, sidAddresses)
-			session := domain.NewDomainStreamSession(pathRequest, pathResult)
-			sessionController.openSessions[pathRequest.Serialize()] = session
-			snapshot := sessionController.getSessionSnapshot()
-			go sessionController.sendExistingPathResult(snapshot, pathRequest)
-			result := <-messagingChannels.GetPathResponseChan()
-			assert.Equal(t, pathResult, result)
-		})
+	pathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, streams[0], context.Background())
+	assert.NoError(t, err)
+	pathResult, err := domain.NewDomainPathResult(pathRequest, graph.NewMockPath(gomock.NewController(t)), []string{"fc::0:1", "fc::0:2"})
+	assert.NoError(t, err)
+	session := domain.NewDomainStreamSession(pathRequest, pathResult)
+	for _, stream := range streams[1:] {
+		subscriber, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, stream, context.Background())
+		assert.NoError(t, err)
+		assert.True(t, session.AddSubscriber(subscriber))
+	}
+
+	go sessionController.sendPathResult(session, pathResult)
+	for _, stream := range streams {
+		result := <-messagingChannels.GetPathResponseChan()
+		assert.Equal(t, stream, result.GetStream())
+		assert.Equal(t, pathResult.GetIpv6SidAddresses(), result.GetIpv6SidAddresses())
 	}
 }
 
@@ -342,13 +466,16 @@ func TestSessionController_calculateAndCreateSession(t *testing.T) {
 				calculationManager.EXPECT().CalculateBestPath(gomock.Any()).Return(nil, fmt.Errorf("No path found")).Times(1)
 			} else {
 				calculationManager.EXPECT().CalculateBestPath(gomock.Any()).Return(pathResult, nil).Times(1)
+				calculationManager.EXPECT().AddServiceSessions(pathResult).Times(1)
+				calculationManager.EXPECT().ReserveBandwidth(pathResult).Times(1)
 			}
-			result, err := sessionController.calculateAndCreateSession(pathRequest.Serialize(), pathRequest)
+			result, err := sessionController.calculateAndCreateSession(getSessionKey(pathRequest), pathRequest)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, pathResult, result)
+				assert.Equal(t, sessionController.openSessions[getSessionKey(pathRequest)].GetSessionId(), result.GetSessionId())
 			}
 		})
 	}
@@ -368,6 +495,7 @@ func TestSessionController_handlePathRequest(t *testing.T) {
 		name          string
 		wantError     bool
 		sessionExists bool
+		otherStream   bool
 	}{
 		{
 			name:          "TestSessionController_handlePathRequest no error and session does not exist",
@@ -384,14 +512,33 @@ func TestSessionController_handlePathRequest(t *testing.T) {
 			wantError:     false,
 			sessionExists: true,
 		},
+		{
+			name:          "TestSessionController_handlePathRequest no error and stream subscribes to session of another stream",
+			wantError:     false,
+			sessionExists: false,
+			otherStream:   true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			sessionController := NewSessionController(calculationManager, messagingChannels, make(chan struct{}))
 			pathRequest, _ := domain.NewDomainPathRequest(sourceIpv6Address, destinationIpv6Address, intents, stream, ctx)
 			pathResult, _ := domain.NewDomainPathResult(pathRequest, shortestPath, sidAddresses)
+			if tt.otherStream {
+				otherStream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+				otherPathRequest, _ := domain.NewDomainPathRequest(sourceIpv6Address, destinationIpv6Address, intents, otherStream, ctx)
+				otherPathResult, _ := domain.NewDomainPathResult(otherPathRequest, shortestPath, sidAddresses)
+				otherSession := domain.NewDomainStreamSession(otherPathRequest, otherPathResult)
+				sessionController.openSessions[getSessionKey(otherPathRequest)] = otherSession
+				go sessionController.handlePathRequest(pathRequest)
+				result := <-messagingChannels.GetPathResponseChan()
+				assert.Equal(t, stream, result.GetStream())
+				assert.Equal(t, sidAddresses, result.GetIpv6SidAddresses())
+				assert.Equal(t, []domain.PathRequest{otherPathRequest, pathRequest}, sessionController.getSubscribers(otherSession))
+				return
+			}
 			if tt.sessionExists {
-				sessionController.openSessions[pathRequest.Serialize()] = domain.NewDomainStreamSession(pathRequest, pathResult)
+				sessionController.openSessions[getSessionKey(pathRequest)] = domain.NewDomainStreamSession(pathRequest, pathResult)
 				go sessionController.handlePathRequest(pathRequest)
 				result := <-messagingChannels.GetPathResponseChan()
 				assert.Equal(t, pathResult, result)
@@ -401,17 +548,332 @@ func TestSessionController_handlePathRequest(t *testing.T) {
 				calculationManager.EXPECT().CalculateBestPath(gomock.Any()).Return(nil, fmt.Errorf("No path found")).Times(1)
 				go sessionController.handlePathRequest(pathRequest)
 				err := <-messagingChannels.GetErrorChan()
-				assert.Error(t, err)
+				var sessionError *domain.SessionError
+				assert.ErrorAs(t, err, &sessionError)
+				assert.Equal(t, pathRequest, sessionError.GetPathRequest())
 			} else {
 				calculationManager.EXPECT().CalculateBestPath(gomock.Any()).Return(pathResult, nil).Times(1)
+				calculationManager.EXPECT().AddServiceSessions(pathResult).Times(1)
+				calculationManager.EXPECT().ReserveBandwidth(pathResult).Times(1)
 				go sessionController.handlePathRequest(pathRequest)
 				result := <-messagingChannels.GetPathResponseChan()
 				assert.Equal(t, pathResult, result)
+				assert.Equal(t, stream, result.GetStream())
 			}
 		})
 	}
 }
 
+func TestSessionController_handleUnadmittedRequest(t *testing.T) {
+	queueUnadmittedRequests := helper.QueueUnadmittedRequests
+	defer func() { helper.QueueUnadmittedRequests = queueUnadmittedRequests }()
+	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+	intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+	tests := []struct {
+		name                    string
+		queueUnadmittedRequests bool
+	}{
+		{
+			name:                    "TestSessionController_handleUnadmittedRequest reject",
+			queueUnadmittedRequests: false,
+		},
+		{
+			name:                    "TestSessionController_handleUnadmittedRequest queue",
+			queueUnadmittedRequests: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helper.QueueUnadmittedRequests = tt.queueUnadmittedRequests
+			messagingChannels := messaging.NewPathMessagingChannels()
+			sessionController := NewSessionController(calculation.NewMockManager(gomock.NewController(t)), messagingChannels, make(chan struct{}))
+			pathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, stream, context.Background())
+			assert.NoError(t, err)
+			pathRequest.SetBandwidthDemand(100000)
+			if tt.queueUnadmittedRequests {
+				sessionController.handleUnadmittedRequest(pathRequest, fmt.Errorf("No path found"))
+				assert.True(t, sessionController.isPending(pathRequest))
+				return
+			}
+			go sessionController.handleUnadmittedRequest(pathRequest, fmt.Errorf("No path found"))
+			err = <-messagingChannels.GetErrorChan()
+			assert.ErrorContains(t, err, "bandwidth demand of 100000 kbit/s can not be admitted")
+			assert.False(t, sessionController.isPending(pathRequest))
+		})
+	}
+}
+
+func TestSessionController_admitPendingRequests(t *testing.T) {
+	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+	shortestPath := graph.NewMockPath(gomock.NewController(t))
+	intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+	tests := []struct {
+		name        string
+		cancelled   bool
+		admitted    bool
+		wantPending bool
+	}{
+		{
+			name:        "TestSessionController_admitPendingRequests admitted",
+			admitted:    true,
+			wantPending: false,
+		},
+		{
+			name:        "TestSessionController_admitPendingRequests still not admitted",
+			admitted:    false,
+			wantPending: true,
+		},
+		{
+			name:        "TestSessionController_admitPendingRequests cancelled",
+			cancelled:   true,
+			wantPending: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculationManager := calculation.NewMockManager(gomock.NewController(t))
+			messagingChannels := messaging.NewPathMessagingChannels()
+			sessionController := NewSessionController(calculationManager, messagingChannels, make(chan struct{}))
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			pathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, stream, ctx)
+			assert.NoError(t, err)
+			pathRequest.SetBandwidthDemand(100000)
+			sessionController.pendingRequests = append(sessionController.pendingRequests, pathRequest)
+			if tt.cancelled {
+				cancel()
+				sessionController.admitPendingRequests()
+			} else if tt.admitted {
+				pathResult, err := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:1", "fc::0:2"})
+				assert.NoError(t, err)
+				calculationManager.EXPECT().CalculateBestPath(pathRequest).Return(pathResult, nil)
+				calculationManager.EXPECT().AddServiceSessions(pathResult)
+				calculationManager.EXPECT().ReserveBandwidth(pathResult)
+				calculationManager.EXPECT().RemoveServiceSessions(pathResult).AnyTimes()
+				calculationManager.EXPECT().ReleaseBandwidth(pathResult).AnyTimes()
+				go sessionController.admitPendingRequests()
+				assert.Equal(t, pathResult, <-messagingChannels.GetPathResponseChan())
+				assert.Contains(t, sessionController.getSessionSnapshot(), getSessionKey(pathRequest))
+			} else {
+				calculationManager.EXPECT().CalculateBestPath(pathRequest).Return(nil, fmt.Errorf("No path found"))
+				sessionController.admitPendingRequests()
+			}
+			assert.Equal(t, tt.wantPending, sessionController.isPending(pathRequest))
+		})
+	}
+}
+
+func TestSessionController_admitSession(t *testing.T) {
+	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+	shortestPath := graph.NewMockPath(gomock.NewController(t))
+	intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+	tests := []struct {
+		name                  string
+		openHoldPriority      uint32
+		insufficientBandwidth bool
+		preemptionSufficient  bool
+		rerouted              bool
+		wantErr               bool
+	}{
+		{
+			name:                  "TestSessionController_admitSession preempted session is rerouted",
+			openHoldPriority:      5,
+			insufficientBandwidth: true,
+			preemptionSufficient:  true,
+			rerouted:              true,
+			wantErr:               false,
+		},
+		{
+			name:                  "TestSessionController_admitSession preempted session is displaced",
+			openHoldPriority:      5,
+			insufficientBandwidth: true,
+			preemptionSufficient:  true,
+			rerouted:              false,
+			wantErr:               false,
+		},
+		{
+			name:                  "TestSessionController_admitSession no session with lower priority",
+			openHoldPriority:      0,
+			insufficientBandwidth: true,
+			wantErr:               true,
+		},
+		{
+			name:                  "TestSessionController_admitSession preemption not sufficient",
+			openHoldPriority:      5,
+			insufficientBandwidth: true,
+			preemptionSufficient:  false,
+			wantErr:               true,
+		},
+		{
+			name:                  "TestSessionController_admitSession no preemption without lack of bandwidth",
+			openHoldPriority:      5,
+			insufficientBandwidth: false,
+			wantErr:               true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculationManager := calculation.NewMockManager(gomock.NewController(t))
+			messagingChannels := messaging.NewPathMessagingChannels()
+			sessionController := NewSessionController(calculationManager, messagingChannels, make(chan struct{}))
+			openPathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:3", intents, stream, context.Background())
+			assert.NoError(t, err)
+			assert.NoError(t, openPathRequest.SetPriorities(tt.openHoldPriority, tt.openHoldPriority))
+			openPathResult, err := domain.NewDomainPathResult(openPathRequest, shortestPath, []string{"fc::0:1", "fc::0:3"})
+			assert.NoError(t, err)
+			openSession := domain.NewDomainStreamSession(openPathRequest, openPathResult)
+			sessionController.openSessions[getSessionKey(openPathRequest)] = openSession
+			pathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, stream, context.Background())
+			assert.NoError(t, err)
+			assert.NoError(t, pathRequest.SetPriorities(domain.HighestPriority, domain.HighestPriority))
+			pathResult, err := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:1", "fc::0:2"})
+			assert.NoError(t, err)
+
+			if !tt.insufficientBandwidth {
+				calculationManager.EXPECT().CalculateBestPath(pathRequest).Return(nil, fmt.Errorf("No path found"))
+			} else {
+				calculationManager.EXPECT().CalculateBestPath(pathRequest).Return(nil, fmt.Errorf("%w: No path found", calculation.ErrInsufficientCapacity))
+			}
+			if tt.insufficientBandwidth && tt.openHoldPriority > 0 {
+				calculationManager.EXPECT().RemoveServiceSessions(openPathResult)
+				calculationManager.EXPECT().ReleaseBandwidth(openPathResult)
+			}
+			if tt.insufficientBandwidth && tt.openHoldPriority > 0 && !tt.preemptionSufficient {
+				calculationManager.EXPECT().CalculateBestPath(pathRequest).Return(nil, fmt.Errorf("%w: No path found", calculation.ErrInsufficientCapacity))
+				calculationManager.EXPECT().AddServiceSessions(openPathResult)
+				calculationManager.EXPECT().ReserveBandwidth(openPathResult)
+			}
+			reroutedPathResult, err := domain.NewDomainPathResult(openPathRequest, shortestPath, []string{"fc::0:4", "fc::0:3"})
+			assert.NoError(t, err)
+			if tt.preemptionSufficient {
+				calculationManager.EXPECT().CalculateBestPath(pathRequest).Return(pathResult, nil)
+				calculationManager.EXPECT().AddServiceSessions(pathResult)
+				calculationManager.EXPECT().ReserveBandwidth(pathResult)
+				if tt.rerouted {
+					calculationManager.EXPECT().CalculateBestPath(openPathRequest).Return(reroutedPathResult, nil)
+					calculationManager.EXPECT().AddServiceSessions(reroutedPathResult)
+					calculationManager.EXPECT().ReserveBandwidth(reroutedPathResult)
+				} else {
+					calculationManager.EXPECT().CalculateBestPath(openPathRequest).Return(nil, fmt.Errorf("No path found"))
+				}
+			}
+
+			type admission struct {
+				pathResult domain.PathResult
+				err        error
+			}
+			admissionChan := make(chan admission)
+			go func() {
+				pathResult, err := sessionController.admitSession(getSessionKey(pathRequest), pathRequest)
+				admissionChan <- admission{pathResult, err}
+			}()
+			if tt.preemptionSufficient && tt.rerouted {
+				assert.Equal(t, reroutedPathResult, <-messagingChannels.GetPathResponseChan())
+			} else if tt.preemptionSufficient {
+				displacedPathResult := <-messagingChannels.GetPathResponseChan()
+				assert.Equal(t, domain.UpdateReasonDisplaced, displacedPathResult.GetUpdateReason())
+				assert.Empty(t, displacedPathResult.GetIpv6SidAddresses())
+				assert.Equal(t, openPathRequest.GetSessionId(), displacedPathResult.GetSessionId())
+			}
+			result := <-admissionChan
+			if tt.wantErr {
+				assert.Error(t, result.err)
+				assert.Contains(t, sessionController.getSessionSnapshot(), getSessionKey(openPathRequest))
+				return
+			}
+			assert.NoError(t, result.err)
+			assert.Equal(t, pathResult, result.pathResult)
+			assert.Contains(t, sessionController.getSessionSnapshot(), getSessionKey(pathRequest))
+			if tt.rerouted {
+				assert.Contains(t, sessionController.getSessionSnapshot(), getSessionKey(openPathRequest))
+				assert.Equal(t, reroutedPathResult, openSession.GetPathResult())
+				// the controller moved the session, the network did not flap
+				assert.Equal(t, uint32(0), openSession.GetPathChangeCount())
+			} else {
+				assert.NotContains(t, sessionController.getSessionSnapshot(), getSessionKey(openPathRequest))
+			}
+		})
+	}
+}
+
+func TestSessionController_optimizeSessions(t *testing.T) {
+	stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
+	shortestPath := graph.NewMockPath(gomock.NewController(t))
+	intents := []domain.Intent{domain.NewDomainIntent(domain.IntentTypeLowLatency, []domain.Value{})}
+	tests := []struct {
+		name         string
+		dryRun       bool
+		closeSession bool
+	}{
+		{
+			name:   "TestSessionController_optimizeSessions dry run",
+			dryRun: true,
+		},
+		{
+			name:   "TestSessionController_optimizeSessions apply moves",
+			dryRun: false,
+		},
+		{
+			name:         "TestSessionController_optimizeSessions session closed during the optimization",
+			dryRun:       false,
+			closeSession: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculationManager := calculation.NewMockManager(gomock.NewController(t))
+			messagingChannels := messaging.NewPathMessagingChannels()
+			sessionController := NewSessionController(calculationManager, messagingChannels, make(chan struct{}))
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			pathRequest, err := domain.NewDomainPathRequest("2001:db8::0:1", "2001:db8::0:2", intents, stream, ctx)
+			assert.NoError(t, err)
+			pathRequest.SetBandwidthDemand(6000)
+			currentPathResult, err := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:1", "fc::0:2"})
+			assert.NoError(t, err)
+			newPathResult, err := domain.NewDomainPathResult(pathRequest, shortestPath, []string{"fc::0:3", "fc::0:2"})
+			assert.NoError(t, err)
+			session := domain.NewDomainStreamSession(pathRequest, currentPathResult)
+			sessionController.openSessions[getSessionKey(pathRequest)] = session
+			report := &calculation.OptimizationReport{
+				Moves:                   []calculation.SessionMove{{Session: session, CurrentPathResult: currentPathResult, NewPathResult: newPathResult}},
+				CurrentMaxUtilization:   1.2,
+				OptimizedMaxUtilization: 0.6,
+			}
+			if tt.closeSession {
+				go sessionController.watchForContextCancellation(pathRequest, getSessionKey(pathRequest))
+				// the stream is closed while the optimization is calculated, its resources are released after the reservations are restored
+				calculationManager.EXPECT().OptimizeSessions([]domain.StreamSession{session}).DoAndReturn(func(sessions []domain.StreamSession) *calculation.OptimizationReport {
+					cancel()
+					assert.Eventually(t, func() bool { return len(sessionController.getSessionSnapshot()) == 0 }, time.Second, time.Millisecond)
+					return report
+				})
+				calculationManager.EXPECT().RemoveServiceSessions(currentPathResult)
+				calculationManager.EXPECT().ReleaseBandwidth(currentPathResult)
+				sessionController.optimizeSessions(tt.dryRun)
+				assert.Equal(t, currentPathResult, session.GetPathResult())
+				assert.Len(t, sessionController.admissionChan, 1)
+				assert.Len(t, messagingChannels.GetPathResponseChan(), 0)
+				return
+			}
+			calculationManager.EXPECT().OptimizeSessions([]domain.StreamSession{session}).Return(report)
+			if tt.dryRun {
+				sessionController.optimizeSessions(tt.dryRun)
+				assert.Equal(t, currentPathResult, session.GetPathResult())
+				return
+			}
+			calculationManager.EXPECT().RemoveServiceSessions(currentPathResult)
+			calculationManager.EXPECT().ReleaseBandwidth(currentPathResult)
+			calculationManager.EXPECT().AddServiceSessions(newPathResult)
+			calculationManager.EXPECT().ReserveBandwidth(newPathResult)
+			go sessionController.optimizeSessions(tt.dryRun)
+			assert.Equal(t, newPathResult, <-messagingChannels.GetPathResponseChan())
+			assert.Equal(t, newPathResult, session.GetPathResult())
+			assert.Equal(t, uint32(0), session.GetPathChangeCount())
+		})
+	}
+}
+
 func TestSessionController_Start(t *testing.T) {
 	calculationManager := calculation.NewMockManager(gomock.NewController(t))
 	messagingChannels := messaging.NewPathMessagingChannels()
@@ -446,6 +908,21 @@ func TestSessionController_Start(t *testing.T) {
 	}
 }
 
+func TestSessionController_TriggerOptimization(t *testing.T) {
+	sessionController := NewSessionController(calculation.NewMockManager(gomock.NewController(t)), messaging.NewPathMessagingChannels(), make(chan struct{}))
+	sessionController.Stop()
+	done := make(chan struct{})
+	go func() {
+		sessionController.TriggerOptimization(false)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("TriggerOptimization blocked after the session controller has been stopped")
+	}
+}
+
 func TestSessionController_Stop(t *testing.T) {
 	calculationManager := calculation.NewMockManager(gomock.NewController(t))
 	messagingChannels := messaging.NewPathMessagingChannels()
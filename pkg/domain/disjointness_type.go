@@ -0,0 +1,22 @@
+package domain
+
+type DisjointnessType int
+
+const (
+	DisjointnessTypeNone DisjointnessType = iota
+	DisjointnessTypeLink
+	DisjointnessTypeNode
+)
+
+func (dt DisjointnessType) String() string {
+	switch dt {
+	case DisjointnessTypeNone:
+		return "None"
+	case DisjointnessTypeLink:
+		return "Link"
+	case DisjointnessTypeNode:
+		return "Node"
+	default:
+		return "Unknown"
+	}
+}
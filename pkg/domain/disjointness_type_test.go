@@ -0,0 +1,24 @@
+package domain
+
+import "testing"
+
+func TestDisjointnessType_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    DisjointnessType
+		expected string
+	}{
+		{"None", DisjointnessTypeNone, "None"},
+		{"Link", DisjointnessTypeLink, "Link"},
+		{"Node", DisjointnessTypeNode, "Node"},
+		{"Unknown", DisjointnessType(999), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.String(); got != tt.expected {
+				t.Errorf("DisjointnessType.String() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -7,12 +7,43 @@ import (
 type Intent interface {
 	GetIntentType() IntentType
 	GetValues() []Value
+	GetWeight() float64
+	SetWeight(float64)
+	GetUnordered() bool
+	SetUnordered(bool)
+	GetServiceOrder() []ServiceOrder
+	SetServiceOrder([]ServiceOrder)
+	GetOptionalServices() []string
+	SetOptionalServices([]string)
+	IsOptionalService(string) bool
 	Serialize() string
 }
 
+// ServiceOrder requires the service before to be applied prior to the service after in an unordered service function chain
+type ServiceOrder struct {
+	before string
+	after  string
+}
+
+func NewServiceOrder(before, after string) ServiceOrder {
+	return ServiceOrder{before: before, after: after}
+}
+
+func (order ServiceOrder) GetBefore() string {
+	return order.before
+}
+
+func (order ServiceOrder) GetAfter() string {
+	return order.after
+}
+
 type DomainIntent struct {
-	intentType IntentType
-	values     []Value
+	intentType       IntentType
+	values           []Value
+	weight           float64
+	unordered        bool
+	serviceOrder     []ServiceOrder
+	optionalServices []string
 }
 
 func NewDomainIntent(intentType IntentType, values []Value) *DomainIntent {
@@ -30,6 +61,47 @@ func (intent *DomainIntent) GetValues() []Value {
 	return intent.values
 }
 
+func (intent *DomainIntent) GetWeight() float64 {
+	return intent.weight
+}
+
+func (intent *DomainIntent) SetWeight(weight float64) {
+	intent.weight = weight
+}
+
+func (intent *DomainIntent) GetUnordered() bool {
+	return intent.unordered
+}
+
+func (intent *DomainIntent) SetUnordered(unordered bool) {
+	intent.unordered = unordered
+}
+
+func (intent *DomainIntent) GetServiceOrder() []ServiceOrder {
+	return intent.serviceOrder
+}
+
+func (intent *DomainIntent) SetServiceOrder(serviceOrder []ServiceOrder) {
+	intent.serviceOrder = serviceOrder
+}
+
+func (intent *DomainIntent) GetOptionalServices() []string {
+	return intent.optionalServices
+}
+
+func (intent *DomainIntent) SetOptionalServices(optionalServices []string) {
+	intent.optionalServices = optionalServices
+}
+
+func (intent *DomainIntent) IsOptionalService(service string) bool {
+	for _, optionalService := range intent.optionalServices {
+		if optionalService == service {
+			return true
+		}
+	}
+	return false
+}
+
 func (intent *DomainIntent) convertValue(value Value) string {
 	valueType := value.GetValueType()
 	switch valueType {
@@ -39,18 +111,24 @@ func (intent *DomainIntent) convertValue(value Value) string {
 		return valueType.String() + ":" + strconv.Itoa(int(value.GetNumberValue()))
 	case ValueTypeFlexAlgoNr:
 		return valueType.String() + ":" + strconv.Itoa((int(value.GetNumberValue())))
+	case ValueTypeTolerance:
+		return valueType.String() + ":" + strconv.Itoa(int(value.GetNumberValue()))
 	case ValueTypeSFC:
 		return valueType.String() + ":" + value.GetStringValue()
+	case ValueTypeNode:
+		return valueType.String() + ":" + value.GetStringValue()
+	case ValueTypeLink:
+		return valueType.String() + ":" + value.GetStringValue()
 	default:
 		return IntentTypeUnspecified.String()
 	}
 }
 
 func (intent *DomainIntent) Serialize() string {
-	if len(intent.values) == 0 {
-		return intent.intentType.String()
+	serialization := intent.intentType.String()
+	if len(intent.values) != 0 {
+		serialization += ","
 	}
-	serialization := intent.intentType.String() + ","
 	for i := 0; i < len(intent.values); i++ {
 		if i == len(intent.values)-1 {
 			serialization += intent.convertValue(intent.values[i])
@@ -58,5 +136,17 @@ func (intent *DomainIntent) Serialize() string {
 			serialization += intent.convertValue(intent.values[i]) + ","
 		}
 	}
+	if intent.weight != 0 {
+		serialization += ",Weight:" + strconv.FormatFloat(intent.weight, 'g', -1, 64)
+	}
+	if intent.unordered {
+		serialization += ",Unordered"
+	}
+	for _, order := range intent.serviceOrder {
+		serialization += ",ServiceOrder:" + order.before + "<" + order.after
+	}
+	for _, optionalService := range intent.optionalServices {
+		serialization += ",OptionalService:" + optionalService
+	}
 	return serialization
 }
@@ -179,11 +179,26 @@ func TestDomainIntent_convertValue(t *testing.T) {
 			value: getNumberValue(ValueTypeFlexAlgoNr, proto.Int32(128)),
 			want:  "FlexAlgoNr:128",
 		},
+		{
+			name:  "Test convertValue ValueTypeTolerance",
+			value: getNumberValue(ValueTypeTolerance, proto.Int32(10)),
+			want:  "Tolerance:10",
+		},
 		{
 			name:  "Test convertValue ValueTypeSFC",
 			value: GetStringValue(ValueTypeSFC, proto.String("fw")),
 			want:  "SFC:fw",
 		},
+		{
+			name:  "Test convertValue ValueTypeNode",
+			value: GetStringValue(ValueTypeNode, proto.String("2.2.2.2")),
+			want:  "Node:2.2.2.2",
+		},
+		{
+			name:  "Test convertValue ValueTypeLink",
+			value: GetStringValue(ValueTypeLink, proto.String("2_0_0_0_0000.0000.0002_2001:db8::2_0000.0000.0003_2001:db8::3")),
+			want:  "Link:2_0_0_0_0000.0000.0002_2001:db8::2_0000.0000.0003_2001:db8::3",
+		},
 		{
 			name:  "Test convertValue default",
 			value: getNumberValue(ValueTypeUnspecified, proto.Int32(1)),
@@ -199,10 +214,14 @@ func TestDomainIntent_convertValue(t *testing.T) {
 
 func TestDomainIntent_Serialize(t *testing.T) {
 	tests := []struct {
-		name       string
-		intentType IntentType
-		values     []Value
-		want       string
+		name             string
+		intentType       IntentType
+		values           []Value
+		weight           float64
+		unordered        bool
+		serviceOrder     []ServiceOrder
+		optionalServices []string
+		want             string
 	}{
 		{
 			name:       "Test DomainIntent Serialize no values",
@@ -226,10 +245,89 @@ func TestDomainIntent_Serialize(t *testing.T) {
 			},
 			want: "HighBandwidth,SFC:fw",
 		},
+		{
+			name:       "Test DomainIntent Serialize weight without values",
+			intentType: IntentTypeLowLatency,
+			values:     []Value{},
+			weight:     0.7,
+			want:       "LowLatency,Weight:0.7",
+		},
+		{
+			name:       "Test DomainIntent Serialize weight with values",
+			intentType: IntentTypeLowLatency,
+			values: []Value{
+				getNumberValue(ValueTypeMaxValue, proto.Int32(10)),
+			},
+			weight: 0.3,
+			want:   "LowLatency,MaxValue:10,Weight:0.3",
+		},
+		{
+			name:       "Test DomainIntent Serialize unordered service function chain",
+			intentType: IntentTypeSFC,
+			values: []Value{
+				GetStringValue(ValueTypeSFC, proto.String("fw")),
+				GetStringValue(ValueTypeSFC, proto.String("ids")),
+			},
+			unordered: true,
+			want:      "SFC,SFC:fw,SFC:ids,Unordered",
+		},
+		{
+			name:       "Test DomainIntent Serialize unordered service function chain with service order",
+			intentType: IntentTypeSFC,
+			values: []Value{
+				GetStringValue(ValueTypeSFC, proto.String("fw")),
+				GetStringValue(ValueTypeSFC, proto.String("ids")),
+			},
+			unordered:    true,
+			serviceOrder: []ServiceOrder{NewServiceOrder("fw", "ids")},
+			want:         "SFC,SFC:fw,SFC:ids,Unordered,ServiceOrder:fw<ids",
+		},
+		{
+			name:       "Test DomainIntent Serialize service function chain with optional service",
+			intentType: IntentTypeSFC,
+			values: []Value{
+				GetStringValue(ValueTypeSFC, proto.String("fw")),
+				GetStringValue(ValueTypeSFC, proto.String("ids")),
+			},
+			optionalServices: []string{"ids"},
+			want:             "SFC,SFC:fw,SFC:ids,OptionalService:ids",
+		},
 	}
 
 	for _, tt := range tests {
 		intent := NewDomainIntent(tt.intentType, tt.values)
+		intent.SetWeight(tt.weight)
+		intent.SetUnordered(tt.unordered)
+		intent.SetServiceOrder(tt.serviceOrder)
+		intent.SetOptionalServices(tt.optionalServices)
 		assert.Equal(t, tt.want, intent.Serialize())
 	}
 }
+
+func TestDomainIntent_GetWeight(t *testing.T) {
+	intent := NewDomainIntent(IntentTypeLowLatency, []Value{})
+	assert.Equal(t, 0.0, intent.GetWeight())
+	intent.SetWeight(0.7)
+	assert.Equal(t, 0.7, intent.GetWeight())
+}
+
+func TestDomainIntent_ServiceOrder(t *testing.T) {
+	intent := NewDomainIntent(IntentTypeSFC, []Value{})
+	assert.False(t, intent.GetUnordered())
+	assert.Empty(t, intent.GetServiceOrder())
+	intent.SetUnordered(true)
+	intent.SetServiceOrder([]ServiceOrder{NewServiceOrder("fw", "ids")})
+	assert.True(t, intent.GetUnordered())
+	assert.Equal(t, "fw", intent.GetServiceOrder()[0].GetBefore())
+	assert.Equal(t, "ids", intent.GetServiceOrder()[0].GetAfter())
+}
+
+func TestDomainIntent_OptionalServices(t *testing.T) {
+	intent := NewDomainIntent(IntentTypeSFC, []Value{})
+	assert.Empty(t, intent.GetOptionalServices())
+	assert.False(t, intent.IsOptionalService("ids"))
+	intent.SetOptionalServices([]string{"ids"})
+	assert.Equal(t, []string{"ids"}, intent.GetOptionalServices())
+	assert.True(t, intent.IsOptionalService("ids"))
+	assert.False(t, intent.IsOptionalService("fw"))
+}
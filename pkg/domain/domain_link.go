@@ -21,6 +21,8 @@ type Link interface {
 	GetNormalizedUnidirLinkDelay() float64
 	GetNormalizedUnidirDelayVariation() float64
 	GetNormalizedUnidirPacketLoss() float64
+	GetAdjacencySids() map[uint32]string
+	SetAdjacencySids(map[uint32]string)
 }
 
 type LinkInput struct {
@@ -53,6 +55,8 @@ type DomainLink struct {
 	normalizedUnidirLinkDelay      float64
 	normalizedUnidirDelayVariation float64
 	normalizedUnidirPacketLoss     float64
+	// SRv6 End.X SIDs of the link per algorithm
+	adjacencySids map[uint32]string
 }
 
 func NewDomainLink(key, igpRouterId, remoteIgpRouterId *string, igpMetric, unidirLinkDelay, unidirDelayVariation *uint32, maxLinkBWKbps *uint64, unidirAvailableBandwidth, unidirBandwidthUtilization *uint32, unidirPacketLoss, normalizedUnidirLinkDelay, normalizedUnidirDelayVariation, normalizedUnidirPacketLoss *float64) (*DomainLink, error) {
@@ -91,6 +95,7 @@ func NewDomainLink(key, igpRouterId, remoteIgpRouterId *string, igpMetric, unidi
 		normalizedUnidirLinkDelay:      *normalizedUnidirLinkDelay,
 		normalizedUnidirDelayVariation: *normalizedUnidirDelayVariation,
 		normalizedUnidirPacketLoss:     *normalizedUnidirPacketLoss,
+		adjacencySids:                  make(map[uint32]string),
 	}
 
 	return defaultLink, nil
@@ -159,3 +164,11 @@ func (link *DomainLink) GetNormalizedUnidirDelayVariation() float64 {
 func (link *DomainLink) GetNormalizedUnidirPacketLoss() float64 {
 	return link.normalizedUnidirPacketLoss
 }
+
+func (link *DomainLink) GetAdjacencySids() map[uint32]string {
+	return link.adjacencySids
+}
+
+func (link *DomainLink) SetAdjacencySids(adjacencySids map[uint32]string) {
+	link.adjacencySids = adjacencySids
+}
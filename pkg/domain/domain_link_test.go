@@ -1163,3 +1163,27 @@ func TestDomainLink_SetNormalizedUnidirPacketLoss(t *testing.T) {
 		assert.Equal(t, tt.want, link.GetNormalizedUnidirPacketLoss())
 	}
 }
+
+func TestDomainLink_AdjacencySids(t *testing.T) {
+	tests := []struct {
+		name          string
+		adjacencySids map[uint32]string
+	}{
+		{
+			name:          "Test DomainLink AdjacencySids not advertised",
+			adjacencySids: map[uint32]string{},
+		},
+		{
+			name:          "Test DomainLink AdjacencySids per algorithm",
+			adjacencySids: map[uint32]string{0: "fc00:0:b:e000::", 128: "fc00:0:8b:e000::"},
+		},
+	}
+
+	for _, tt := range tests {
+		link, err := NewDomainLink(proto.String("2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6"), proto.String("0000.0000.000b"), proto.String("0000.0000.0006"), proto.Uint32(10), proto.Uint32(2000), proto.Uint32(100), proto.Uint64(1000000), proto.Uint32(99766), proto.Uint32(234), proto.Float64(3.0059316283477027), proto.Float64(0.05), proto.Float64(0.016452169298129225), proto.Float64(0.01))
+		assert.NoError(t, err)
+		assert.Empty(t, link.GetAdjacencySids())
+		link.SetAdjacencySids(tt.adjacencySids)
+		assert.Equal(t, tt.adjacencySids, link.GetAdjacencySids())
+	}
+}
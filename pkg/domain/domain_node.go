@@ -7,6 +7,8 @@ type Node interface {
 	GetIgpRouterId() string
 	GetName() string
 	GetSrAlgorithm() []uint32
+	GetMaximumSidDepth() uint32
+	SetMaximumSidDepth(uint32)
 }
 
 type NodeInput struct {
@@ -21,6 +23,8 @@ type DomainNode struct {
 	igpRouterId string
 	name        string
 	srAlgorithm []uint32
+	// maximum number of SIDs the node can impose as headend, 0 if not advertised
+	maximumSidDepth uint32
 }
 
 func NewDomainNode(key, igpRouterId, name *string, srAlgorihm []uint32) (*DomainNode, error) {
@@ -60,3 +64,11 @@ func (n *DomainNode) GetName() string {
 func (n *DomainNode) GetSrAlgorithm() []uint32 {
 	return n.srAlgorithm
 }
+
+func (n *DomainNode) GetMaximumSidDepth() uint32 {
+	return n.maximumSidDepth
+}
+
+func (n *DomainNode) SetMaximumSidDepth(maximumSidDepth uint32) {
+	n.maximumSidDepth = maximumSidDepth
+}
@@ -183,3 +183,28 @@ func TestDomainNode_GetSrAlgorithm(t *testing.T) {
 		assert.Equal(t, tt.srAlgorithm, node.GetSrAlgorithm())
 	}
 }
+
+func TestDomainNode_MaximumSidDepth(t *testing.T) {
+	tests := []struct {
+		name            string
+		maximumSidDepth uint32
+	}{
+		{
+			name:            "Test MaximumSidDepth not advertised",
+			maximumSidDepth: 0,
+		},
+		{
+			name:            "Test MaximumSidDepth advertised",
+			maximumSidDepth: 6,
+		},
+	}
+
+	for _, tt := range tests {
+		node, err := NewDomainNode(proto.String("2_0_0_0000.0000.0004"), proto.String("0000.0000.0004"), proto.String("XR-4"), []uint32{0})
+		if err != nil {
+			t.Errorf("Error creating DomainNode: %v", err)
+		}
+		node.SetMaximumSidDepth(tt.maximumSidDepth)
+		assert.Equal(t, tt.maximumSidDepth, node.GetMaximumSidDepth())
+	}
+}
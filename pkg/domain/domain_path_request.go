@@ -3,6 +3,9 @@ package domain
 import (
 	"context"
 	"fmt"
+	"math"
+	"strconv"
+	"time"
 
 	"github.com/go-playground/validator"
 	"github.com/hawkv6/hawkeye/pkg/api"
@@ -14,15 +17,74 @@ type PathRequest interface {
 	GetIntents() []Intent
 	GetContext() context.Context
 	GetStream() api.IntentController_GetIntentPathServer
+	GetAlternativePathCount() uint32
+	SetAlternativePathCount(uint32) error
+	GetDisjointnessType() DisjointnessType
+	SetDisjointnessType(DisjointnessType) error
+	GetPathAlgorithm() PathAlgorithm
+	SetPathAlgorithm(PathAlgorithm) error
+	GetSelectionPolicy() SelectionPolicy
+	SetSelectionPolicy(SelectionPolicy) error
+	GetMaxHopCount() uint32
+	SetMaxHopCount(uint32)
+	GetMaxSidDepth() uint32
+	SetMaxSidDepth(uint32)
+	GetStrictPath() bool
+	SetStrictPath(bool)
+	GetBandwidthDemand() uint32
+	SetBandwidthDemand(uint32)
+	GetSetupPriority() uint32
+	GetHoldPriority() uint32
+	SetPriorities(uint32, uint32) error
+	GetHysteresisPercentage() float64
+	GetHoldDownTime() time.Duration
+	GetFlapDampingHalfLife() time.Duration
+	SetPathStability(float64, uint32, uint32) error
+	GetRequestId() string
+	SetRequestId(string)
+	GetSessionId() string
+	SetSessionId(string)
 	Serialize() string
 }
 
+const MaximumAlternativePathCount = 10
+
+// the calculation tracks the applied services of a service function chain in a 64 bit mask
+const MaximumServiceCount = 64
+
+// setup and hold priorities as in RSVP-TE, 0 is the highest priority
+const (
+	HighestPriority uint32 = 0
+	LowestPriority  uint32 = 7
+)
+
 type DomainPathRequest struct {
 	ipv6SourceAddress      string
 	ipv6DestinationAddress string
 	intents                []Intent
 	stream                 api.IntentController_GetIntentPathServer
 	ctx                    context.Context
+	alternativePathCount   uint32
+	disjointnessType       DisjointnessType
+	pathAlgorithm          PathAlgorithm
+	selectionPolicy        SelectionPolicy
+	maxHopCount            uint32
+	maxSidDepth            uint32
+	strictPath             bool
+	// expected bandwidth of the session in kbit/s, reserved on the links of the path
+	bandwidthDemand uint32
+	// priority to take resources of other sessions and to keep its own resources, the lowest priority by default
+	setupPriority uint32
+	holdPriority  uint32
+	// required improvement in percent before the path is changed, 0 if the global flapping threshold applies
+	hysteresisPercentage float64
+	// minimum time between path changes and half-life of the flap damping penalty in seconds, 0 if disabled
+	holdDownTime        uint32
+	flapDampingHalfLife uint32
+	// identifier chosen by the client and identifier of the session assigned by the server, both are not serialized
+	// so equal path requests of different clients share a session
+	requestId string
+	sessionId string
 }
 
 type DomainPathRequestInput struct {
@@ -44,6 +106,10 @@ func validateMinMaxValues(intentType IntentType, values []Value) error {
 			if intentType != IntentTypeHighBandwidth {
 				return fmt.Errorf("Min value is only allowed for High Bandwidth intents")
 			}
+		case ValueTypeTolerance:
+			if intentType != IntentTypeLowLatency && intentType != IntentTypeLowJitter && intentType != IntentTypeLowPacketLoss && intentType != IntentTypeHighBandwidth {
+				return fmt.Errorf("Tolerance is only allowed for Low Latency, Jitter, Packet Loss, or High Bandwidth intents")
+			}
 		}
 	}
 	return nil
@@ -74,12 +140,72 @@ func validateFlexAlgoIntentType(intent Intent, intentType IntentType) error {
 	return nil
 }
 
+func hasServiceOrderCycle(service string, successors map[string][]string, states map[string]int) bool {
+	const visiting, visited = 1, 2
+	switch states[service] {
+	case visiting:
+		return true
+	case visited:
+		return false
+	}
+	states[service] = visiting
+	for _, successor := range successors[service] {
+		if hasServiceOrderCycle(successor, successors, states) {
+			return true
+		}
+	}
+	states[service] = visited
+	return false
+}
+
+func validateServiceOrder(intent Intent, services map[string]bool) error {
+	serviceOrder := intent.GetServiceOrder()
+	if len(serviceOrder) == 0 {
+		return nil
+	}
+	if !intent.GetUnordered() {
+		return fmt.Errorf("Service order constraints are only supported for unordered Service Function Chain intents")
+	}
+	successors := make(map[string][]string)
+	for _, order := range serviceOrder {
+		if !services[order.GetBefore()] || !services[order.GetAfter()] {
+			return fmt.Errorf("Service order %s before %s refers to a service which is not part of the Service Function Chain", order.GetBefore(), order.GetAfter())
+		}
+		if order.GetBefore() == order.GetAfter() {
+			return fmt.Errorf("Service order %s before %s refers to the same service twice", order.GetBefore(), order.GetAfter())
+		}
+		successors[order.GetBefore()] = append(successors[order.GetBefore()], order.GetAfter())
+	}
+	states := make(map[string]int)
+	for service := range successors {
+		if hasServiceOrderCycle(service, successors, states) {
+			return fmt.Errorf("Service order constraints of the Service Function Chain contain a cycle")
+		}
+	}
+	return nil
+}
+
+func validateOptionalServices(intent Intent, services map[string]bool) error {
+	for _, optionalService := range intent.GetOptionalServices() {
+		if !services[optionalService] {
+			return fmt.Errorf("Optional service %s is not part of the Service Function Chain", optionalService)
+		}
+	}
+	return nil
+}
+
 func validateServiceFunctionChainIntentType(intent Intent, intentType IntentType) error {
+	if intentType != IntentTypeSFC && (intent.GetUnordered() || len(intent.GetServiceOrder()) > 0 || len(intent.GetOptionalServices()) > 0) {
+		return fmt.Errorf("Unordered flag, service order and optional services are only supported for Service Function Chain intents")
+	}
 	if intentType == IntentTypeSFC {
 		values := intent.GetValues()
 		if len(values) == 0 {
 			return fmt.Errorf("Service Function Chain intent should have at least one VALUE_TYPE_SERVICE_FUNCTION_CHAIN")
 		}
+		if len(values) > MaximumServiceCount {
+			return fmt.Errorf("Service Function Chain intent should have at most %d services", MaximumServiceCount)
+		}
 		services := make(map[string]bool, 0)
 		for _, value := range values {
 			if value.GetValueType() != ValueTypeSFC {
@@ -91,6 +217,167 @@ func validateServiceFunctionChainIntentType(intent Intent, intentType IntentType
 			}
 			services[service] = true
 		}
+		if err := validateOptionalServices(intent, services); err != nil {
+			return err
+		}
+		return validateServiceOrder(intent, services)
+	}
+	return nil
+}
+
+func hasToleranceValue(intents []Intent) bool {
+	for _, intent := range intents {
+		for _, value := range intent.GetValues() {
+			if value.GetValueType() == ValueTypeTolerance {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateLexicographicIntents(intents []Intent) error {
+	if hasToleranceValue(intents) && intents[0].GetIntentType() == IntentTypeSFC {
+		return fmt.Errorf("Lexicographic intent ordering is not supported for Service Function Chain intents")
+	}
+	return nil
+}
+
+func IsTopologyIntentType(intentType IntentType) bool {
+	switch intentType {
+	case IntentTypeExcludeNodes, IntentTypeIncludeNodes, IntentTypeExcludeLinks, IntentTypeIncludeLinks:
+		return true
+	default:
+		return false
+	}
+}
+
+func hasTopologyIntent(intents []Intent) bool {
+	for _, intent := range intents {
+		if IsTopologyIntentType(intent.GetIntentType()) {
+			return true
+		}
+	}
+	return false
+}
+
+func getTopologyValueType(intentType IntentType) ValueType {
+	if intentType == IntentTypeExcludeLinks || intentType == IntentTypeIncludeLinks {
+		return ValueTypeLink
+	}
+	return ValueTypeNode
+}
+
+func validateTopologyIntentType(intent Intent, intentType IntentType) error {
+	if !IsTopologyIntentType(intentType) {
+		return nil
+	}
+	values := intent.GetValues()
+	if len(values) == 0 {
+		return fmt.Errorf("%v intent should have at least one value", intentType)
+	}
+	valueType := getTopologyValueType(intentType)
+	elements := make(map[string]bool)
+	for _, value := range values {
+		if value.GetValueType() != valueType {
+			return fmt.Errorf("%v intent values should be of type %v", intentType, valueType)
+		}
+		element := value.GetStringValue()
+		if element == "" {
+			return fmt.Errorf("%v intent values should not be empty", intentType)
+		}
+		if _, exists := elements[element]; exists {
+			return fmt.Errorf("%v intent value %v appears more than once", intentType, element)
+		}
+		elements[element] = true
+	}
+	return nil
+}
+
+func getTopologyElements(intents []Intent, intentType IntentType) map[string]bool {
+	elements := make(map[string]bool)
+	for _, intent := range intents {
+		if intent.GetIntentType() == intentType {
+			for _, value := range intent.GetValues() {
+				elements[value.GetStringValue()] = true
+			}
+		}
+	}
+	return elements
+}
+
+func validateTopologyElementOverlap(intents []Intent, excludeType, includeType IntentType) error {
+	excludedElements := getTopologyElements(intents, excludeType)
+	for element := range getTopologyElements(intents, includeType) {
+		if excludedElements[element] {
+			return fmt.Errorf("%v can not be excluded and included at the same time", element)
+		}
+	}
+	return nil
+}
+
+func validateTopologyIntents(intents []Intent) error {
+	if !hasTopologyIntent(intents) {
+		return nil
+	}
+	if IsTopologyIntentType(intents[0].GetIntentType()) {
+		return fmt.Errorf("Node and link intents should be combined with at least one other intent")
+	}
+	for i := 1; i < len(intents); i++ {
+		if IsTopologyIntentType(intents[i-1].GetIntentType()) && !IsTopologyIntentType(intents[i].GetIntentType()) {
+			return fmt.Errorf("Node and link intents should be placed after all other intents")
+		}
+	}
+	if err := validateTopologyElementOverlap(intents, IntentTypeExcludeNodes, IntentTypeIncludeNodes); err != nil {
+		return err
+	}
+	if err := validateTopologyElementOverlap(intents, IntentTypeExcludeLinks, IntentTypeIncludeLinks); err != nil {
+		return err
+	}
+	includesNodes := len(getTopologyElements(intents, IntentTypeIncludeNodes)) > 0
+	includesLinks := len(getTopologyElements(intents, IntentTypeIncludeLinks)) > 0
+	if includesNodes && includesLinks {
+		return fmt.Errorf("Include node and include link intents can not be combined")
+	}
+	if hasToleranceValue(intents) {
+		return fmt.Errorf("Node and link intents can not be combined with lexicographic intent ordering")
+	}
+	return nil
+}
+
+func isWeightableIntentType(intentType IntentType) bool {
+	switch intentType {
+	case IntentTypeLowLatency, IntentTypeLowJitter, IntentTypeLowPacketLoss, IntentTypeHighBandwidth:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateIntentWeights(intents []Intent) error {
+	weightedIntents, weightableIntents := 0, 0
+	for _, intent := range intents {
+		weight := intent.GetWeight()
+		if math.IsNaN(weight) || math.IsInf(weight, 0) || weight < 0 {
+			return fmt.Errorf("Intent weight should be a positive number")
+		}
+		if isWeightableIntentType(intent.GetIntentType()) {
+			weightableIntents++
+		} else if weight != 0 {
+			return fmt.Errorf("Weight is only allowed for Low Latency, Jitter, Packet Loss, or High Bandwidth intents")
+		}
+		if weight != 0 {
+			weightedIntents++
+		}
+	}
+	if weightedIntents == 0 {
+		return nil
+	}
+	if weightedIntents != weightableIntents {
+		return fmt.Errorf("Either all or none of the combined intents should have a weight")
+	}
+	if hasToleranceValue(intents) {
+		return fmt.Errorf("Intent weights can not be combined with lexicographic intent ordering")
 	}
 	return nil
 }
@@ -114,9 +401,18 @@ func validateIntents(intents []Intent) error {
 		if err := validateServiceFunctionChainIntentType(intent, intentType); err != nil {
 			return err
 		}
+		if err := validateTopologyIntentType(intent, intentType); err != nil {
+			return err
+		}
 		intentTypes[intentType] = true
 	}
-	return nil
+	if err := validateIntentWeights(intents); err != nil {
+		return err
+	}
+	if err := validateTopologyIntents(intents); err != nil {
+		return err
+	}
+	return validateLexicographicIntents(intents)
 }
 
 func NewDomainPathRequest(ipv6SourceAddress string, ipv6DestinationAddress string, intents []Intent, stream api.IntentController_GetIntentPathServer, ctx context.Context) (*DomainPathRequest, error) {
@@ -141,6 +437,8 @@ func NewDomainPathRequest(ipv6SourceAddress string, ipv6DestinationAddress strin
 		intents:                intents,
 		stream:                 stream,
 		ctx:                    ctx,
+		setupPriority:          LowestPriority,
+		holdPriority:           LowestPriority,
 	}
 	return pathRequest, nil
 }
@@ -165,6 +463,226 @@ func (pathRequest *DomainPathRequest) GetStream() api.IntentController_GetIntent
 	return pathRequest.stream
 }
 
+func (pathRequest *DomainPathRequest) GetAlternativePathCount() uint32 {
+	return pathRequest.alternativePathCount
+}
+
+func (pathRequest *DomainPathRequest) SetAlternativePathCount(alternativePathCount uint32) error {
+	if alternativePathCount > MaximumAlternativePathCount {
+		return fmt.Errorf("At most %d alternative paths can be requested", MaximumAlternativePathCount)
+	}
+	if alternativePathCount > 0 && pathRequest.intents[0].GetIntentType() == IntentTypeSFC {
+		return fmt.Errorf("Alternative paths are not supported for Service Function Chain intents")
+	}
+	if alternativePathCount > 0 && pathRequest.disjointnessType != DisjointnessTypeNone {
+		return fmt.Errorf("Alternative paths can not be combined with disjoint paths")
+	}
+	if alternativePathCount > 0 && pathRequest.pathAlgorithm == PathAlgorithmConstrained {
+		return fmt.Errorf("Alternative paths can not be combined with the constrained path algorithm")
+	}
+	if alternativePathCount > 0 && pathRequest.selectionPolicy != SelectionPolicyNone {
+		return fmt.Errorf("Alternative paths can not be combined with a Pareto front")
+	}
+	if alternativePathCount > 0 && hasToleranceValue(pathRequest.intents) {
+		return fmt.Errorf("Alternative paths can not be combined with lexicographic intent ordering")
+	}
+	if alternativePathCount > 0 && hasTopologyIntent(pathRequest.intents) {
+		return fmt.Errorf("Alternative paths can not be combined with node or link intents")
+	}
+	pathRequest.alternativePathCount = alternativePathCount
+	return nil
+}
+
+func (pathRequest *DomainPathRequest) GetDisjointnessType() DisjointnessType {
+	return pathRequest.disjointnessType
+}
+
+func (pathRequest *DomainPathRequest) SetDisjointnessType(disjointnessType DisjointnessType) error {
+	if disjointnessType == DisjointnessTypeNone {
+		pathRequest.disjointnessType = disjointnessType
+		return nil
+	}
+	if disjointnessType != DisjointnessTypeLink && disjointnessType != DisjointnessTypeNode {
+		return fmt.Errorf("Disjointness type %v is not supported", disjointnessType)
+	}
+	if pathRequest.intents[0].GetIntentType() == IntentTypeSFC {
+		return fmt.Errorf("Disjoint paths are not supported for Service Function Chain intents")
+	}
+	if pathRequest.alternativePathCount > 0 {
+		return fmt.Errorf("Disjoint paths can not be combined with alternative paths")
+	}
+	if pathRequest.pathAlgorithm == PathAlgorithmConstrained {
+		return fmt.Errorf("Disjoint paths can not be combined with the constrained path algorithm")
+	}
+	if pathRequest.selectionPolicy != SelectionPolicyNone {
+		return fmt.Errorf("Disjoint paths can not be combined with a Pareto front")
+	}
+	if hasToleranceValue(pathRequest.intents) {
+		return fmt.Errorf("Disjoint paths can not be combined with lexicographic intent ordering")
+	}
+	if hasTopologyIntent(pathRequest.intents) {
+		return fmt.Errorf("Disjoint paths can not be combined with node or link intents")
+	}
+	pathRequest.disjointnessType = disjointnessType
+	return nil
+}
+
+func (pathRequest *DomainPathRequest) GetPathAlgorithm() PathAlgorithm {
+	return pathRequest.pathAlgorithm
+}
+
+func (pathRequest *DomainPathRequest) SetPathAlgorithm(pathAlgorithm PathAlgorithm) error {
+	if pathAlgorithm != PathAlgorithmDefault && pathAlgorithm != PathAlgorithmDijkstra && pathAlgorithm != PathAlgorithmConstrained {
+		return fmt.Errorf("Path algorithm %v is not supported", pathAlgorithm)
+	}
+	if pathAlgorithm == PathAlgorithmConstrained {
+		if pathRequest.intents[0].GetIntentType() == IntentTypeSFC {
+			return fmt.Errorf("Constrained path algorithm is not supported for Service Function Chain intents")
+		}
+		if pathRequest.alternativePathCount > 0 || pathRequest.disjointnessType != DisjointnessTypeNone {
+			return fmt.Errorf("Constrained path algorithm can not be combined with alternative or disjoint paths")
+		}
+		if pathRequest.selectionPolicy != SelectionPolicyNone {
+			return fmt.Errorf("Constrained path algorithm can not be combined with a Pareto front")
+		}
+		if hasToleranceValue(pathRequest.intents) {
+			return fmt.Errorf("Constrained path algorithm can not be combined with lexicographic intent ordering")
+		}
+	}
+	pathRequest.pathAlgorithm = pathAlgorithm
+	return nil
+}
+
+func (pathRequest *DomainPathRequest) GetSelectionPolicy() SelectionPolicy {
+	return pathRequest.selectionPolicy
+}
+
+func validateParetoFrontIntents(intents []Intent) error {
+	for _, intent := range intents {
+		switch intent.GetIntentType() {
+		case IntentTypeFlexAlgo, IntentTypeLowLatency, IntentTypeLowJitter, IntentTypeLowPacketLoss, IntentTypeHighBandwidth:
+			continue
+		default:
+			return fmt.Errorf("Intent type %v is not supported for Pareto front calculation", intent.GetIntentType())
+		}
+	}
+	return nil
+}
+
+func (pathRequest *DomainPathRequest) SetSelectionPolicy(selectionPolicy SelectionPolicy) error {
+	if selectionPolicy == SelectionPolicyNone {
+		pathRequest.selectionPolicy = selectionPolicy
+		return nil
+	}
+	if selectionPolicy != SelectionPolicyWeighted && selectionPolicy != SelectionPolicyLexicographic && selectionPolicy != SelectionPolicyKnee {
+		return fmt.Errorf("Selection policy %v is not supported", selectionPolicy)
+	}
+	if err := validateParetoFrontIntents(pathRequest.intents); err != nil {
+		return err
+	}
+	if pathRequest.alternativePathCount > 0 || pathRequest.disjointnessType != DisjointnessTypeNone {
+		return fmt.Errorf("Pareto front can not be combined with alternative or disjoint paths")
+	}
+	if pathRequest.pathAlgorithm == PathAlgorithmConstrained {
+		return fmt.Errorf("Pareto front can not be combined with the constrained path algorithm")
+	}
+	if hasToleranceValue(pathRequest.intents) {
+		return fmt.Errorf("Pareto front can not be combined with lexicographic intent ordering")
+	}
+	pathRequest.selectionPolicy = selectionPolicy
+	return nil
+}
+
+func (pathRequest *DomainPathRequest) GetMaxHopCount() uint32 {
+	return pathRequest.maxHopCount
+}
+
+func (pathRequest *DomainPathRequest) SetMaxHopCount(maxHopCount uint32) {
+	pathRequest.maxHopCount = maxHopCount
+}
+
+func (pathRequest *DomainPathRequest) GetMaxSidDepth() uint32 {
+	return pathRequest.maxSidDepth
+}
+
+func (pathRequest *DomainPathRequest) SetMaxSidDepth(maxSidDepth uint32) {
+	pathRequest.maxSidDepth = maxSidDepth
+}
+
+func (pathRequest *DomainPathRequest) GetStrictPath() bool {
+	return pathRequest.strictPath
+}
+
+func (pathRequest *DomainPathRequest) SetStrictPath(strictPath bool) {
+	pathRequest.strictPath = strictPath
+}
+
+func (pathRequest *DomainPathRequest) GetBandwidthDemand() uint32 {
+	return pathRequest.bandwidthDemand
+}
+
+func (pathRequest *DomainPathRequest) SetBandwidthDemand(bandwidthDemand uint32) {
+	pathRequest.bandwidthDemand = bandwidthDemand
+}
+
+func (pathRequest *DomainPathRequest) GetSetupPriority() uint32 {
+	return pathRequest.setupPriority
+}
+
+func (pathRequest *DomainPathRequest) GetHoldPriority() uint32 {
+	return pathRequest.holdPriority
+}
+
+func (pathRequest *DomainPathRequest) SetPriorities(setupPriority, holdPriority uint32) error {
+	if setupPriority > LowestPriority || holdPriority > LowestPriority {
+		return fmt.Errorf("Setup and hold priority have to be between %d and %d", HighestPriority, LowestPriority)
+	}
+	if holdPriority > setupPriority {
+		return fmt.Errorf("Hold priority %d can not be lower than setup priority %d", holdPriority, setupPriority)
+	}
+	pathRequest.setupPriority = setupPriority
+	pathRequest.holdPriority = holdPriority
+	return nil
+}
+
+func (pathRequest *DomainPathRequest) GetHysteresisPercentage() float64 {
+	return pathRequest.hysteresisPercentage
+}
+
+func (pathRequest *DomainPathRequest) GetHoldDownTime() time.Duration {
+	return time.Duration(pathRequest.holdDownTime) * time.Second
+}
+
+func (pathRequest *DomainPathRequest) GetFlapDampingHalfLife() time.Duration {
+	return time.Duration(pathRequest.flapDampingHalfLife) * time.Second
+}
+
+func (pathRequest *DomainPathRequest) SetPathStability(hysteresisPercentage float64, holdDownTime, flapDampingHalfLife uint32) error {
+	if hysteresisPercentage < 0 || hysteresisPercentage > 100 || math.IsNaN(hysteresisPercentage) {
+		return fmt.Errorf("Hysteresis has to be between 0 and 100 percent, got %v", hysteresisPercentage)
+	}
+	pathRequest.hysteresisPercentage = hysteresisPercentage
+	pathRequest.holdDownTime = holdDownTime
+	pathRequest.flapDampingHalfLife = flapDampingHalfLife
+	return nil
+}
+
+func (pathRequest *DomainPathRequest) GetRequestId() string {
+	return pathRequest.requestId
+}
+
+func (pathRequest *DomainPathRequest) SetRequestId(requestId string) {
+	pathRequest.requestId = requestId
+}
+
+func (pathRequest *DomainPathRequest) GetSessionId() string {
+	return pathRequest.sessionId
+}
+
+func (pathRequest *DomainPathRequest) SetSessionId(sessionId string) {
+	pathRequest.sessionId = sessionId
+}
+
 func (pathRequest *DomainPathRequest) Serialize() string {
 	serialization := pathRequest.ipv6SourceAddress + "," + pathRequest.ipv6DestinationAddress + ","
 	for i := 0; i < len(pathRequest.intents); i++ {
@@ -174,5 +692,44 @@ func (pathRequest *DomainPathRequest) Serialize() string {
 			serialization += pathRequest.intents[i].Serialize() + ","
 		}
 	}
+	if pathRequest.alternativePathCount > 0 {
+		serialization += ",AlternativePaths:" + strconv.Itoa(int(pathRequest.alternativePathCount))
+	}
+	if pathRequest.disjointnessType != DisjointnessTypeNone {
+		serialization += ",Disjointness:" + pathRequest.disjointnessType.String()
+	}
+	if pathRequest.pathAlgorithm != PathAlgorithmDefault {
+		serialization += ",PathAlgorithm:" + pathRequest.pathAlgorithm.String()
+	}
+	if pathRequest.selectionPolicy != SelectionPolicyNone {
+		serialization += ",ParetoFront:" + pathRequest.selectionPolicy.String()
+	}
+	if pathRequest.maxHopCount > 0 {
+		serialization += ",MaxHops:" + strconv.Itoa(int(pathRequest.maxHopCount))
+	}
+	if pathRequest.maxSidDepth > 0 {
+		serialization += ",MaxSidDepth:" + strconv.Itoa(int(pathRequest.maxSidDepth))
+	}
+	if pathRequest.strictPath {
+		serialization += ",StrictPath"
+	}
+	if pathRequest.bandwidthDemand > 0 {
+		serialization += ",BandwidthDemand:" + strconv.Itoa(int(pathRequest.bandwidthDemand))
+	}
+	if pathRequest.setupPriority != LowestPriority {
+		serialization += ",SetupPriority:" + strconv.Itoa(int(pathRequest.setupPriority))
+	}
+	if pathRequest.holdPriority != LowestPriority {
+		serialization += ",HoldPriority:" + strconv.Itoa(int(pathRequest.holdPriority))
+	}
+	if pathRequest.hysteresisPercentage > 0 {
+		serialization += ",Hysteresis:" + strconv.FormatFloat(pathRequest.hysteresisPercentage, 'f', -1, 64)
+	}
+	if pathRequest.holdDownTime > 0 {
+		serialization += ",HoldDownTime:" + strconv.Itoa(int(pathRequest.holdDownTime))
+	}
+	if pathRequest.flapDampingHalfLife > 0 {
+		serialization += ",FlapDampingHalfLife:" + strconv.Itoa(int(pathRequest.flapDampingHalfLife))
+	}
 	return serialization
 }
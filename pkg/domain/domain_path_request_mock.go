@@ -12,6 +12,7 @@ package domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	api "github.com/hawkv6/hawkeye/pkg/api"
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,34 @@ func (m *MockPathRequest) EXPECT() *MockPathRequestMockRecorder {
 	return m.recorder
 }
 
+// GetAlternativePathCount mocks base method.
+func (m *MockPathRequest) GetAlternativePathCount() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlternativePathCount")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetAlternativePathCount indicates an expected call of GetAlternativePathCount.
+func (mr *MockPathRequestMockRecorder) GetAlternativePathCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlternativePathCount", reflect.TypeOf((*MockPathRequest)(nil).GetAlternativePathCount))
+}
+
+// GetBandwidthDemand mocks base method.
+func (m *MockPathRequest) GetBandwidthDemand() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBandwidthDemand")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetBandwidthDemand indicates an expected call of GetBandwidthDemand.
+func (mr *MockPathRequestMockRecorder) GetBandwidthDemand() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBandwidthDemand", reflect.TypeOf((*MockPathRequest)(nil).GetBandwidthDemand))
+}
+
 // GetContext mocks base method.
 func (m *MockPathRequest) GetContext() context.Context {
 	m.ctrl.T.Helper()
@@ -54,6 +83,76 @@ func (mr *MockPathRequestMockRecorder) GetContext() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContext", reflect.TypeOf((*MockPathRequest)(nil).GetContext))
 }
 
+// GetDisjointnessType mocks base method.
+func (m *MockPathRequest) GetDisjointnessType() DisjointnessType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisjointnessType")
+	ret0, _ := ret[0].(DisjointnessType)
+	return ret0
+}
+
+// GetDisjointnessType indicates an expected call of GetDisjointnessType.
+func (mr *MockPathRequestMockRecorder) GetDisjointnessType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisjointnessType", reflect.TypeOf((*MockPathRequest)(nil).GetDisjointnessType))
+}
+
+// GetFlapDampingHalfLife mocks base method.
+func (m *MockPathRequest) GetFlapDampingHalfLife() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlapDampingHalfLife")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetFlapDampingHalfLife indicates an expected call of GetFlapDampingHalfLife.
+func (mr *MockPathRequestMockRecorder) GetFlapDampingHalfLife() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlapDampingHalfLife", reflect.TypeOf((*MockPathRequest)(nil).GetFlapDampingHalfLife))
+}
+
+// GetHoldDownTime mocks base method.
+func (m *MockPathRequest) GetHoldDownTime() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldDownTime")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetHoldDownTime indicates an expected call of GetHoldDownTime.
+func (mr *MockPathRequestMockRecorder) GetHoldDownTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldDownTime", reflect.TypeOf((*MockPathRequest)(nil).GetHoldDownTime))
+}
+
+// GetHoldPriority mocks base method.
+func (m *MockPathRequest) GetHoldPriority() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldPriority")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetHoldPriority indicates an expected call of GetHoldPriority.
+func (mr *MockPathRequestMockRecorder) GetHoldPriority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldPriority", reflect.TypeOf((*MockPathRequest)(nil).GetHoldPriority))
+}
+
+// GetHysteresisPercentage mocks base method.
+func (m *MockPathRequest) GetHysteresisPercentage() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHysteresisPercentage")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// GetHysteresisPercentage indicates an expected call of GetHysteresisPercentage.
+func (mr *MockPathRequestMockRecorder) GetHysteresisPercentage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHysteresisPercentage", reflect.TypeOf((*MockPathRequest)(nil).GetHysteresisPercentage))
+}
+
 // GetIntents mocks base method.
 func (m *MockPathRequest) GetIntents() []Intent {
 	m.ctrl.T.Helper()
@@ -96,6 +195,104 @@ func (mr *MockPathRequestMockRecorder) GetIpv6SourceAddress() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv6SourceAddress", reflect.TypeOf((*MockPathRequest)(nil).GetIpv6SourceAddress))
 }
 
+// GetMaxHopCount mocks base method.
+func (m *MockPathRequest) GetMaxHopCount() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxHopCount")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetMaxHopCount indicates an expected call of GetMaxHopCount.
+func (mr *MockPathRequestMockRecorder) GetMaxHopCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxHopCount", reflect.TypeOf((*MockPathRequest)(nil).GetMaxHopCount))
+}
+
+// GetMaxSidDepth mocks base method.
+func (m *MockPathRequest) GetMaxSidDepth() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxSidDepth")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetMaxSidDepth indicates an expected call of GetMaxSidDepth.
+func (mr *MockPathRequestMockRecorder) GetMaxSidDepth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxSidDepth", reflect.TypeOf((*MockPathRequest)(nil).GetMaxSidDepth))
+}
+
+// GetPathAlgorithm mocks base method.
+func (m *MockPathRequest) GetPathAlgorithm() PathAlgorithm {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPathAlgorithm")
+	ret0, _ := ret[0].(PathAlgorithm)
+	return ret0
+}
+
+// GetPathAlgorithm indicates an expected call of GetPathAlgorithm.
+func (mr *MockPathRequestMockRecorder) GetPathAlgorithm() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathAlgorithm", reflect.TypeOf((*MockPathRequest)(nil).GetPathAlgorithm))
+}
+
+// GetRequestId mocks base method.
+func (m *MockPathRequest) GetRequestId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRequestId indicates an expected call of GetRequestId.
+func (mr *MockPathRequestMockRecorder) GetRequestId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestId", reflect.TypeOf((*MockPathRequest)(nil).GetRequestId))
+}
+
+// GetSelectionPolicy mocks base method.
+func (m *MockPathRequest) GetSelectionPolicy() SelectionPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelectionPolicy")
+	ret0, _ := ret[0].(SelectionPolicy)
+	return ret0
+}
+
+// GetSelectionPolicy indicates an expected call of GetSelectionPolicy.
+func (mr *MockPathRequestMockRecorder) GetSelectionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectionPolicy", reflect.TypeOf((*MockPathRequest)(nil).GetSelectionPolicy))
+}
+
+// GetSessionId mocks base method.
+func (m *MockPathRequest) GetSessionId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetSessionId indicates an expected call of GetSessionId.
+func (mr *MockPathRequestMockRecorder) GetSessionId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionId", reflect.TypeOf((*MockPathRequest)(nil).GetSessionId))
+}
+
+// GetSetupPriority mocks base method.
+func (m *MockPathRequest) GetSetupPriority() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSetupPriority")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetSetupPriority indicates an expected call of GetSetupPriority.
+func (mr *MockPathRequestMockRecorder) GetSetupPriority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSetupPriority", reflect.TypeOf((*MockPathRequest)(nil).GetSetupPriority))
+}
+
 // GetStream mocks base method.
 func (m *MockPathRequest) GetStream() api.IntentController_GetIntentPathServer {
 	m.ctrl.T.Helper()
@@ -110,6 +307,20 @@ func (mr *MockPathRequestMockRecorder) GetStream() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStream", reflect.TypeOf((*MockPathRequest)(nil).GetStream))
 }
 
+// GetStrictPath mocks base method.
+func (m *MockPathRequest) GetStrictPath() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStrictPath")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetStrictPath indicates an expected call of GetStrictPath.
+func (mr *MockPathRequestMockRecorder) GetStrictPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStrictPath", reflect.TypeOf((*MockPathRequest)(nil).GetStrictPath))
+}
+
 // Serialize mocks base method.
 func (m *MockPathRequest) Serialize() string {
 	m.ctrl.T.Helper()
@@ -123,3 +334,159 @@ func (mr *MockPathRequestMockRecorder) Serialize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serialize", reflect.TypeOf((*MockPathRequest)(nil).Serialize))
 }
+
+// SetAlternativePathCount mocks base method.
+func (m *MockPathRequest) SetAlternativePathCount(arg0 uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlternativePathCount", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlternativePathCount indicates an expected call of SetAlternativePathCount.
+func (mr *MockPathRequestMockRecorder) SetAlternativePathCount(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlternativePathCount", reflect.TypeOf((*MockPathRequest)(nil).SetAlternativePathCount), arg0)
+}
+
+// SetBandwidthDemand mocks base method.
+func (m *MockPathRequest) SetBandwidthDemand(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBandwidthDemand", arg0)
+}
+
+// SetBandwidthDemand indicates an expected call of SetBandwidthDemand.
+func (mr *MockPathRequestMockRecorder) SetBandwidthDemand(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBandwidthDemand", reflect.TypeOf((*MockPathRequest)(nil).SetBandwidthDemand), arg0)
+}
+
+// SetDisjointnessType mocks base method.
+func (m *MockPathRequest) SetDisjointnessType(arg0 DisjointnessType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDisjointnessType", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDisjointnessType indicates an expected call of SetDisjointnessType.
+func (mr *MockPathRequestMockRecorder) SetDisjointnessType(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDisjointnessType", reflect.TypeOf((*MockPathRequest)(nil).SetDisjointnessType), arg0)
+}
+
+// SetMaxHopCount mocks base method.
+func (m *MockPathRequest) SetMaxHopCount(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxHopCount", arg0)
+}
+
+// SetMaxHopCount indicates an expected call of SetMaxHopCount.
+func (mr *MockPathRequestMockRecorder) SetMaxHopCount(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxHopCount", reflect.TypeOf((*MockPathRequest)(nil).SetMaxHopCount), arg0)
+}
+
+// SetMaxSidDepth mocks base method.
+func (m *MockPathRequest) SetMaxSidDepth(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxSidDepth", arg0)
+}
+
+// SetMaxSidDepth indicates an expected call of SetMaxSidDepth.
+func (mr *MockPathRequestMockRecorder) SetMaxSidDepth(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxSidDepth", reflect.TypeOf((*MockPathRequest)(nil).SetMaxSidDepth), arg0)
+}
+
+// SetPathAlgorithm mocks base method.
+func (m *MockPathRequest) SetPathAlgorithm(arg0 PathAlgorithm) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPathAlgorithm", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPathAlgorithm indicates an expected call of SetPathAlgorithm.
+func (mr *MockPathRequestMockRecorder) SetPathAlgorithm(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPathAlgorithm", reflect.TypeOf((*MockPathRequest)(nil).SetPathAlgorithm), arg0)
+}
+
+// SetPathStability mocks base method.
+func (m *MockPathRequest) SetPathStability(arg0 float64, arg1, arg2 uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPathStability", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPathStability indicates an expected call of SetPathStability.
+func (mr *MockPathRequestMockRecorder) SetPathStability(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPathStability", reflect.TypeOf((*MockPathRequest)(nil).SetPathStability), arg0, arg1, arg2)
+}
+
+// SetPriorities mocks base method.
+func (m *MockPathRequest) SetPriorities(arg0, arg1 uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPriorities", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPriorities indicates an expected call of SetPriorities.
+func (mr *MockPathRequestMockRecorder) SetPriorities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPriorities", reflect.TypeOf((*MockPathRequest)(nil).SetPriorities), arg0, arg1)
+}
+
+// SetRequestId mocks base method.
+func (m *MockPathRequest) SetRequestId(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRequestId", arg0)
+}
+
+// SetRequestId indicates an expected call of SetRequestId.
+func (mr *MockPathRequestMockRecorder) SetRequestId(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRequestId", reflect.TypeOf((*MockPathRequest)(nil).SetRequestId), arg0)
+}
+
+// SetSelectionPolicy mocks base method.
+func (m *MockPathRequest) SetSelectionPolicy(arg0 SelectionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSelectionPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSelectionPolicy indicates an expected call of SetSelectionPolicy.
+func (mr *MockPathRequestMockRecorder) SetSelectionPolicy(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSelectionPolicy", reflect.TypeOf((*MockPathRequest)(nil).SetSelectionPolicy), arg0)
+}
+
+// SetSessionId mocks base method.
+func (m *MockPathRequest) SetSessionId(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSessionId", arg0)
+}
+
+// SetSessionId indicates an expected call of SetSessionId.
+func (mr *MockPathRequestMockRecorder) SetSessionId(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionId", reflect.TypeOf((*MockPathRequest)(nil).SetSessionId), arg0)
+}
+
+// SetStrictPath mocks base method.
+func (m *MockPathRequest) SetStrictPath(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetStrictPath", arg0)
+}
+
+// SetStrictPath indicates an expected call of SetStrictPath.
+func (mr *MockPathRequestMockRecorder) SetStrictPath(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStrictPath", reflect.TypeOf((*MockPathRequest)(nil).SetStrictPath), arg0)
+}
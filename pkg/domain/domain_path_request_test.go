@@ -2,10 +2,13 @@ package domain
 
 import (
 	"context"
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hawkv6/hawkeye/pkg/api"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/proto"
 )
@@ -53,6 +56,18 @@ func TestDomainPathRequest_validateMinMaxValues(t *testing.T) {
 			values:     []Value{getNumberValue(ValueTypeMinValue, proto.Int32(100000))},
 			wantErr:    true,
 		},
+		{
+			name:       "Test validateMinMaxValues low latency with tolerance",
+			intentType: IntentTypeLowLatency,
+			values:     []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))},
+			wantErr:    false,
+		},
+		{
+			name:       "Test validateMinMaxValues error flex algo with tolerance",
+			intentType: IntentTypeFlexAlgo,
+			values:     []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))},
+			wantErr:    true,
+		},
 		{
 			name:       "Test validateMinMaxValues error intent type unspecified with min value",
 			intentType: IntentTypeUnspecified,
@@ -154,6 +169,27 @@ func TestDomainPathRequest_validateFlexAlgoIntentType(t *testing.T) {
 	}
 }
 
+func getServiceFunctionChainIntentWithServiceOrder(unordered bool, services []string, serviceOrder ...ServiceOrder) Intent {
+	values := make([]Value, len(services))
+	for index, service := range services {
+		values[index] = GetStringValue(ValueTypeSFC, proto.String(service))
+	}
+	intent := NewDomainIntent(IntentTypeSFC, values)
+	intent.SetUnordered(unordered)
+	intent.SetServiceOrder(serviceOrder)
+	return intent
+}
+
+func getServiceFunctionChainIntentWithOptionalServices(services, optionalServices []string) Intent {
+	intent := getServiceFunctionChainIntentWithServiceOrder(false, services)
+	intent.SetOptionalServices(optionalServices)
+	return intent
+}
+
+func getUnorderedServiceFunctionChainIntent(services []string, serviceOrder ...ServiceOrder) Intent {
+	return getServiceFunctionChainIntentWithServiceOrder(true, services, serviceOrder...)
+}
+
 func TestDomainPathRequest_validateServiceFunctionChainIntentType(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -191,6 +227,66 @@ func TestDomainPathRequest_validateServiceFunctionChainIntentType(t *testing.T)
 			intentType: IntentTypeSFC,
 			wantErr:    false,
 		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType unordered",
+			intent:     getUnorderedServiceFunctionChainIntent([]string{"fw", "ids", "nat"}),
+			intentType: IntentTypeSFC,
+			wantErr:    false,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType unordered with service order",
+			intent:     getUnorderedServiceFunctionChainIntent([]string{"fw", "ids", "nat"}, NewServiceOrder("fw", "ids"), NewServiceOrder("nat", "ids")),
+			intentType: IntentTypeSFC,
+			wantErr:    false,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType service order without unordered flag",
+			intent:     getServiceFunctionChainIntentWithServiceOrder(false, []string{"fw", "ids"}, NewServiceOrder("fw", "ids")),
+			intentType: IntentTypeSFC,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType service order with unknown service",
+			intent:     getUnorderedServiceFunctionChainIntent([]string{"fw", "ids"}, NewServiceOrder("fw", "nat")),
+			intentType: IntentTypeSFC,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType service order with same service",
+			intent:     getUnorderedServiceFunctionChainIntent([]string{"fw", "ids"}, NewServiceOrder("fw", "fw")),
+			intentType: IntentTypeSFC,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType service order with cycle",
+			intent:     getUnorderedServiceFunctionChainIntent([]string{"fw", "ids", "nat"}, NewServiceOrder("fw", "ids"), NewServiceOrder("ids", "nat"), NewServiceOrder("nat", "fw")),
+			intentType: IntentTypeSFC,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType optional service",
+			intent:     getServiceFunctionChainIntentWithOptionalServices([]string{"fw", "ids"}, []string{"ids"}),
+			intentType: IntentTypeSFC,
+			wantErr:    false,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType unknown optional service",
+			intent:     getServiceFunctionChainIntentWithOptionalServices([]string{"fw", "ids"}, []string{"nat"}),
+			intentType: IntentTypeSFC,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType optional service on other intent",
+			intent:     getServiceFunctionChainIntentWithOptionalServices([]string{}, []string{"nat"}),
+			intentType: IntentTypeLowLatency,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateServiceFunctionChainIntentType unordered flag on other intent",
+			intent:     getServiceFunctionChainIntentWithServiceOrder(true, []string{}),
+			intentType: IntentTypeLowLatency,
+			wantErr:    true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -202,6 +298,256 @@ func TestDomainPathRequest_validateServiceFunctionChainIntentType(t *testing.T)
 	}
 }
 
+func TestDomainPathRequest_validateTopologyIntentType(t *testing.T) {
+	tests := []struct {
+		name       string
+		intent     Intent
+		intentType IntentType
+		wantErr    bool
+	}{
+		{
+			name:       "Test validateTopologyIntentType other intent type",
+			intent:     NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			intentType: IntentTypeLowLatency,
+			wantErr:    false,
+		},
+		{
+			name:       "Test validateTopologyIntentType no values",
+			intent:     NewDomainIntent(IntentTypeExcludeNodes, []Value{}),
+			intentType: IntentTypeExcludeNodes,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateTopologyIntentType link value for node intent",
+			intent:     NewDomainIntent(IntentTypeIncludeNodes, []Value{GetStringValue(ValueTypeLink, proto.String("link1"))}),
+			intentType: IntentTypeIncludeNodes,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateTopologyIntentType node value for link intent",
+			intent:     NewDomainIntent(IntentTypeExcludeLinks, []Value{GetStringValue(ValueTypeNode, proto.String("2.2.2.2"))}),
+			intentType: IntentTypeExcludeLinks,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateTopologyIntentType empty value",
+			intent:     NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String(""))}),
+			intentType: IntentTypeExcludeNodes,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateTopologyIntentType twice the same value",
+			intent:     NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2")), GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+			intentType: IntentTypeExcludeNodes,
+			wantErr:    true,
+		},
+		{
+			name:       "Test validateTopologyIntentType correct node values",
+			intent:     NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2")), GetStringValue(ValueTypeNode, proto.String("3.3.3.3"))}),
+			intentType: IntentTypeExcludeNodes,
+			wantErr:    false,
+		},
+		{
+			name:       "Test validateTopologyIntentType correct link value",
+			intent:     NewDomainIntent(IntentTypeIncludeLinks, []Value{GetStringValue(ValueTypeLink, proto.String("link1"))}),
+			intentType: IntentTypeIncludeLinks,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTopologyIntentType(tt.intent, tt.intentType)
+			if (err != nil) != tt.wantErr {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDomainPathRequest_validateTopologyIntents(t *testing.T) {
+	tests := []struct {
+		name    string
+		intents []Intent
+		wantErr bool
+	}{
+		{
+			name: "Test validateTopologyIntents without node or link intents",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateTopologyIntents exclude and include after other intents",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+				NewDomainIntent(IntentTypeIncludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-3"))}),
+				NewDomainIntent(IntentTypeExcludeLinks, []Value{GetStringValue(ValueTypeLink, proto.String("link1"))}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateTopologyIntents only node intent",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateTopologyIntents node intent before other intent",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+				NewDomainIntent(IntentTypeLowJitter, []Value{}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateTopologyIntents node excluded and included",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+				NewDomainIntent(IntentTypeIncludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateTopologyIntents link excluded and included",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeExcludeLinks, []Value{GetStringValue(ValueTypeLink, proto.String("link1"))}),
+				NewDomainIntent(IntentTypeIncludeLinks, []Value{GetStringValue(ValueTypeLink, proto.String("link1"))}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateTopologyIntents include nodes and links",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeIncludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+				NewDomainIntent(IntentTypeIncludeLinks, []Value{GetStringValue(ValueTypeLink, proto.String("link1"))}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateTopologyIntents exclude nodes with sfc",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))}),
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateTopologyIntents include nodes with sfc",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))}),
+				NewDomainIntent(IntentTypeIncludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateTopologyIntents combined with lexicographic intent ordering",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}),
+				NewDomainIntent(IntentTypeLowPacketLoss, []Value{}),
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTopologyIntents(tt.intents)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateTopologyIntents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func getWeightedIntent(intentType IntentType, values []Value, weight float64) Intent {
+	intent := NewDomainIntent(intentType, values)
+	intent.SetWeight(weight)
+	return intent
+}
+
+func TestDomainPathRequest_validateIntentWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		intents []Intent
+		wantErr bool
+	}{
+		{
+			name: "Test validateIntentWeights without weights",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeLowJitter, []Value{}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateIntentWeights weights on all intents",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeFlexAlgo, []Value{getNumberValue(ValueTypeFlexAlgoNr, proto.Int32(128))}),
+				getWeightedIntent(IntentTypeLowLatency, []Value{}, 0.6),
+				getWeightedIntent(IntentTypeLowJitter, []Value{}, 0.2),
+				getWeightedIntent(IntentTypeLowPacketLoss, []Value{}, 0.1),
+				getWeightedIntent(IntentTypeHighBandwidth, []Value{}, 0.1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateIntentWeights negative weight",
+			intents: []Intent{
+				getWeightedIntent(IntentTypeLowLatency, []Value{}, -0.5),
+				getWeightedIntent(IntentTypeLowJitter, []Value{}, 0.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateIntentWeights infinite weight",
+			intents: []Intent{
+				getWeightedIntent(IntentTypeLowLatency, []Value{}, math.Inf(1)),
+				getWeightedIntent(IntentTypeLowJitter, []Value{}, 0.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateIntentWeights weight on flex algo intent",
+			intents: []Intent{
+				getWeightedIntent(IntentTypeFlexAlgo, []Value{getNumberValue(ValueTypeFlexAlgoNr, proto.Int32(128))}, 0.5),
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateIntentWeights weight missing on one intent",
+			intents: []Intent{
+				getWeightedIntent(IntentTypeLowLatency, []Value{}, 0.5),
+				NewDomainIntent(IntentTypeLowJitter, []Value{}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateIntentWeights combined with lexicographic intent ordering",
+			intents: []Intent{
+				getWeightedIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}, 0.5),
+				getWeightedIntent(IntentTypeLowJitter, []Value{}, 0.5),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIntentWeights(tt.intents)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateIntentWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestDomainPathRequest_validateIntents(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -278,6 +624,47 @@ func TestDomainPathRequest_validateIntents(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Test validateIntents lexicographic ordering with tolerance",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}),
+				NewDomainIntent(IntentTypeLowPacketLoss, []Value{}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateIntents exclude node with wrong value",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateIntents exclude node with correct value",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test validateIntents weight on exclude node intent",
+			intents: []Intent{
+				getWeightedIntent(IntentTypeLowLatency, []Value{}, 0.5),
+				getWeightedIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))}, 0.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test validateIntents lexicographic ordering with sfc",
+			intents: []Intent{
+				NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))}),
+				NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}),
+				NewDomainIntent(IntentTypeLowPacketLoss, []Value{}),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -340,8 +727,10 @@ func TestNewDomainPathRequest(t *testing.T) {
 					NewDomainIntent(IntentTypeLowLatency, []Value{}),
 					NewDomainIntent(IntentTypeLowPacketLoss, []Value{}),
 				},
-				stream: api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
-				ctx:    context.Background(),
+				stream:        api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+				ctx:           context.Background(),
+				setupPriority: LowestPriority,
+				holdPriority:  LowestPriority,
 			},
 			wantErr: false,
 		},
@@ -362,8 +751,10 @@ func TestNewDomainPathRequest(t *testing.T) {
 					NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeMaxValue, proto.Int32(10))}),
 					NewDomainIntent(IntentTypeLowPacketLoss, []Value{getNumberValue(ValueTypeMaxValue, proto.Int32(20))}),
 				},
-				stream: api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
-				ctx:    context.Background(),
+				stream:        api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+				ctx:           context.Background(),
+				setupPriority: LowestPriority,
+				holdPriority:  LowestPriority,
 			},
 			wantErr: false,
 		},
@@ -406,8 +797,10 @@ func TestNewDomainPathRequest(t *testing.T) {
 				intents: []Intent{
 					NewDomainIntent(IntentTypeFlexAlgo, []Value{getNumberValue(ValueTypeFlexAlgoNr, proto.Int32(128))}),
 				},
-				stream: api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
-				ctx:    context.Background(),
+				stream:        api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+				ctx:           context.Background(),
+				setupPriority: LowestPriority,
+				holdPriority:  LowestPriority,
 			},
 			wantErr: false,
 		},
@@ -438,8 +831,10 @@ func TestNewDomainPathRequest(t *testing.T) {
 				intents: []Intent{
 					NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeMaxValue, proto.Int32(10))}),
 				},
-				stream: api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
-				ctx:    context.Background(),
+				stream:        api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+				ctx:           context.Background(),
+				setupPriority: LowestPriority,
+				holdPriority:  LowestPriority,
 			},
 			wantErr: false,
 		},
@@ -470,8 +865,10 @@ func TestNewDomainPathRequest(t *testing.T) {
 				intents: []Intent{
 					NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))}),
 				},
-				stream: api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
-				ctx:    context.Background(),
+				stream:        api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+				ctx:           context.Background(),
+				setupPriority: LowestPriority,
+				holdPriority:  LowestPriority,
 			},
 			wantErr: false,
 		},
@@ -494,8 +891,10 @@ func TestNewDomainPathRequest(t *testing.T) {
 					NewDomainIntent(IntentTypeFlexAlgo, []Value{getNumberValue(ValueTypeFlexAlgoNr, proto.Int32(128))}),
 					NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeMaxValue, proto.Int32(10))}),
 				},
-				stream: api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
-				ctx:    context.Background(),
+				stream:        api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+				ctx:           context.Background(),
+				setupPriority: LowestPriority,
+				holdPriority:  LowestPriority,
 			},
 			wantErr: false,
 		},
@@ -728,6 +1127,597 @@ func TestDomainPathRequest_GetStream(t *testing.T) {
 	}
 }
 
+func TestDomainPathRequest_GetAlternativePathCount(t *testing.T) {
+	tests := []struct {
+		name                 string
+		alternativePathCount uint32
+	}{
+		{
+			name:                 "Test GetAlternativePathCount",
+			alternativePathCount: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{alternativePathCount: tt.alternativePathCount}
+			assert.Equal(t, tt.alternativePathCount, pathRequest.GetAlternativePathCount())
+		})
+	}
+}
+
+func TestDomainPathRequest_SetAlternativePathCount(t *testing.T) {
+	tests := []struct {
+		name                 string
+		intents              []Intent
+		disjointnessType     DisjointnessType
+		alternativePathCount uint32
+		wantErr              bool
+	}{
+		{
+			name:                 "Test SetAlternativePathCount valid count",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			alternativePathCount: 2,
+			wantErr:              false,
+		},
+		{
+			name:                 "Test SetAlternativePathCount too many alternative paths",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			alternativePathCount: MaximumAlternativePathCount + 1,
+			wantErr:              true,
+		},
+		{
+			name:                 "Test SetAlternativePathCount service function chain",
+			intents:              []Intent{NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))})},
+			alternativePathCount: 2,
+			wantErr:              true,
+		},
+		{
+			name:                 "Test SetAlternativePathCount combined with disjoint paths",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			disjointnessType:     DisjointnessTypeLink,
+			alternativePathCount: 2,
+			wantErr:              true,
+		},
+		{
+			name:                 "Test SetAlternativePathCount combined with lexicographic intent ordering",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}), NewDomainIntent(IntentTypeLowJitter, []Value{})},
+			alternativePathCount: 2,
+			wantErr:              true,
+		},
+		{
+			name:                 "Test SetAlternativePathCount combined with node intent",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeExcludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))})},
+			alternativePathCount: 2,
+			wantErr:              true,
+		},
+		{
+			name:                 "Test SetAlternativePathCount zero for service function chain",
+			intents:              []Intent{NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))})},
+			alternativePathCount: 0,
+			wantErr:              false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest, err := NewDomainPathRequest("2001:db8::1", "2001:db8::2", tt.intents, api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)), context.Background())
+			assert.NoError(t, err)
+			assert.NoError(t, pathRequest.SetDisjointnessType(tt.disjointnessType))
+			err = pathRequest.SetAlternativePathCount(tt.alternativePathCount)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, uint32(0), pathRequest.GetAlternativePathCount())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.alternativePathCount, pathRequest.GetAlternativePathCount())
+			}
+		})
+	}
+}
+
+func TestDomainPathRequest_GetDisjointnessType(t *testing.T) {
+	tests := []struct {
+		name             string
+		disjointnessType DisjointnessType
+	}{
+		{
+			name:             "Test GetDisjointnessType",
+			disjointnessType: DisjointnessTypeNode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{disjointnessType: tt.disjointnessType}
+			assert.Equal(t, tt.disjointnessType, pathRequest.GetDisjointnessType())
+		})
+	}
+}
+
+func TestDomainPathRequest_SetDisjointnessType(t *testing.T) {
+	tests := []struct {
+		name                 string
+		intents              []Intent
+		alternativePathCount uint32
+		disjointnessType     DisjointnessType
+		wantErr              bool
+	}{
+		{
+			name:             "Test SetDisjointnessType link",
+			intents:          []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			disjointnessType: DisjointnessTypeLink,
+			wantErr:          false,
+		},
+		{
+			name:             "Test SetDisjointnessType node",
+			intents:          []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			disjointnessType: DisjointnessTypeNode,
+			wantErr:          false,
+		},
+		{
+			name:             "Test SetDisjointnessType unknown type not supported",
+			intents:          []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			disjointnessType: DisjointnessType(99),
+			wantErr:          true,
+		},
+		{
+			name:             "Test SetDisjointnessType service function chain",
+			intents:          []Intent{NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))})},
+			disjointnessType: DisjointnessTypeLink,
+			wantErr:          true,
+		},
+		{
+			name:             "Test SetDisjointnessType none for service function chain",
+			intents:          []Intent{NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))})},
+			disjointnessType: DisjointnessTypeNone,
+			wantErr:          false,
+		},
+		{
+			name:             "Test SetDisjointnessType combined with lexicographic intent ordering",
+			intents:          []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}), NewDomainIntent(IntentTypeLowJitter, []Value{})},
+			disjointnessType: DisjointnessTypeLink,
+			wantErr:          true,
+		},
+		{
+			name:             "Test SetDisjointnessType combined with link intent",
+			intents:          []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeExcludeLinks, []Value{GetStringValue(ValueTypeLink, proto.String("link1"))})},
+			disjointnessType: DisjointnessTypeLink,
+			wantErr:          true,
+		},
+		{
+			name:                 "Test SetDisjointnessType combined with alternative paths",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			alternativePathCount: 2,
+			disjointnessType:     DisjointnessTypeLink,
+			wantErr:              true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest, err := NewDomainPathRequest("2001:db8::1", "2001:db8::2", tt.intents, api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)), context.Background())
+			assert.NoError(t, err)
+			assert.NoError(t, pathRequest.SetAlternativePathCount(tt.alternativePathCount))
+			err = pathRequest.SetDisjointnessType(tt.disjointnessType)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, DisjointnessTypeNone, pathRequest.GetDisjointnessType())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.disjointnessType, pathRequest.GetDisjointnessType())
+			}
+		})
+	}
+}
+
+func TestDomainPathRequest_GetPathAlgorithm(t *testing.T) {
+	tests := []struct {
+		name          string
+		pathAlgorithm PathAlgorithm
+	}{
+		{
+			name:          "Test GetPathAlgorithm",
+			pathAlgorithm: PathAlgorithmConstrained,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{pathAlgorithm: tt.pathAlgorithm}
+			assert.Equal(t, tt.pathAlgorithm, pathRequest.GetPathAlgorithm())
+		})
+	}
+}
+
+func TestDomainPathRequest_SetPathAlgorithm(t *testing.T) {
+	tests := []struct {
+		name                 string
+		intents              []Intent
+		alternativePathCount uint32
+		disjointnessType     DisjointnessType
+		pathAlgorithm        PathAlgorithm
+		wantErr              bool
+	}{
+		{
+			name:          "Test SetPathAlgorithm constrained",
+			intents:       []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeMaxValue, proto.Int32(10))})},
+			pathAlgorithm: PathAlgorithmConstrained,
+			wantErr:       false,
+		},
+		{
+			name:          "Test SetPathAlgorithm dijkstra",
+			intents:       []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			pathAlgorithm: PathAlgorithmDijkstra,
+			wantErr:       false,
+		},
+		{
+			name:          "Test SetPathAlgorithm unknown algorithm",
+			intents:       []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			pathAlgorithm: PathAlgorithm(999),
+			wantErr:       true,
+		},
+		{
+			name:          "Test SetPathAlgorithm constrained for service function chain",
+			intents:       []Intent{NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))})},
+			pathAlgorithm: PathAlgorithmConstrained,
+			wantErr:       true,
+		},
+		{
+			name:          "Test SetPathAlgorithm constrained combined with node intent",
+			intents:       []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeMaxValue, proto.Int32(10))}), NewDomainIntent(IntentTypeIncludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))})},
+			pathAlgorithm: PathAlgorithmConstrained,
+			wantErr:       false,
+		},
+		{
+			name:          "Test SetPathAlgorithm dijkstra combined with node intent",
+			intents:       []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeIncludeNodes, []Value{GetStringValue(ValueTypeNode, proto.String("XR-2"))})},
+			pathAlgorithm: PathAlgorithmDijkstra,
+			wantErr:       false,
+		},
+		{
+			name:          "Test SetPathAlgorithm constrained combined with lexicographic intent ordering",
+			intents:       []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}), NewDomainIntent(IntentTypeLowPacketLoss, []Value{})},
+			pathAlgorithm: PathAlgorithmConstrained,
+			wantErr:       true,
+		},
+		{
+			name:                 "Test SetPathAlgorithm constrained combined with alternative paths",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			alternativePathCount: 2,
+			pathAlgorithm:        PathAlgorithmConstrained,
+			wantErr:              true,
+		},
+		{
+			name:             "Test SetPathAlgorithm constrained combined with disjoint paths",
+			intents:          []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			disjointnessType: DisjointnessTypeLink,
+			pathAlgorithm:    PathAlgorithmConstrained,
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest, err := NewDomainPathRequest("2001:db8::1", "2001:db8::2", tt.intents, api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)), context.Background())
+			assert.NoError(t, err)
+			assert.NoError(t, pathRequest.SetAlternativePathCount(tt.alternativePathCount))
+			assert.NoError(t, pathRequest.SetDisjointnessType(tt.disjointnessType))
+			err = pathRequest.SetPathAlgorithm(tt.pathAlgorithm)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, PathAlgorithmDefault, pathRequest.GetPathAlgorithm())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.pathAlgorithm, pathRequest.GetPathAlgorithm())
+			}
+		})
+	}
+}
+
+func TestDomainPathRequest_GetSelectionPolicy(t *testing.T) {
+	tests := []struct {
+		name            string
+		selectionPolicy SelectionPolicy
+	}{
+		{
+			name:            "Test GetSelectionPolicy",
+			selectionPolicy: SelectionPolicyKnee,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{selectionPolicy: tt.selectionPolicy}
+			assert.Equal(t, tt.selectionPolicy, pathRequest.GetSelectionPolicy())
+		})
+	}
+}
+
+func TestDomainPathRequest_SetSelectionPolicy(t *testing.T) {
+	tests := []struct {
+		name                 string
+		intents              []Intent
+		alternativePathCount uint32
+		pathAlgorithm        PathAlgorithm
+		selectionPolicy      SelectionPolicy
+		wantErr              bool
+	}{
+		{
+			name:            "Test SetSelectionPolicy weighted",
+			intents:         []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeLowPacketLoss, []Value{})},
+			selectionPolicy: SelectionPolicyWeighted,
+			wantErr:         false,
+		},
+		{
+			name:            "Test SetSelectionPolicy knee with flex algo and high bandwidth",
+			intents:         []Intent{NewDomainIntent(IntentTypeFlexAlgo, []Value{getNumberValue(ValueTypeFlexAlgoNr, proto.Int32(128))}), NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeHighBandwidth, []Value{})},
+			selectionPolicy: SelectionPolicyKnee,
+			wantErr:         false,
+		},
+		{
+			name:            "Test SetSelectionPolicy unknown policy",
+			intents:         []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeLowJitter, []Value{})},
+			selectionPolicy: SelectionPolicy(999),
+			wantErr:         true,
+		},
+		{
+			name:            "Test SetSelectionPolicy service function chain",
+			intents:         []Intent{NewDomainIntent(IntentTypeSFC, []Value{GetStringValue(ValueTypeSFC, proto.String("fw"))}), NewDomainIntent(IntentTypeLowLatency, []Value{})},
+			selectionPolicy: SelectionPolicyLexicographic,
+			wantErr:         true,
+		},
+		{
+			name:            "Test SetSelectionPolicy low utilization not supported",
+			intents:         []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeLowUtilization, []Value{})},
+			selectionPolicy: SelectionPolicyWeighted,
+			wantErr:         true,
+		},
+		{
+			name:                 "Test SetSelectionPolicy combined with alternative paths",
+			intents:              []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeLowJitter, []Value{})},
+			alternativePathCount: 2,
+			selectionPolicy:      SelectionPolicyWeighted,
+			wantErr:              true,
+		},
+		{
+			name:            "Test SetSelectionPolicy combined with lexicographic intent ordering",
+			intents:         []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeTolerance, proto.Int32(10))}), NewDomainIntent(IntentTypeLowJitter, []Value{})},
+			selectionPolicy: SelectionPolicyWeighted,
+			wantErr:         true,
+		},
+		{
+			name:            "Test SetSelectionPolicy combined with constrained path algorithm",
+			intents:         []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{}), NewDomainIntent(IntentTypeLowJitter, []Value{})},
+			pathAlgorithm:   PathAlgorithmConstrained,
+			selectionPolicy: SelectionPolicyWeighted,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest, err := NewDomainPathRequest("2001:db8::1", "2001:db8::2", tt.intents, api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)), context.Background())
+			assert.NoError(t, err)
+			assert.NoError(t, pathRequest.SetAlternativePathCount(tt.alternativePathCount))
+			assert.NoError(t, pathRequest.SetPathAlgorithm(tt.pathAlgorithm))
+			err = pathRequest.SetSelectionPolicy(tt.selectionPolicy)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, SelectionPolicyNone, pathRequest.GetSelectionPolicy())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.selectionPolicy, pathRequest.GetSelectionPolicy())
+			}
+		})
+	}
+}
+
+func TestDomainPathRequest_MaxHopCount(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxHopCount uint32
+	}{
+		{
+			name:        "Test MaxHopCount unlimited",
+			maxHopCount: 0,
+		},
+		{
+			name:        "Test MaxHopCount limited",
+			maxHopCount: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{}
+			pathRequest.SetMaxHopCount(tt.maxHopCount)
+			assert.Equal(t, tt.maxHopCount, pathRequest.GetMaxHopCount())
+		})
+	}
+}
+
+func TestDomainPathRequest_MaxSidDepth(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxSidDepth uint32
+	}{
+		{
+			name:        "Test MaxSidDepth unlimited",
+			maxSidDepth: 0,
+		},
+		{
+			name:        "Test MaxSidDepth limited",
+			maxSidDepth: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{}
+			pathRequest.SetMaxSidDepth(tt.maxSidDepth)
+			assert.Equal(t, tt.maxSidDepth, pathRequest.GetMaxSidDepth())
+		})
+	}
+}
+
+func TestDomainPathRequest_StrictPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		strictPath bool
+	}{
+		{
+			name:       "Test StrictPath disabled",
+			strictPath: false,
+		},
+		{
+			name:       "Test StrictPath enabled",
+			strictPath: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{}
+			pathRequest.SetStrictPath(tt.strictPath)
+			assert.Equal(t, tt.strictPath, pathRequest.GetStrictPath())
+		})
+	}
+}
+
+func TestDomainPathRequest_BandwidthDemand(t *testing.T) {
+	tests := []struct {
+		name            string
+		bandwidthDemand uint32
+	}{
+		{
+			name:            "Test BandwidthDemand without demand",
+			bandwidthDemand: 0,
+		},
+		{
+			name:            "Test BandwidthDemand with demand",
+			bandwidthDemand: 100000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{}
+			pathRequest.SetBandwidthDemand(tt.bandwidthDemand)
+			assert.Equal(t, tt.bandwidthDemand, pathRequest.GetBandwidthDemand())
+		})
+	}
+}
+
+func TestDomainPathRequest_SetPriorities(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupPriority uint32
+		holdPriority  uint32
+		wantErr       bool
+	}{
+		{
+			name:          "Test SetPriorities with default priorities",
+			setupPriority: 0,
+			holdPriority:  0,
+			wantErr:       false,
+		},
+		{
+			name:          "Test SetPriorities with hold priority higher than setup priority",
+			setupPriority: 7,
+			holdPriority:  3,
+			wantErr:       false,
+		},
+		{
+			name:          "Test SetPriorities with priority out of range",
+			setupPriority: 8,
+			holdPriority:  0,
+			wantErr:       true,
+		},
+		{
+			name:          "Test SetPriorities with hold priority lower than setup priority",
+			setupPriority: 2,
+			holdPriority:  5,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{}
+			err := pathRequest.SetPriorities(tt.setupPriority, tt.holdPriority)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.setupPriority, pathRequest.GetSetupPriority())
+			assert.Equal(t, tt.holdPriority, pathRequest.GetHoldPriority())
+		})
+	}
+}
+
+func TestDomainPathRequest_SetPathStability(t *testing.T) {
+	tests := []struct {
+		name                 string
+		hysteresisPercentage float64
+		holdDownTime         uint32
+		flapDampingHalfLife  uint32
+		wantErr              bool
+	}{
+		{
+			name:    "Test SetPathStability with default settings",
+			wantErr: false,
+		},
+		{
+			name:                 "Test SetPathStability with hysteresis, hold-down time and flap damping",
+			hysteresisPercentage: 25,
+			holdDownTime:         30,
+			flapDampingHalfLife:  300,
+			wantErr:              false,
+		},
+		{
+			name:                 "Test SetPathStability with hysteresis above 100 percent",
+			hysteresisPercentage: 120,
+			wantErr:              true,
+		},
+		{
+			name:                 "Test SetPathStability with negative hysteresis",
+			hysteresisPercentage: -5,
+			wantErr:              true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{}
+			err := pathRequest.SetPathStability(tt.hysteresisPercentage, tt.holdDownTime, tt.flapDampingHalfLife)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.hysteresisPercentage, pathRequest.GetHysteresisPercentage())
+			assert.Equal(t, time.Duration(tt.holdDownTime)*time.Second, pathRequest.GetHoldDownTime())
+			assert.Equal(t, time.Duration(tt.flapDampingHalfLife)*time.Second, pathRequest.GetFlapDampingHalfLife())
+		})
+	}
+}
+
+func TestDomainPathRequest_Identifiers(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestId string
+		sessionId string
+	}{
+		{
+			name: "Test DomainPathRequest without identifiers",
+		},
+		{
+			name:      "Test DomainPathRequest with request and session identifier",
+			requestId: "request-1",
+			sessionId: "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := &DomainPathRequest{}
+			serialized := pathRequest.Serialize()
+			pathRequest.SetRequestId(tt.requestId)
+			pathRequest.SetSessionId(tt.sessionId)
+			assert.Equal(t, tt.requestId, pathRequest.GetRequestId())
+			assert.Equal(t, tt.sessionId, pathRequest.GetSessionId())
+			assert.Equal(t, serialized, pathRequest.Serialize())
+		})
+	}
+}
+
 func TestDomainPathRequest_Serialize(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -736,6 +1726,20 @@ func TestDomainPathRequest_Serialize(t *testing.T) {
 		stream                 api.IntentController_GetIntentPathServer
 		ctx                    context.Context
 		intents                []Intent
+		alternativePathCount   uint32
+		disjointnessType       DisjointnessType
+		pathAlgorithm          PathAlgorithm
+		selectionPolicy        SelectionPolicy
+		maxHopCount            uint32
+		maxSidDepth            uint32
+		strictPath             bool
+		bandwidthDemand        uint32
+		setPriorities          bool
+		setupPriority          uint32
+		holdPriority           uint32
+		hysteresisPercentage   float64
+		holdDownTime           uint32
+		flapDampingHalfLife    uint32
 		want                   string
 	}{
 		{
@@ -774,6 +1778,148 @@ func TestDomainPathRequest_Serialize(t *testing.T) {
 			},
 			want: "2001:db8::1,2001:db8::2,SFC,SFC:fw,SFC:ids,FlexAlgo,FlexAlgoNr:128,LowLatency,MaxValue:10",
 		},
+		{
+			name:                   "Test Serialize with alternative paths",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			alternativePathCount: 2,
+			want:                 "2001:db8::1,2001:db8::2,LowLatency,AlternativePaths:2",
+		},
+		{
+			name:                   "Test Serialize with disjoint paths",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			disjointnessType: DisjointnessTypeNode,
+			want:             "2001:db8::1,2001:db8::2,LowLatency,Disjointness:Node",
+		},
+		{
+			name:                   "Test Serialize with constrained path algorithm",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{getNumberValue(ValueTypeMaxValue, proto.Int32(10))}),
+			},
+			pathAlgorithm: PathAlgorithmConstrained,
+			want:          "2001:db8::1,2001:db8::2,LowLatency,MaxValue:10,PathAlgorithm:Constrained",
+		},
+		{
+			name:                   "Test Serialize with pareto front",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+				NewDomainIntent(IntentTypeLowPacketLoss, []Value{}),
+			},
+			selectionPolicy: SelectionPolicyKnee,
+			want:            "2001:db8::1,2001:db8::2,LowLatency,LowPacketLoss,ParetoFront:Knee",
+		},
+		{
+			name:                   "Test Serialize with max hop count and max SID depth",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			maxHopCount: 5,
+			maxSidDepth: 4,
+			want:        "2001:db8::1,2001:db8::2,LowLatency,MaxHops:5,MaxSidDepth:4",
+		},
+		{
+			name:                   "Test Serialize with strict path",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			strictPath: true,
+			want:       "2001:db8::1,2001:db8::2,LowLatency,StrictPath",
+		},
+		{
+			name:                   "Test Serialize with bandwidth demand",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			bandwidthDemand: 100000,
+			want:            "2001:db8::1,2001:db8::2,LowLatency,BandwidthDemand:100000",
+		},
+		{
+			name:                   "Test Serialize with priorities",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			setPriorities: true,
+			setupPriority: 4,
+			holdPriority:  2,
+			want:          "2001:db8::1,2001:db8::2,LowLatency,SetupPriority:4,HoldPriority:2",
+		},
+		{
+			name:                   "Test Serialize with highest priorities",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			setPriorities: true,
+			setupPriority: HighestPriority,
+			holdPriority:  HighestPriority,
+			want:          "2001:db8::1,2001:db8::2,LowLatency,SetupPriority:0,HoldPriority:0",
+		},
+		{
+			name:                   "Test Serialize with lowest priorities",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			setPriorities: true,
+			setupPriority: LowestPriority,
+			holdPriority:  LowestPriority,
+			want:          "2001:db8::1,2001:db8::2,LowLatency",
+		},
+		{
+			name:                   "Test Serialize with path stability",
+			ipv6SourceAddress:      "2001:db8::1",
+			ipv6DestinationAddress: "2001:db8::2",
+			stream:                 api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t)),
+			ctx:                    context.Background(),
+			intents: []Intent{
+				NewDomainIntent(IntentTypeLowLatency, []Value{}),
+			},
+			hysteresisPercentage: 12.5,
+			holdDownTime:         30,
+			flapDampingHalfLife:  300,
+			want:                 "2001:db8::1,2001:db8::2,LowLatency,Hysteresis:12.5,HoldDownTime:30,FlapDampingHalfLife:300",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -782,6 +1928,18 @@ func TestDomainPathRequest_Serialize(t *testing.T) {
 				t.Error(err)
 				return
 			}
+			assert.NoError(t, pathRequest.SetAlternativePathCount(tt.alternativePathCount))
+			assert.NoError(t, pathRequest.SetDisjointnessType(tt.disjointnessType))
+			assert.NoError(t, pathRequest.SetPathAlgorithm(tt.pathAlgorithm))
+			assert.NoError(t, pathRequest.SetSelectionPolicy(tt.selectionPolicy))
+			pathRequest.SetMaxHopCount(tt.maxHopCount)
+			pathRequest.SetMaxSidDepth(tt.maxSidDepth)
+			pathRequest.SetStrictPath(tt.strictPath)
+			pathRequest.SetBandwidthDemand(tt.bandwidthDemand)
+			if tt.setPriorities {
+				assert.NoError(t, pathRequest.SetPriorities(tt.setupPriority, tt.holdPriority))
+			}
+			assert.NoError(t, pathRequest.SetPathStability(tt.hysteresisPercentage, tt.holdDownTime, tt.flapDampingHalfLife))
 			serialization := pathRequest.Serialize()
 			if serialization != tt.want {
 				t.Errorf("Serialize() = %v, want %v", serialization, tt.want)
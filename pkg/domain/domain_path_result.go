@@ -11,6 +11,19 @@ type PathResult interface {
 	GetIpv6SidAddresses() []string
 	GetServiceSidList() []string
 	SetServiceSidList([]string)
+	GetIncludedServices() []string
+	SetIncludedServices([]string)
+	GetAlternativePathResults() []PathResult
+	SetAlternativePathResults([]PathResult)
+	GetBackupPathResult() PathResult
+	SetBackupPathResult(PathResult)
+	GetParetoPathResults() []PathResult
+	SetParetoPathResults([]PathResult)
+	GetPath() graph.Path
+	GetFlexAlgoNumber() uint32
+	SetFlexAlgoNumber(uint32)
+	GetUpdateReason() UpdateReason
+	SetUpdateReason(UpdateReason)
 }
 
 type DomainPathResult struct {
@@ -18,6 +31,12 @@ type DomainPathResult struct {
 	graph.Path
 	ipv6SidAddresses    []string
 	serviceSidAddresses []string
+	includedServices    []string
+	alternativeResults  []PathResult
+	backupResult        PathResult
+	paretoResults       []PathResult
+	flexAlgoNumber      uint32
+	updateReason        UpdateReason
 }
 
 type DomainPathResultInput struct {
@@ -44,6 +63,15 @@ func NewDomainPathResult(pathRequest PathRequest, shortestPath graph.Path, ipv6S
 	return defaultPathResult, nil
 }
 
+// a displaced session has no path anymore, its result carries an empty SID list so the client stops using the released path
+func NewDomainDisplacedPathResult(pathRequest PathRequest) *DomainPathResult {
+	return &DomainPathResult{
+		PathRequest:      pathRequest,
+		ipv6SidAddresses: []string{},
+		updateReason:     UpdateReasonDisplaced,
+	}
+}
+
 func (pathResponse *DomainPathResult) GetIpv6SidAddresses() []string {
 	return pathResponse.ipv6SidAddresses
 }
@@ -55,3 +83,57 @@ func (pathResponse *DomainPathResult) GetServiceSidList() []string {
 func (pathResponse *DomainPathResult) SetServiceSidList(serviceSidAddresses []string) {
 	pathResponse.serviceSidAddresses = serviceSidAddresses
 }
+
+func (pathResponse *DomainPathResult) GetIncludedServices() []string {
+	return pathResponse.includedServices
+}
+
+func (pathResponse *DomainPathResult) SetIncludedServices(includedServices []string) {
+	pathResponse.includedServices = includedServices
+}
+
+func (pathResponse *DomainPathResult) GetAlternativePathResults() []PathResult {
+	return pathResponse.alternativeResults
+}
+
+func (pathResponse *DomainPathResult) SetAlternativePathResults(alternativeResults []PathResult) {
+	pathResponse.alternativeResults = alternativeResults
+}
+
+func (pathResponse *DomainPathResult) GetBackupPathResult() PathResult {
+	return pathResponse.backupResult
+}
+
+func (pathResponse *DomainPathResult) SetBackupPathResult(backupResult PathResult) {
+	pathResponse.backupResult = backupResult
+}
+
+func (pathResponse *DomainPathResult) GetParetoPathResults() []PathResult {
+	return pathResponse.paretoResults
+}
+
+func (pathResponse *DomainPathResult) SetParetoPathResults(paretoResults []PathResult) {
+	pathResponse.paretoResults = paretoResults
+}
+
+// the path is nil if no path has been found
+func (pathResponse *DomainPathResult) GetPath() graph.Path {
+	return pathResponse.Path
+}
+
+func (pathResponse *DomainPathResult) GetFlexAlgoNumber() uint32 {
+	return pathResponse.flexAlgoNumber
+}
+
+func (pathResponse *DomainPathResult) SetFlexAlgoNumber(flexAlgoNumber uint32) {
+	pathResponse.flexAlgoNumber = flexAlgoNumber
+}
+
+// the reason of the last path change of the session the path result belongs to
+func (pathResponse *DomainPathResult) GetUpdateReason() UpdateReason {
+	return pathResponse.updateReason
+}
+
+func (pathResponse *DomainPathResult) SetUpdateReason(updateReason UpdateReason) {
+	pathResponse.updateReason = updateReason
+}
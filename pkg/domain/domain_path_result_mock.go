@@ -12,6 +12,7 @@ package domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	api "github.com/hawkv6/hawkeye/pkg/api"
 	graph "github.com/hawkv6/hawkeye/pkg/graph"
@@ -41,6 +42,90 @@ func (m *MockPathResult) EXPECT() *MockPathResultMockRecorder {
 	return m.recorder
 }
 
+// GetAlternativePathCount mocks base method.
+func (m *MockPathResult) GetAlternativePathCount() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlternativePathCount")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetAlternativePathCount indicates an expected call of GetAlternativePathCount.
+func (mr *MockPathResultMockRecorder) GetAlternativePathCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlternativePathCount", reflect.TypeOf((*MockPathResult)(nil).GetAlternativePathCount))
+}
+
+// GetAlternativePathResults mocks base method.
+func (m *MockPathResult) GetAlternativePathResults() []PathResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlternativePathResults")
+	ret0, _ := ret[0].([]PathResult)
+	return ret0
+}
+
+// GetAlternativePathResults indicates an expected call of GetAlternativePathResults.
+func (mr *MockPathResultMockRecorder) GetAlternativePathResults() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlternativePathResults", reflect.TypeOf((*MockPathResult)(nil).GetAlternativePathResults))
+}
+
+// GetAlternativePaths mocks base method.
+func (m *MockPathResult) GetAlternativePaths() []graph.Path {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlternativePaths")
+	ret0, _ := ret[0].([]graph.Path)
+	return ret0
+}
+
+// GetAlternativePaths indicates an expected call of GetAlternativePaths.
+func (mr *MockPathResultMockRecorder) GetAlternativePaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlternativePaths", reflect.TypeOf((*MockPathResult)(nil).GetAlternativePaths))
+}
+
+// GetBackupPath mocks base method.
+func (m *MockPathResult) GetBackupPath() graph.Path {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackupPath")
+	ret0, _ := ret[0].(graph.Path)
+	return ret0
+}
+
+// GetBackupPath indicates an expected call of GetBackupPath.
+func (mr *MockPathResultMockRecorder) GetBackupPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupPath", reflect.TypeOf((*MockPathResult)(nil).GetBackupPath))
+}
+
+// GetBackupPathResult mocks base method.
+func (m *MockPathResult) GetBackupPathResult() PathResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackupPathResult")
+	ret0, _ := ret[0].(PathResult)
+	return ret0
+}
+
+// GetBackupPathResult indicates an expected call of GetBackupPathResult.
+func (mr *MockPathResultMockRecorder) GetBackupPathResult() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupPathResult", reflect.TypeOf((*MockPathResult)(nil).GetBackupPathResult))
+}
+
+// GetBandwidthDemand mocks base method.
+func (m *MockPathResult) GetBandwidthDemand() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBandwidthDemand")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetBandwidthDemand indicates an expected call of GetBandwidthDemand.
+func (mr *MockPathResultMockRecorder) GetBandwidthDemand() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBandwidthDemand", reflect.TypeOf((*MockPathResult)(nil).GetBandwidthDemand))
+}
+
 // GetBottleneckEdge mocks base method.
 func (m *MockPathResult) GetBottleneckEdge() graph.Edge {
 	m.ctrl.T.Helper()
@@ -83,6 +168,20 @@ func (mr *MockPathResultMockRecorder) GetContext() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContext", reflect.TypeOf((*MockPathResult)(nil).GetContext))
 }
 
+// GetDisjointnessType mocks base method.
+func (m *MockPathResult) GetDisjointnessType() DisjointnessType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisjointnessType")
+	ret0, _ := ret[0].(DisjointnessType)
+	return ret0
+}
+
+// GetDisjointnessType indicates an expected call of GetDisjointnessType.
+func (mr *MockPathResultMockRecorder) GetDisjointnessType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisjointnessType", reflect.TypeOf((*MockPathResult)(nil).GetDisjointnessType))
+}
+
 // GetEdges mocks base method.
 func (m *MockPathResult) GetEdges() []graph.Edge {
 	m.ctrl.T.Helper()
@@ -97,6 +196,90 @@ func (mr *MockPathResultMockRecorder) GetEdges() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEdges", reflect.TypeOf((*MockPathResult)(nil).GetEdges))
 }
 
+// GetFlapDampingHalfLife mocks base method.
+func (m *MockPathResult) GetFlapDampingHalfLife() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlapDampingHalfLife")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetFlapDampingHalfLife indicates an expected call of GetFlapDampingHalfLife.
+func (mr *MockPathResultMockRecorder) GetFlapDampingHalfLife() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlapDampingHalfLife", reflect.TypeOf((*MockPathResult)(nil).GetFlapDampingHalfLife))
+}
+
+// GetFlexAlgoNumber mocks base method.
+func (m *MockPathResult) GetFlexAlgoNumber() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlexAlgoNumber")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetFlexAlgoNumber indicates an expected call of GetFlexAlgoNumber.
+func (mr *MockPathResultMockRecorder) GetFlexAlgoNumber() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlexAlgoNumber", reflect.TypeOf((*MockPathResult)(nil).GetFlexAlgoNumber))
+}
+
+// GetHoldDownTime mocks base method.
+func (m *MockPathResult) GetHoldDownTime() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldDownTime")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetHoldDownTime indicates an expected call of GetHoldDownTime.
+func (mr *MockPathResultMockRecorder) GetHoldDownTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldDownTime", reflect.TypeOf((*MockPathResult)(nil).GetHoldDownTime))
+}
+
+// GetHoldPriority mocks base method.
+func (m *MockPathResult) GetHoldPriority() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldPriority")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetHoldPriority indicates an expected call of GetHoldPriority.
+func (mr *MockPathResultMockRecorder) GetHoldPriority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldPriority", reflect.TypeOf((*MockPathResult)(nil).GetHoldPriority))
+}
+
+// GetHysteresisPercentage mocks base method.
+func (m *MockPathResult) GetHysteresisPercentage() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHysteresisPercentage")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// GetHysteresisPercentage indicates an expected call of GetHysteresisPercentage.
+func (mr *MockPathResultMockRecorder) GetHysteresisPercentage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHysteresisPercentage", reflect.TypeOf((*MockPathResult)(nil).GetHysteresisPercentage))
+}
+
+// GetIncludedServices mocks base method.
+func (m *MockPathResult) GetIncludedServices() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncludedServices")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetIncludedServices indicates an expected call of GetIncludedServices.
+func (mr *MockPathResultMockRecorder) GetIncludedServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncludedServices", reflect.TypeOf((*MockPathResult)(nil).GetIncludedServices))
+}
+
 // GetIntents mocks base method.
 func (m *MockPathResult) GetIntents() []Intent {
 	m.ctrl.T.Helper()
@@ -153,6 +336,104 @@ func (mr *MockPathResultMockRecorder) GetIpv6SourceAddress() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv6SourceAddress", reflect.TypeOf((*MockPathResult)(nil).GetIpv6SourceAddress))
 }
 
+// GetMaxHopCount mocks base method.
+func (m *MockPathResult) GetMaxHopCount() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxHopCount")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetMaxHopCount indicates an expected call of GetMaxHopCount.
+func (mr *MockPathResultMockRecorder) GetMaxHopCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxHopCount", reflect.TypeOf((*MockPathResult)(nil).GetMaxHopCount))
+}
+
+// GetMaxSidDepth mocks base method.
+func (m *MockPathResult) GetMaxSidDepth() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxSidDepth")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetMaxSidDepth indicates an expected call of GetMaxSidDepth.
+func (mr *MockPathResultMockRecorder) GetMaxSidDepth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxSidDepth", reflect.TypeOf((*MockPathResult)(nil).GetMaxSidDepth))
+}
+
+// GetParetoPathResults mocks base method.
+func (m *MockPathResult) GetParetoPathResults() []PathResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParetoPathResults")
+	ret0, _ := ret[0].([]PathResult)
+	return ret0
+}
+
+// GetParetoPathResults indicates an expected call of GetParetoPathResults.
+func (mr *MockPathResultMockRecorder) GetParetoPathResults() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParetoPathResults", reflect.TypeOf((*MockPathResult)(nil).GetParetoPathResults))
+}
+
+// GetParetoPaths mocks base method.
+func (m *MockPathResult) GetParetoPaths() []graph.Path {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParetoPaths")
+	ret0, _ := ret[0].([]graph.Path)
+	return ret0
+}
+
+// GetParetoPaths indicates an expected call of GetParetoPaths.
+func (mr *MockPathResultMockRecorder) GetParetoPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParetoPaths", reflect.TypeOf((*MockPathResult)(nil).GetParetoPaths))
+}
+
+// GetPath mocks base method.
+func (m *MockPathResult) GetPath() graph.Path {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPath")
+	ret0, _ := ret[0].(graph.Path)
+	return ret0
+}
+
+// GetPath indicates an expected call of GetPath.
+func (mr *MockPathResultMockRecorder) GetPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPath", reflect.TypeOf((*MockPathResult)(nil).GetPath))
+}
+
+// GetPathAlgorithm mocks base method.
+func (m *MockPathResult) GetPathAlgorithm() PathAlgorithm {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPathAlgorithm")
+	ret0, _ := ret[0].(PathAlgorithm)
+	return ret0
+}
+
+// GetPathAlgorithm indicates an expected call of GetPathAlgorithm.
+func (mr *MockPathResultMockRecorder) GetPathAlgorithm() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathAlgorithm", reflect.TypeOf((*MockPathResult)(nil).GetPathAlgorithm))
+}
+
+// GetRequestId mocks base method.
+func (m *MockPathResult) GetRequestId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRequestId indicates an expected call of GetRequestId.
+func (mr *MockPathResultMockRecorder) GetRequestId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestId", reflect.TypeOf((*MockPathResult)(nil).GetRequestId))
+}
+
 // GetRouterServiceMap mocks base method.
 func (m *MockPathResult) GetRouterServiceMap() map[string]string {
 	m.ctrl.T.Helper()
@@ -167,6 +448,34 @@ func (mr *MockPathResultMockRecorder) GetRouterServiceMap() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterServiceMap", reflect.TypeOf((*MockPathResult)(nil).GetRouterServiceMap))
 }
 
+// GetSelectionPolicy mocks base method.
+func (m *MockPathResult) GetSelectionPolicy() SelectionPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelectionPolicy")
+	ret0, _ := ret[0].(SelectionPolicy)
+	return ret0
+}
+
+// GetSelectionPolicy indicates an expected call of GetSelectionPolicy.
+func (mr *MockPathResultMockRecorder) GetSelectionPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectionPolicy", reflect.TypeOf((*MockPathResult)(nil).GetSelectionPolicy))
+}
+
+// GetServiceCost mocks base method.
+func (m *MockPathResult) GetServiceCost() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceCost")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// GetServiceCost indicates an expected call of GetServiceCost.
+func (mr *MockPathResultMockRecorder) GetServiceCost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceCost", reflect.TypeOf((*MockPathResult)(nil).GetServiceCost))
+}
+
 // GetServiceSidList mocks base method.
 func (m *MockPathResult) GetServiceSidList() []string {
 	m.ctrl.T.Helper()
@@ -181,6 +490,34 @@ func (mr *MockPathResultMockRecorder) GetServiceSidList() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSidList", reflect.TypeOf((*MockPathResult)(nil).GetServiceSidList))
 }
 
+// GetSessionId mocks base method.
+func (m *MockPathResult) GetSessionId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetSessionId indicates an expected call of GetSessionId.
+func (mr *MockPathResultMockRecorder) GetSessionId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionId", reflect.TypeOf((*MockPathResult)(nil).GetSessionId))
+}
+
+// GetSetupPriority mocks base method.
+func (m *MockPathResult) GetSetupPriority() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSetupPriority")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetSetupPriority indicates an expected call of GetSetupPriority.
+func (mr *MockPathResultMockRecorder) GetSetupPriority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSetupPriority", reflect.TypeOf((*MockPathResult)(nil).GetSetupPriority))
+}
+
 // GetStream mocks base method.
 func (m *MockPathResult) GetStream() api.IntentController_GetIntentPathServer {
 	m.ctrl.T.Helper()
@@ -195,6 +532,20 @@ func (mr *MockPathResultMockRecorder) GetStream() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStream", reflect.TypeOf((*MockPathResult)(nil).GetStream))
 }
 
+// GetStrictPath mocks base method.
+func (m *MockPathResult) GetStrictPath() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStrictPath")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetStrictPath indicates an expected call of GetStrictPath.
+func (mr *MockPathResultMockRecorder) GetStrictPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStrictPath", reflect.TypeOf((*MockPathResult)(nil).GetStrictPath))
+}
+
 // GetTotalCost mocks base method.
 func (m *MockPathResult) GetTotalCost() float64 {
 	m.ctrl.T.Helper()
@@ -251,6 +602,20 @@ func (mr *MockPathResultMockRecorder) GetTotalPacketLoss() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPacketLoss", reflect.TypeOf((*MockPathResult)(nil).GetTotalPacketLoss))
 }
 
+// GetUpdateReason mocks base method.
+func (m *MockPathResult) GetUpdateReason() UpdateReason {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateReason")
+	ret0, _ := ret[0].(UpdateReason)
+	return ret0
+}
+
+// GetUpdateReason indicates an expected call of GetUpdateReason.
+func (mr *MockPathResultMockRecorder) GetUpdateReason() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateReason", reflect.TypeOf((*MockPathResult)(nil).GetUpdateReason))
+}
+
 // Serialize mocks base method.
 func (m *MockPathResult) Serialize() string {
 	m.ctrl.T.Helper()
@@ -265,6 +630,80 @@ func (mr *MockPathResultMockRecorder) Serialize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serialize", reflect.TypeOf((*MockPathResult)(nil).Serialize))
 }
 
+// SetAlternativePathCount mocks base method.
+func (m *MockPathResult) SetAlternativePathCount(arg0 uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlternativePathCount", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlternativePathCount indicates an expected call of SetAlternativePathCount.
+func (mr *MockPathResultMockRecorder) SetAlternativePathCount(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlternativePathCount", reflect.TypeOf((*MockPathResult)(nil).SetAlternativePathCount), arg0)
+}
+
+// SetAlternativePathResults mocks base method.
+func (m *MockPathResult) SetAlternativePathResults(arg0 []PathResult) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAlternativePathResults", arg0)
+}
+
+// SetAlternativePathResults indicates an expected call of SetAlternativePathResults.
+func (mr *MockPathResultMockRecorder) SetAlternativePathResults(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlternativePathResults", reflect.TypeOf((*MockPathResult)(nil).SetAlternativePathResults), arg0)
+}
+
+// SetAlternativePaths mocks base method.
+func (m *MockPathResult) SetAlternativePaths(arg0 []graph.Path) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAlternativePaths", arg0)
+}
+
+// SetAlternativePaths indicates an expected call of SetAlternativePaths.
+func (mr *MockPathResultMockRecorder) SetAlternativePaths(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlternativePaths", reflect.TypeOf((*MockPathResult)(nil).SetAlternativePaths), arg0)
+}
+
+// SetBackupPath mocks base method.
+func (m *MockPathResult) SetBackupPath(arg0 graph.Path) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBackupPath", arg0)
+}
+
+// SetBackupPath indicates an expected call of SetBackupPath.
+func (mr *MockPathResultMockRecorder) SetBackupPath(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackupPath", reflect.TypeOf((*MockPathResult)(nil).SetBackupPath), arg0)
+}
+
+// SetBackupPathResult mocks base method.
+func (m *MockPathResult) SetBackupPathResult(arg0 PathResult) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBackupPathResult", arg0)
+}
+
+// SetBackupPathResult indicates an expected call of SetBackupPathResult.
+func (mr *MockPathResultMockRecorder) SetBackupPathResult(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackupPathResult", reflect.TypeOf((*MockPathResult)(nil).SetBackupPathResult), arg0)
+}
+
+// SetBandwidthDemand mocks base method.
+func (m *MockPathResult) SetBandwidthDemand(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBandwidthDemand", arg0)
+}
+
+// SetBandwidthDemand indicates an expected call of SetBandwidthDemand.
+func (mr *MockPathResultMockRecorder) SetBandwidthDemand(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBandwidthDemand", reflect.TypeOf((*MockPathResult)(nil).SetBandwidthDemand), arg0)
+}
+
 // SetBottleneckEdge mocks base method.
 func (m *MockPathResult) SetBottleneckEdge(arg0 graph.Edge) {
 	m.ctrl.T.Helper()
@@ -289,6 +728,146 @@ func (mr *MockPathResultMockRecorder) SetBottleneckValue(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBottleneckValue", reflect.TypeOf((*MockPathResult)(nil).SetBottleneckValue), arg0)
 }
 
+// SetDisjointnessType mocks base method.
+func (m *MockPathResult) SetDisjointnessType(arg0 DisjointnessType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDisjointnessType", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDisjointnessType indicates an expected call of SetDisjointnessType.
+func (mr *MockPathResultMockRecorder) SetDisjointnessType(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDisjointnessType", reflect.TypeOf((*MockPathResult)(nil).SetDisjointnessType), arg0)
+}
+
+// SetFlexAlgoNumber mocks base method.
+func (m *MockPathResult) SetFlexAlgoNumber(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFlexAlgoNumber", arg0)
+}
+
+// SetFlexAlgoNumber indicates an expected call of SetFlexAlgoNumber.
+func (mr *MockPathResultMockRecorder) SetFlexAlgoNumber(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFlexAlgoNumber", reflect.TypeOf((*MockPathResult)(nil).SetFlexAlgoNumber), arg0)
+}
+
+// SetIncludedServices mocks base method.
+func (m *MockPathResult) SetIncludedServices(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetIncludedServices", arg0)
+}
+
+// SetIncludedServices indicates an expected call of SetIncludedServices.
+func (mr *MockPathResultMockRecorder) SetIncludedServices(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIncludedServices", reflect.TypeOf((*MockPathResult)(nil).SetIncludedServices), arg0)
+}
+
+// SetMaxHopCount mocks base method.
+func (m *MockPathResult) SetMaxHopCount(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxHopCount", arg0)
+}
+
+// SetMaxHopCount indicates an expected call of SetMaxHopCount.
+func (mr *MockPathResultMockRecorder) SetMaxHopCount(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxHopCount", reflect.TypeOf((*MockPathResult)(nil).SetMaxHopCount), arg0)
+}
+
+// SetMaxSidDepth mocks base method.
+func (m *MockPathResult) SetMaxSidDepth(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxSidDepth", arg0)
+}
+
+// SetMaxSidDepth indicates an expected call of SetMaxSidDepth.
+func (mr *MockPathResultMockRecorder) SetMaxSidDepth(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxSidDepth", reflect.TypeOf((*MockPathResult)(nil).SetMaxSidDepth), arg0)
+}
+
+// SetParetoPathResults mocks base method.
+func (m *MockPathResult) SetParetoPathResults(arg0 []PathResult) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetParetoPathResults", arg0)
+}
+
+// SetParetoPathResults indicates an expected call of SetParetoPathResults.
+func (mr *MockPathResultMockRecorder) SetParetoPathResults(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParetoPathResults", reflect.TypeOf((*MockPathResult)(nil).SetParetoPathResults), arg0)
+}
+
+// SetParetoPaths mocks base method.
+func (m *MockPathResult) SetParetoPaths(arg0 []graph.Path) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetParetoPaths", arg0)
+}
+
+// SetParetoPaths indicates an expected call of SetParetoPaths.
+func (mr *MockPathResultMockRecorder) SetParetoPaths(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParetoPaths", reflect.TypeOf((*MockPathResult)(nil).SetParetoPaths), arg0)
+}
+
+// SetPathAlgorithm mocks base method.
+func (m *MockPathResult) SetPathAlgorithm(arg0 PathAlgorithm) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPathAlgorithm", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPathAlgorithm indicates an expected call of SetPathAlgorithm.
+func (mr *MockPathResultMockRecorder) SetPathAlgorithm(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPathAlgorithm", reflect.TypeOf((*MockPathResult)(nil).SetPathAlgorithm), arg0)
+}
+
+// SetPathStability mocks base method.
+func (m *MockPathResult) SetPathStability(arg0 float64, arg1, arg2 uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPathStability", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPathStability indicates an expected call of SetPathStability.
+func (mr *MockPathResultMockRecorder) SetPathStability(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPathStability", reflect.TypeOf((*MockPathResult)(nil).SetPathStability), arg0, arg1, arg2)
+}
+
+// SetPriorities mocks base method.
+func (m *MockPathResult) SetPriorities(arg0, arg1 uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPriorities", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPriorities indicates an expected call of SetPriorities.
+func (mr *MockPathResultMockRecorder) SetPriorities(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPriorities", reflect.TypeOf((*MockPathResult)(nil).SetPriorities), arg0, arg1)
+}
+
+// SetRequestId mocks base method.
+func (m *MockPathResult) SetRequestId(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRequestId", arg0)
+}
+
+// SetRequestId indicates an expected call of SetRequestId.
+func (mr *MockPathResultMockRecorder) SetRequestId(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRequestId", reflect.TypeOf((*MockPathResult)(nil).SetRequestId), arg0)
+}
+
 // SetRouterServiceMap mocks base method.
 func (m *MockPathResult) SetRouterServiceMap(arg0 map[string]string) {
 	m.ctrl.T.Helper()
@@ -301,6 +880,32 @@ func (mr *MockPathResultMockRecorder) SetRouterServiceMap(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRouterServiceMap", reflect.TypeOf((*MockPathResult)(nil).SetRouterServiceMap), arg0)
 }
 
+// SetSelectionPolicy mocks base method.
+func (m *MockPathResult) SetSelectionPolicy(arg0 SelectionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSelectionPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSelectionPolicy indicates an expected call of SetSelectionPolicy.
+func (mr *MockPathResultMockRecorder) SetSelectionPolicy(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSelectionPolicy", reflect.TypeOf((*MockPathResult)(nil).SetSelectionPolicy), arg0)
+}
+
+// SetServiceCost mocks base method.
+func (m *MockPathResult) SetServiceCost(arg0 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetServiceCost", arg0)
+}
+
+// SetServiceCost indicates an expected call of SetServiceCost.
+func (mr *MockPathResultMockRecorder) SetServiceCost(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceCost", reflect.TypeOf((*MockPathResult)(nil).SetServiceCost), arg0)
+}
+
 // SetServiceSidList mocks base method.
 func (m *MockPathResult) SetServiceSidList(arg0 []string) {
 	m.ctrl.T.Helper()
@@ -313,6 +918,30 @@ func (mr *MockPathResultMockRecorder) SetServiceSidList(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceSidList", reflect.TypeOf((*MockPathResult)(nil).SetServiceSidList), arg0)
 }
 
+// SetSessionId mocks base method.
+func (m *MockPathResult) SetSessionId(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSessionId", arg0)
+}
+
+// SetSessionId indicates an expected call of SetSessionId.
+func (mr *MockPathResultMockRecorder) SetSessionId(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionId", reflect.TypeOf((*MockPathResult)(nil).SetSessionId), arg0)
+}
+
+// SetStrictPath mocks base method.
+func (m *MockPathResult) SetStrictPath(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetStrictPath", arg0)
+}
+
+// SetStrictPath indicates an expected call of SetStrictPath.
+func (mr *MockPathResultMockRecorder) SetStrictPath(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStrictPath", reflect.TypeOf((*MockPathResult)(nil).SetStrictPath), arg0)
+}
+
 // SetTotalCost mocks base method.
 func (m *MockPathResult) SetTotalCost(arg0 float64) {
 	m.ctrl.T.Helper()
@@ -360,3 +989,15 @@ func (mr *MockPathResultMockRecorder) SetTotalPacketLoss(arg0 any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTotalPacketLoss", reflect.TypeOf((*MockPathResult)(nil).SetTotalPacketLoss), arg0)
 }
+
+// SetUpdateReason mocks base method.
+func (m *MockPathResult) SetUpdateReason(arg0 UpdateReason) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUpdateReason", arg0)
+}
+
+// SetUpdateReason indicates an expected call of SetUpdateReason.
+func (mr *MockPathResultMockRecorder) SetUpdateReason(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpdateReason", reflect.TypeOf((*MockPathResult)(nil).SetUpdateReason), arg0)
+}
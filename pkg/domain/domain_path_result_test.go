@@ -50,6 +50,23 @@ func TestNewDomainPathResult(t *testing.T) {
 	}
 }
 
+func TestNewDomainDisplacedPathResult(t *testing.T) {
+	pathRequest := NewMockPathRequest(gomock.NewController(t))
+	pathResult := NewDomainDisplacedPathResult(pathRequest)
+	if pathResult.PathRequest != pathRequest {
+		t.Errorf("Expected path request %v, got %v", pathRequest, pathResult.PathRequest)
+	}
+	if pathResult.GetPath() != nil {
+		t.Errorf("Expected no path, got %v", pathResult.GetPath())
+	}
+	if len(pathResult.GetIpv6SidAddresses()) != 0 {
+		t.Errorf("Expected empty SID list, got %v", pathResult.GetIpv6SidAddresses())
+	}
+	if pathResult.GetUpdateReason() != UpdateReasonDisplaced {
+		t.Errorf("Expected update reason %s, got %s", UpdateReasonDisplaced, pathResult.GetUpdateReason())
+	}
+}
+
 func TestDomainPathResult_GetIpv6SidAddresses(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -135,3 +152,258 @@ func TestDomainPathResult_SetServiceSidList(t *testing.T) {
 		})
 	}
 }
+
+func TestDomainPathResult_IncludedServices(t *testing.T) {
+	pathResult, err := NewDomainPathResult(NewMockPathRequest(gomock.NewController(t)), graph.NewMockPath(gomock.NewController(t)), []string{"2001:db8:0:1::1"})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(pathResult.GetIncludedServices()) != 0 {
+		t.Errorf("Expected no included services, got %v", pathResult.GetIncludedServices())
+	}
+	pathResult.SetIncludedServices([]string{"fw", "ids"})
+	if !reflect.DeepEqual([]string{"fw", "ids"}, pathResult.GetIncludedServices()) {
+		t.Errorf("Expected %v, got %v", []string{"fw", "ids"}, pathResult.GetIncludedServices())
+	}
+}
+
+func TestDomainPathResult_GetPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		shortestPath graph.Path
+	}{
+		{
+			name:         "Test GetPath with path",
+			shortestPath: graph.NewMockPath(gomock.NewController(t)),
+		},
+		{
+			name:         "Test GetPath without path",
+			shortestPath: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathResult, err := NewDomainPathResult(NewMockPathRequest(gomock.NewController(t)), tt.shortestPath, []string{"2001:db8:0:1::1"})
+			if err != nil {
+				t.Error(err)
+			}
+			if pathResult.GetPath() != tt.shortestPath {
+				t.Errorf("Expected path %v, got %v", tt.shortestPath, pathResult.GetPath())
+			}
+		})
+	}
+}
+
+func TestDomainPathResult_FlexAlgoNumber(t *testing.T) {
+	pathResult, err := NewDomainPathResult(NewMockPathRequest(gomock.NewController(t)), graph.NewMockPath(gomock.NewController(t)), []string{"2001:db8:0:1::1"})
+	if err != nil {
+		t.Error(err)
+	}
+	if pathResult.GetFlexAlgoNumber() != 0 {
+		t.Errorf("Expected flex algo number 0, got %d", pathResult.GetFlexAlgoNumber())
+	}
+	pathResult.SetFlexAlgoNumber(128)
+	if pathResult.GetFlexAlgoNumber() != 128 {
+		t.Errorf("Expected flex algo number 128, got %d", pathResult.GetFlexAlgoNumber())
+	}
+}
+
+func TestDomainPathResult_UpdateReason(t *testing.T) {
+	pathResult, err := NewDomainPathResult(NewMockPathRequest(gomock.NewController(t)), graph.NewMockPath(gomock.NewController(t)), []string{"2001:db8:0:1::1"})
+	if err != nil {
+		t.Error(err)
+	}
+	if pathResult.GetUpdateReason() != UpdateReasonInitial {
+		t.Errorf("Expected update reason %s, got %s", UpdateReasonInitial, pathResult.GetUpdateReason())
+	}
+	pathResult.SetUpdateReason(UpdateReasonNetworkChange)
+	if pathResult.GetUpdateReason() != UpdateReasonNetworkChange {
+		t.Errorf("Expected update reason %s, got %s", UpdateReasonNetworkChange, pathResult.GetUpdateReason())
+	}
+}
+
+func TestDomainPathResult_GetAlternativePathResults(t *testing.T) {
+	tests := []struct {
+		name               string
+		pathRequest        PathRequest
+		shortestPath       graph.Path
+		ipv6SidAddresses   []string
+		alternativeResults []PathResult
+	}{
+		{
+			name:               "Get Alternative Path Results",
+			pathRequest:        NewMockPathRequest(gomock.NewController(t)),
+			shortestPath:       graph.NewMockPath(gomock.NewController(t)),
+			ipv6SidAddresses:   []string{"2001:db8:0:1::1", "2001:db8:0:1::2"},
+			alternativeResults: []PathResult{NewMockPathResult(gomock.NewController(t))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathResult, err := NewDomainPathResult(tt.pathRequest, tt.shortestPath, tt.ipv6SidAddresses)
+			if err != nil {
+				t.Error(err)
+			}
+			pathResult.alternativeResults = tt.alternativeResults
+			if !reflect.DeepEqual(tt.alternativeResults, pathResult.GetAlternativePathResults()) {
+				t.Errorf("Expected %v, got %v", tt.alternativeResults, pathResult.GetAlternativePathResults())
+			}
+		})
+	}
+}
+
+func TestDomainPathResult_SetAlternativePathResults(t *testing.T) {
+	tests := []struct {
+		name               string
+		pathRequest        PathRequest
+		shortestPath       graph.Path
+		ipv6SidAddresses   []string
+		alternativeResults []PathResult
+	}{
+		{
+			name:               "Set Alternative Path Results",
+			pathRequest:        NewMockPathRequest(gomock.NewController(t)),
+			shortestPath:       graph.NewMockPath(gomock.NewController(t)),
+			ipv6SidAddresses:   []string{"2001:db8:0:1::1", "2001:db8:0:1::2"},
+			alternativeResults: []PathResult{NewMockPathResult(gomock.NewController(t))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathResult, err := NewDomainPathResult(tt.pathRequest, tt.shortestPath, tt.ipv6SidAddresses)
+			if err != nil {
+				t.Error(err)
+			}
+			pathResult.SetAlternativePathResults(tt.alternativeResults)
+			if !reflect.DeepEqual(tt.alternativeResults, pathResult.alternativeResults) {
+				t.Errorf("Expected %v, got %v", tt.alternativeResults, pathResult.alternativeResults)
+			}
+		})
+	}
+}
+
+func TestDomainPathResult_GetBackupPathResult(t *testing.T) {
+	tests := []struct {
+		name             string
+		pathRequest      PathRequest
+		shortestPath     graph.Path
+		ipv6SidAddresses []string
+		backupResult     PathResult
+	}{
+		{
+			name:             "Get Backup Path Result",
+			pathRequest:      NewMockPathRequest(gomock.NewController(t)),
+			shortestPath:     graph.NewMockPath(gomock.NewController(t)),
+			ipv6SidAddresses: []string{"2001:db8:0:1::1", "2001:db8:0:1::2"},
+			backupResult:     NewMockPathResult(gomock.NewController(t)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathResult, err := NewDomainPathResult(tt.pathRequest, tt.shortestPath, tt.ipv6SidAddresses)
+			if err != nil {
+				t.Error(err)
+			}
+			pathResult.backupResult = tt.backupResult
+			if !reflect.DeepEqual(tt.backupResult, pathResult.GetBackupPathResult()) {
+				t.Errorf("Expected %v, got %v", tt.backupResult, pathResult.GetBackupPathResult())
+			}
+		})
+	}
+}
+
+func TestDomainPathResult_SetBackupPathResult(t *testing.T) {
+	tests := []struct {
+		name             string
+		pathRequest      PathRequest
+		shortestPath     graph.Path
+		ipv6SidAddresses []string
+		backupResult     PathResult
+	}{
+		{
+			name:             "Set Backup Path Result",
+			pathRequest:      NewMockPathRequest(gomock.NewController(t)),
+			shortestPath:     graph.NewMockPath(gomock.NewController(t)),
+			ipv6SidAddresses: []string{"2001:db8:0:1::1", "2001:db8:0:1::2"},
+			backupResult:     NewMockPathResult(gomock.NewController(t)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathResult, err := NewDomainPathResult(tt.pathRequest, tt.shortestPath, tt.ipv6SidAddresses)
+			if err != nil {
+				t.Error(err)
+			}
+			pathResult.SetBackupPathResult(tt.backupResult)
+			if !reflect.DeepEqual(tt.backupResult, pathResult.backupResult) {
+				t.Errorf("Expected %v, got %v", tt.backupResult, pathResult.backupResult)
+			}
+		})
+	}
+}
+
+func TestDomainPathResult_GetParetoPathResults(t *testing.T) {
+	tests := []struct {
+		name             string
+		pathRequest      PathRequest
+		shortestPath     graph.Path
+		ipv6SidAddresses []string
+		paretoResults    []PathResult
+	}{
+		{
+			name:             "Get Pareto Path Results",
+			pathRequest:      NewMockPathRequest(gomock.NewController(t)),
+			shortestPath:     graph.NewMockPath(gomock.NewController(t)),
+			ipv6SidAddresses: []string{"2001:db8:0:1::1", "2001:db8:0:1::2"},
+			paretoResults:    []PathResult{NewMockPathResult(gomock.NewController(t)), NewMockPathResult(gomock.NewController(t))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathResult, err := NewDomainPathResult(tt.pathRequest, tt.shortestPath, tt.ipv6SidAddresses)
+			if err != nil {
+				t.Error(err)
+			}
+			pathResult.paretoResults = tt.paretoResults
+			if !reflect.DeepEqual(tt.paretoResults, pathResult.GetParetoPathResults()) {
+				t.Errorf("Expected %v, got %v", tt.paretoResults, pathResult.GetParetoPathResults())
+			}
+		})
+	}
+}
+
+func TestDomainPathResult_SetParetoPathResults(t *testing.T) {
+	tests := []struct {
+		name             string
+		pathRequest      PathRequest
+		shortestPath     graph.Path
+		ipv6SidAddresses []string
+		paretoResults    []PathResult
+	}{
+		{
+			name:             "Set Pareto Path Results",
+			pathRequest:      NewMockPathRequest(gomock.NewController(t)),
+			shortestPath:     graph.NewMockPath(gomock.NewController(t)),
+			ipv6SidAddresses: []string{"2001:db8:0:1::1", "2001:db8:0:1::2"},
+			paretoResults:    []PathResult{NewMockPathResult(gomock.NewController(t)), NewMockPathResult(gomock.NewController(t))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathResult, err := NewDomainPathResult(tt.pathRequest, tt.shortestPath, tt.ipv6SidAddresses)
+			if err != nil {
+				t.Error(err)
+			}
+			pathResult.SetParetoPathResults(tt.paretoResults)
+			if !reflect.DeepEqual(tt.paretoResults, pathResult.paretoResults) {
+				t.Errorf("Expected %v, got %v", tt.paretoResults, pathResult.paretoResults)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "github.com/go-playground/validator"
+
+// metadata of a service instance as registered in the service registry
+type ServiceMetadata interface {
+	GetLoad() float64
+	GetWeight() float64
+	GetCapacity() uint32
+	GetDelay() float64
+	GetJitter() float64
+	GetPacketLoss() float64
+}
+
+type ServiceMetadataInput struct {
+	Load       float64 `validate:"min=0,max=100"`
+	Weight     float64 `validate:"gt=0"`
+	Delay      float64 `validate:"min=0"`
+	Jitter     float64 `validate:"min=0"`
+	PacketLoss float64 `validate:"min=0,max=100"`
+}
+
+type DomainServiceMetadata struct {
+	// load reported by the instance in percent
+	load float64
+	// relative share of sessions the instance should attract
+	weight float64
+	// maximum number of sessions, 0 if unlimited
+	capacity uint32
+	// processing delay and jitter in microseconds and packet loss in percent, added to the metrics of the path
+	delay      float64
+	jitter     float64
+	packetLoss float64
+}
+
+func NewDomainServiceMetadata(load, weight float64, capacity uint32, delay, jitter, packetLoss float64) (*DomainServiceMetadata, error) {
+	input := &ServiceMetadataInput{
+		Load:       load,
+		Weight:     weight,
+		Delay:      delay,
+		Jitter:     jitter,
+		PacketLoss: packetLoss,
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(input); err != nil {
+		return nil, err
+	}
+
+	return &DomainServiceMetadata{
+		load:       load,
+		weight:     weight,
+		capacity:   capacity,
+		delay:      delay,
+		jitter:     jitter,
+		packetLoss: packetLoss,
+	}, nil
+}
+
+func (metadata *DomainServiceMetadata) GetLoad() float64 {
+	return metadata.load
+}
+
+func (metadata *DomainServiceMetadata) GetWeight() float64 {
+	return metadata.weight
+}
+
+func (metadata *DomainServiceMetadata) GetCapacity() uint32 {
+	return metadata.capacity
+}
+
+func (metadata *DomainServiceMetadata) GetDelay() float64 {
+	return metadata.delay
+}
+
+func (metadata *DomainServiceMetadata) GetJitter() float64 {
+	return metadata.jitter
+}
+
+func (metadata *DomainServiceMetadata) GetPacketLoss() float64 {
+	return metadata.packetLoss
+}
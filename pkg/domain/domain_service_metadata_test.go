@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDomainServiceMetadata(t *testing.T) {
+	tests := []struct {
+		name       string
+		load       float64
+		weight     float64
+		capacity   uint32
+		delay      float64
+		jitter     float64
+		packetLoss float64
+		wantErr    bool
+	}{
+		{
+			name:     "Test NewDomainServiceMetadata success",
+			load:     50,
+			weight:   1,
+			capacity: 100,
+			wantErr:  false,
+		},
+		{
+			name:    "Test NewDomainServiceMetadata success without capacity",
+			load:    0,
+			weight:  2,
+			wantErr: false,
+		},
+		{
+			name:    "Test NewDomainServiceMetadata failed with load above 100 percent",
+			load:    120,
+			weight:  1,
+			wantErr: true,
+		},
+		{
+			name:    "Test NewDomainServiceMetadata failed with negative load",
+			load:    -1,
+			weight:  1,
+			wantErr: true,
+		},
+		{
+			name:    "Test NewDomainServiceMetadata failed with zero weight",
+			load:    50,
+			weight:  0,
+			wantErr: true,
+		},
+		{
+			name:       "Test NewDomainServiceMetadata success with processing metrics",
+			weight:     1,
+			delay:      3000,
+			jitter:     200,
+			packetLoss: 0.1,
+			wantErr:    false,
+		},
+		{
+			name:    "Test NewDomainServiceMetadata failed with negative delay",
+			weight:  1,
+			delay:   -1,
+			wantErr: true,
+		},
+		{
+			name:    "Test NewDomainServiceMetadata failed with negative jitter",
+			weight:  1,
+			jitter:  -1,
+			wantErr: true,
+		},
+		{
+			name:       "Test NewDomainServiceMetadata failed with packet loss above 100 percent",
+			weight:     1,
+			packetLoss: 101,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := NewDomainServiceMetadata(tt.load, tt.weight, tt.capacity, tt.delay, tt.jitter, tt.packetLoss)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.load, metadata.GetLoad())
+			assert.Equal(t, tt.weight, metadata.GetWeight())
+			assert.Equal(t, tt.capacity, metadata.GetCapacity())
+			assert.Equal(t, tt.delay, metadata.GetDelay())
+			assert.Equal(t, tt.jitter, metadata.GetJitter())
+			assert.Equal(t, tt.packetLoss, metadata.GetPacketLoss())
+		})
+	}
+}
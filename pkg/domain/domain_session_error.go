@@ -0,0 +1,26 @@
+package domain
+
+// error of the session of a path request, delivered to the stream of the path request
+type SessionError struct {
+	pathRequest PathRequest
+	err         error
+}
+
+func NewSessionError(pathRequest PathRequest, err error) *SessionError {
+	return &SessionError{
+		pathRequest: pathRequest,
+		err:         err,
+	}
+}
+
+func (sessionError *SessionError) Error() string {
+	return sessionError.err.Error()
+}
+
+func (sessionError *SessionError) Unwrap() error {
+	return sessionError.err
+}
+
+func (sessionError *SessionError) GetPathRequest() PathRequest {
+	return sessionError.pathRequest
+}
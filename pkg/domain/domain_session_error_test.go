@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestNewSessionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "Test NewSessionError wraps error",
+			err:  assert.AnError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := NewMockPathRequest(gomock.NewController(t))
+			var err error = NewSessionError(pathRequest, tt.err)
+			assert.Equal(t, tt.err.Error(), err.Error())
+			assert.ErrorIs(t, err, tt.err)
+
+			var sessionError *SessionError
+			assert.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &sessionError))
+			assert.Equal(t, pathRequest, sessionError.GetPathRequest())
+		})
+	}
+}
@@ -7,6 +7,10 @@ type Sid interface {
 	GetIgpRouterId() string
 	GetSid() string
 	GetAlgorithm() uint32
+	GetLocatorBlockLength() uint32
+	GetLocatorNodeLength() uint32
+	GetFunctionLength() uint32
+	SetSidStructure(uint32, uint32, uint32)
 }
 
 type SidInput struct {
@@ -21,6 +25,10 @@ type DomainSid struct {
 	igpRouterId string
 	sid         string
 	algorithm   uint32
+	// SID structure in bits, all 0 if not advertised
+	locatorBlockLength uint32
+	locatorNodeLength  uint32
+	functionLength     uint32
 }
 
 func NewDomainSid(key, igpRouterId, sid *string, algorithm *uint32) (*DomainSid, error) {
@@ -60,3 +68,21 @@ func (sid *DomainSid) GetSid() string {
 func (sid *DomainSid) GetAlgorithm() uint32 {
 	return sid.algorithm
 }
+
+func (sid *DomainSid) GetLocatorBlockLength() uint32 {
+	return sid.locatorBlockLength
+}
+
+func (sid *DomainSid) GetLocatorNodeLength() uint32 {
+	return sid.locatorNodeLength
+}
+
+func (sid *DomainSid) GetFunctionLength() uint32 {
+	return sid.functionLength
+}
+
+func (sid *DomainSid) SetSidStructure(locatorBlockLength, locatorNodeLength, functionLength uint32) {
+	sid.locatorBlockLength = locatorBlockLength
+	sid.locatorNodeLength = locatorNodeLength
+	sid.functionLength = functionLength
+}
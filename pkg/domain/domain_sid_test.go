@@ -175,3 +175,39 @@ func TestDomainSid_GetAlgorithm(t *testing.T) {
 		}
 	}
 }
+
+func TestDomainSid_SidStructure(t *testing.T) {
+	tests := []struct {
+		name               string
+		locatorBlockLength uint32
+		locatorNodeLength  uint32
+		functionLength     uint32
+	}{
+		{
+			name: "Test SidStructure not advertised",
+		},
+		{
+			name:               "Test SidStructure micro SID F3216",
+			locatorBlockLength: 32,
+			locatorNodeLength:  16,
+			functionLength:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		sid, err := NewDomainSid(proto.String("0_0000.0000.000b_fcbb:bb00:b::"), proto.String("0000.0000.000b"), proto.String("fcbb:bb00:b::"), proto.Uint32(0))
+		if err != nil {
+			t.Errorf("Error creating DomainSid: %v", err)
+		}
+		sid.SetSidStructure(tt.locatorBlockLength, tt.locatorNodeLength, tt.functionLength)
+		if sid.GetLocatorBlockLength() != tt.locatorBlockLength {
+			t.Errorf("Expected %v, got %v", tt.locatorBlockLength, sid.GetLocatorBlockLength())
+		}
+		if sid.GetLocatorNodeLength() != tt.locatorNodeLength {
+			t.Errorf("Expected %v, got %v", tt.locatorNodeLength, sid.GetLocatorNodeLength())
+		}
+		if sid.GetFunctionLength() != tt.functionLength {
+			t.Errorf("Expected %v, got %v", tt.functionLength, sid.GetFunctionLength())
+		}
+	}
+}
@@ -2,39 +2,161 @@ package domain
 
 import (
 	"context"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type StreamSession interface {
 	GetContext() context.Context
 	GetPathRequest() PathRequest
 	GetPathResult() PathResult
-	SetPathResult(PathResult)
+	SetPathResult(PathResult, UpdateReason)
+	GetLastPathChange() time.Time
+	GetPathChangeCount() uint32
+	GetFlapPenalty() float64
+	GetSessionId() string
+	GetSubscribers() []PathRequest
+	AddSubscriber(PathRequest) bool
+	RemoveSubscriber(PathRequest) int
 }
 
+// only the most recent path changes contribute to the flap damping penalty
+const MaximumRecordedPathChanges = 32
+
+// session identifiers are unique for the lifetime of the process
+var sessionCounter atomic.Uint64
+
+// the session is updated by the calculation updater and by the controller, so the fields which change are guarded by the mutex
 type DomainStreamSession struct {
+	mu          sync.RWMutex
+	sessionId   string
 	pathRequest PathRequest
 	pathResult  PathResult
+	// time of the initial path and of every path change caused by the network since then
+	lastPathChange  time.Time
+	pathChangeCount uint32
+	pathChanges     []time.Time
+	// path requests of all streams sharing the session, the session lives until the last subscriber is removed
+	subscribers []PathRequest
 }
 
 func NewDomainStreamSession(pathRequest PathRequest, pathResponse PathResult) *DomainStreamSession {
 	return &DomainStreamSession{
-		pathRequest: pathRequest,
-		pathResult:  pathResponse,
+		sessionId:      strconv.FormatUint(sessionCounter.Add(1), 10),
+		pathRequest:    pathRequest,
+		pathResult:     pathResponse,
+		lastPathChange: time.Now(),
+		pathChanges:    make([]time.Time, 0),
+		subscribers:    []PathRequest{pathRequest},
 	}
 }
 
 func (streamSession *DomainStreamSession) GetContext() context.Context {
-	return streamSession.pathRequest.GetContext()
+	return streamSession.GetPathRequest().GetContext()
 }
 
 func (streamSession *DomainStreamSession) GetPathRequest() PathRequest {
+	streamSession.mu.RLock()
+	defer streamSession.mu.RUnlock()
 	return streamSession.pathRequest
 }
 
 func (streamSession *DomainStreamSession) GetPathResult() PathResult {
+	streamSession.mu.RLock()
+	defer streamSession.mu.RUnlock()
 	return streamSession.pathResult
 }
 
-func (streamSession *DomainStreamSession) SetPathResult(pathResult PathResult) {
+// only path changes caused by the network count toward the hold-down time and the flap penalty,
+// sessions moved by the controller itself did not flap
+func (streamSession *DomainStreamSession) SetPathResult(pathResult PathResult, updateReason UpdateReason) {
+	streamSession.mu.Lock()
+	defer streamSession.mu.Unlock()
 	streamSession.pathResult = pathResult
+	if updateReason == UpdateReasonNetworkChange {
+		streamSession.recordPathChange(time.Now())
+	}
+}
+
+func (streamSession *DomainStreamSession) recordPathChange(changeTime time.Time) {
+	streamSession.lastPathChange = changeTime
+	streamSession.pathChangeCount++
+	streamSession.pathChanges = append(streamSession.pathChanges, changeTime)
+	if len(streamSession.pathChanges) > MaximumRecordedPathChanges {
+		streamSession.pathChanges = streamSession.pathChanges[len(streamSession.pathChanges)-MaximumRecordedPathChanges:]
+	}
+}
+
+func (streamSession *DomainStreamSession) GetLastPathChange() time.Time {
+	streamSession.mu.RLock()
+	defer streamSession.mu.RUnlock()
+	return streamSession.lastPathChange
+}
+
+func (streamSession *DomainStreamSession) GetPathChangeCount() uint32 {
+	streamSession.mu.RLock()
+	defer streamSession.mu.RUnlock()
+	return streamSession.pathChangeCount
+}
+
+// every path change adds a penalty of 1 which halves with each half-life of the path request
+func (streamSession *DomainStreamSession) getFlapPenalty(now time.Time) float64 {
+	halfLife := streamSession.pathRequest.GetFlapDampingHalfLife()
+	if halfLife <= 0 {
+		return 0
+	}
+	penalty := 0.0
+	for _, changeTime := range streamSession.pathChanges {
+		penalty += math.Pow(0.5, now.Sub(changeTime).Seconds()/halfLife.Seconds())
+	}
+	return penalty
+}
+
+func (streamSession *DomainStreamSession) GetFlapPenalty() float64 {
+	streamSession.mu.RLock()
+	defer streamSession.mu.RUnlock()
+	return streamSession.getFlapPenalty(time.Now())
+}
+
+func (streamSession *DomainStreamSession) GetSessionId() string {
+	return streamSession.sessionId
+}
+
+func (streamSession *DomainStreamSession) GetSubscribers() []PathRequest {
+	streamSession.mu.RLock()
+	defer streamSession.mu.RUnlock()
+	subscribers := make([]PathRequest, len(streamSession.subscribers))
+	copy(subscribers, streamSession.subscribers)
+	return subscribers
+}
+
+// every stream subscribes at most once, false if the stream of the path request is already subscribed with an active path request
+func (streamSession *DomainStreamSession) AddSubscriber(pathRequest PathRequest) bool {
+	streamSession.mu.Lock()
+	defer streamSession.mu.Unlock()
+	for _, subscriber := range streamSession.subscribers {
+		if subscriber.GetStream() == pathRequest.GetStream() && subscriber.GetContext().Err() == nil {
+			return false
+		}
+	}
+	streamSession.subscribers = append(streamSession.subscribers, pathRequest)
+	return true
+}
+
+// the subscription of the path request is removed, the remaining number of subscribers is returned. If the path request
+// the session was created for is removed, the oldest remaining subscriber takes it over along with its context
+func (streamSession *DomainStreamSession) RemoveSubscriber(pathRequest PathRequest) int {
+	streamSession.mu.Lock()
+	defer streamSession.mu.Unlock()
+	streamSession.subscribers = slices.DeleteFunc(streamSession.subscribers, func(subscriber PathRequest) bool {
+		return subscriber == pathRequest
+	})
+	if pathRequest == streamSession.pathRequest && len(streamSession.subscribers) > 0 {
+		streamSession.pathRequest = streamSession.subscribers[0]
+	}
+	return len(streamSession.subscribers)
 }
@@ -2,8 +2,11 @@ package domain
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/hawkv6/hawkeye/pkg/api"
 	"github.com/stretchr/testify/assert"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -30,6 +33,25 @@ func TestNewDomainStreamSession(t *testing.T) {
 	}
 }
 
+func TestDomainStreamSession_GetSessionId(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Test DomainStreamSession GetSessionId unique per session",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			streamSession := NewDomainStreamSession(NewMockPathRequest(ctrl), NewMockPathResult(ctrl))
+			otherStreamSession := NewDomainStreamSession(NewMockPathRequest(ctrl), NewMockPathResult(ctrl))
+			assert.NotEmpty(t, streamSession.GetSessionId())
+			assert.NotEqual(t, streamSession.GetSessionId(), otherStreamSession.GetSessionId())
+		})
+	}
+}
+
 func TestDomainStreamSession_GetContext(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -95,22 +117,217 @@ func TestDomainStreamSession_GetPathResult(t *testing.T) {
 
 func TestDomainStreamSession_SetPathResult(t *testing.T) {
 	tests := []struct {
-		name         string
-		pathResponse PathResult
-		pathRequest  PathRequest
+		name                string
+		pathResponse        PathResult
+		pathRequest         PathRequest
+		updateReason        UpdateReason
+		wantPathChangeCount uint32
 	}{
 		{
-			name:         "Test DomainStreamSession SetPathResult",
-			pathRequest:  NewMockPathRequest(gomock.NewController(t)),
-			pathResponse: NewMockPathResult(gomock.NewController(t)),
+			name:                "Test DomainStreamSession SetPathResult after network change",
+			pathRequest:         NewMockPathRequest(gomock.NewController(t)),
+			pathResponse:        NewMockPathResult(gomock.NewController(t)),
+			updateReason:        UpdateReasonNetworkChange,
+			wantPathChangeCount: 1,
+		},
+		{
+			name:                "Test DomainStreamSession SetPathResult after preemption",
+			pathRequest:         NewMockPathRequest(gomock.NewController(t)),
+			pathResponse:        NewMockPathResult(gomock.NewController(t)),
+			updateReason:        UpdateReasonPreemption,
+			wantPathChangeCount: 0,
+		},
+		{
+			name:                "Test DomainStreamSession SetPathResult after global optimization",
+			pathRequest:         NewMockPathRequest(gomock.NewController(t)),
+			pathResponse:        NewMockPathResult(gomock.NewController(t)),
+			updateReason:        UpdateReasonOptimization,
+			wantPathChangeCount: 0,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			streamSession := NewDomainStreamSession(tt.pathRequest, tt.pathResponse)
+			lastPathChange := streamSession.GetLastPathChange()
 			streamSession.pathResult = nil
-			streamSession.SetPathResult(tt.pathResponse)
+			streamSession.SetPathResult(tt.pathResponse, tt.updateReason)
 			assert.NotNil(t, streamSession.GetPathResult())
+			assert.Equal(t, tt.wantPathChangeCount, streamSession.GetPathChangeCount())
+			assert.Len(t, streamSession.pathChanges, int(tt.wantPathChangeCount))
+			if tt.wantPathChangeCount == 0 {
+				assert.Equal(t, lastPathChange, streamSession.GetLastPathChange())
+			}
+		})
+	}
+}
+
+func TestDomainStreamSession_getFlapPenalty(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name                string
+		flapDampingHalfLife time.Duration
+		pathChanges         []time.Time
+		want                float64
+	}{
+		{
+			name:                "Test getFlapPenalty without flap damping",
+			flapDampingHalfLife: 0,
+			pathChanges:         []time.Time{now},
+			want:                0,
+		},
+		{
+			name:                "Test getFlapPenalty without path changes",
+			flapDampingHalfLife: time.Minute,
+			pathChanges:         []time.Time{},
+			want:                0,
+		},
+		{
+			name:                "Test getFlapPenalty with decayed path changes",
+			flapDampingHalfLife: time.Minute,
+			pathChanges:         []time.Time{now.Add(-2 * time.Minute), now.Add(-time.Minute), now},
+			want:                1.75,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathRequest := NewMockPathRequest(gomock.NewController(t))
+			pathRequest.EXPECT().GetFlapDampingHalfLife().Return(tt.flapDampingHalfLife).AnyTimes()
+			streamSession := NewDomainStreamSession(pathRequest, NewMockPathResult(gomock.NewController(t)))
+			for _, changeTime := range tt.pathChanges {
+				streamSession.recordPathChange(changeTime)
+			}
+			assert.InDelta(t, tt.want, streamSession.getFlapPenalty(now), 1e-9)
+			assert.Equal(t, uint32(len(tt.pathChanges)), streamSession.GetPathChangeCount())
+		})
+	}
+}
+
+func TestDomainStreamSession_recordPathChange(t *testing.T) {
+	streamSession := NewDomainStreamSession(NewMockPathRequest(gomock.NewController(t)), NewMockPathResult(gomock.NewController(t)))
+	changeTime := time.Now()
+	for change := 0; change < MaximumRecordedPathChanges+8; change++ {
+		streamSession.recordPathChange(changeTime)
+	}
+	assert.Len(t, streamSession.pathChanges, MaximumRecordedPathChanges)
+	assert.Equal(t, uint32(MaximumRecordedPathChanges+8), streamSession.GetPathChangeCount())
+	assert.Equal(t, changeTime, streamSession.GetLastPathChange())
+}
+
+func TestDomainStreamSession_Subscribers(t *testing.T) {
+	tests := []struct {
+		name            string
+		sameStream      bool
+		cancelled       bool
+		wantSubscribed  bool
+		wantSubscribers int
+	}{
+		{
+			name:            "Test DomainStreamSession subscriber of another stream",
+			sameStream:      false,
+			wantSubscribed:  true,
+			wantSubscribers: 2,
+		},
+		{
+			name:            "Test DomainStreamSession subscriber of the same stream",
+			sameStream:      true,
+			wantSubscribed:  false,
+			wantSubscribers: 1,
+		},
+		{
+			name:            "Test DomainStreamSession subscriber of the same stream replacing a cancelled path request",
+			sameStream:      true,
+			cancelled:       true,
+			wantSubscribed:  true,
+			wantSubscribers: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			stream := api.NewMockIntentController_GetIntentPathServer(ctrl)
+			otherStream := api.NewMockIntentController_GetIntentPathServer(ctrl)
+			pathRequest := NewMockPathRequest(ctrl)
+			pathRequest.EXPECT().GetStream().Return(stream).AnyTimes()
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancelled {
+				cancel()
+			} else {
+				defer cancel()
+			}
+			pathRequest.EXPECT().GetContext().Return(ctx).AnyTimes()
+			subscriber := NewMockPathRequest(ctrl)
+			if tt.sameStream {
+				subscriber.EXPECT().GetStream().Return(stream).AnyTimes()
+			} else {
+				subscriber.EXPECT().GetStream().Return(otherStream).AnyTimes()
+			}
+			streamSession := NewDomainStreamSession(pathRequest, NewMockPathResult(ctrl))
+			assert.Equal(t, []PathRequest{pathRequest}, streamSession.GetSubscribers())
+
+			assert.Equal(t, tt.wantSubscribed, streamSession.AddSubscriber(subscriber))
+			assert.Len(t, streamSession.GetSubscribers(), tt.wantSubscribers)
+			assert.Equal(t, tt.wantSubscribers-1, streamSession.RemoveSubscriber(pathRequest))
+			if tt.wantSubscribed {
+				// the remaining subscriber takes over the session
+				assert.Equal(t, subscriber, streamSession.GetPathRequest())
+			} else {
+				assert.Equal(t, pathRequest, streamSession.GetPathRequest())
+			}
+			assert.Equal(t, 0, streamSession.RemoveSubscriber(subscriber))
+		})
+	}
+}
+
+func TestDomainStreamSession_HandoverDuringRecalculation(t *testing.T) {
+	tests := []struct {
+		name           string
+		recalculations int
+		handovers      int
+	}{
+		{
+			name:           "Test DomainStreamSession subscriber handover during recalculation",
+			recalculations: 100,
+			handovers:      100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			intents := []Intent{NewDomainIntent(IntentTypeLowLatency, []Value{})}
+			owner, err := NewDomainPathRequest("2001:db8::1", "2001:db8::2", intents, api.NewMockIntentController_GetIntentPathServer(ctrl), context.Background())
+			assert.NoError(t, err)
+			subscriber, err := NewDomainPathRequest("2001:db8::1", "2001:db8::2", intents, api.NewMockIntentController_GetIntentPathServer(ctrl), context.Background())
+			assert.NoError(t, err)
+			for _, pathRequest := range []*DomainPathRequest{owner, subscriber} {
+				assert.NoError(t, pathRequest.SetPathStability(0, 0, 60))
+			}
+			streamSession := NewDomainStreamSession(owner, NewMockPathResult(ctrl))
+			assert.True(t, streamSession.AddSubscriber(subscriber))
+
+			wg := sync.WaitGroup{}
+			wg.Add(2)
+			// the updater reads the owning path request and the flap penalty and applies the new path
+			go func() {
+				defer wg.Done()
+				for index := 0; index < tt.recalculations; index++ {
+					assert.NoError(t, streamSession.GetContext().Err())
+					streamSession.GetFlapPenalty()
+					streamSession.SetPathResult(NewMockPathResult(ctrl), UpdateReasonNetworkChange)
+				}
+			}()
+			// the owning stream is closed and reopened while another stream stays subscribed
+			go func() {
+				defer wg.Done()
+				for index := 0; index < tt.handovers; index++ {
+					pathRequest := streamSession.GetPathRequest()
+					assert.Equal(t, 1, streamSession.RemoveSubscriber(pathRequest))
+					assert.True(t, streamSession.AddSubscriber(pathRequest))
+				}
+			}()
+			wg.Wait()
+			assert.Equal(t, uint32(tt.recalculations), streamSession.GetPathChangeCount())
+			assert.Len(t, streamSession.GetSubscribers(), 2)
+			assert.Contains(t, streamSession.GetSubscribers(), streamSession.GetPathRequest())
 		})
 	}
 }
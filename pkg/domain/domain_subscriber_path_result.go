@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/hawkv6/hawkeye/pkg/api"
+)
+
+// path result of a shared session addressed to one of its subscribers, the result is delivered to the stream of the subscriber
+// and carries the identifiers of its path request
+type DomainSubscriberPathResult struct {
+	PathResult
+	subscriber PathRequest
+}
+
+func NewDomainSubscriberPathResult(pathResult PathResult, subscriber PathRequest) *DomainSubscriberPathResult {
+	return &DomainSubscriberPathResult{
+		PathResult: pathResult,
+		subscriber: subscriber,
+	}
+}
+
+func (pathResult *DomainSubscriberPathResult) GetContext() context.Context {
+	return pathResult.subscriber.GetContext()
+}
+
+func (pathResult *DomainSubscriberPathResult) GetStream() api.IntentController_GetIntentPathServer {
+	return pathResult.subscriber.GetStream()
+}
+
+func (pathResult *DomainSubscriberPathResult) GetRequestId() string {
+	return pathResult.subscriber.GetRequestId()
+}
+
+func (pathResult *DomainSubscriberPathResult) GetSessionId() string {
+	return pathResult.subscriber.GetSessionId()
+}
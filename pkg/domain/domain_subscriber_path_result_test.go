@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/api"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestDomainSubscriberPathResult(t *testing.T) {
+	tests := []struct {
+		name             string
+		ipv6SidAddresses []string
+		requestId        string
+		sessionId        string
+	}{
+		{
+			name:             "Test DomainSubscriberPathResult addressed to subscriber",
+			ipv6SidAddresses: []string{"fc00::1", "fc00::2"},
+			requestId:        "request-1",
+			sessionId:        "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			stream := api.NewMockIntentController_GetIntentPathServer(ctrl)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			subscriber := NewMockPathRequest(ctrl)
+			subscriber.EXPECT().GetStream().Return(stream).AnyTimes()
+			subscriber.EXPECT().GetContext().Return(ctx).AnyTimes()
+			subscriber.EXPECT().GetRequestId().Return(tt.requestId).AnyTimes()
+			subscriber.EXPECT().GetSessionId().Return(tt.sessionId).AnyTimes()
+			pathResult := NewMockPathResult(ctrl)
+			pathResult.EXPECT().GetIpv6SidAddresses().Return(tt.ipv6SidAddresses).AnyTimes()
+
+			subscriberPathResult := NewDomainSubscriberPathResult(pathResult, subscriber)
+			assert.Equal(t, stream, subscriberPathResult.GetStream())
+			assert.Equal(t, ctx, subscriberPathResult.GetContext())
+			assert.Equal(t, tt.ipv6SidAddresses, subscriberPathResult.GetIpv6SidAddresses())
+			assert.Equal(t, tt.requestId, subscriberPathResult.GetRequestId())
+			assert.Equal(t, tt.sessionId, subscriberPathResult.GetSessionId())
+		})
+	}
+}
@@ -12,6 +12,10 @@ const (
 	IntentTypeFlexAlgo
 	IntentTypeSFC
 	IntentTypeLowUtilization
+	IntentTypeExcludeNodes
+	IntentTypeIncludeNodes
+	IntentTypeExcludeLinks
+	IntentTypeIncludeLinks
 )
 
 func (it IntentType) String() string {
@@ -34,6 +38,14 @@ func (it IntentType) String() string {
 		return "SFC"
 	case IntentTypeLowUtilization:
 		return "LowUtilization"
+	case IntentTypeExcludeNodes:
+		return "ExcludeNodes"
+	case IntentTypeIncludeNodes:
+		return "IncludeNodes"
+	case IntentTypeExcludeLinks:
+		return "ExcludeLinks"
+	case IntentTypeIncludeLinks:
+		return "IncludeLinks"
 	default:
 		return "Unknown"
 	}
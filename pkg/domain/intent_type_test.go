@@ -19,6 +19,10 @@ func TestIntentType_String(t *testing.T) {
 		{"FlexAlgo", IntentTypeFlexAlgo, "FlexAlgo"},
 		{"SFC", IntentTypeSFC, "SFC"},
 		{"LowUtilization", IntentTypeLowUtilization, "LowUtilization"},
+		{"ExcludeNodes", IntentTypeExcludeNodes, "ExcludeNodes"},
+		{"IncludeNodes", IntentTypeIncludeNodes, "IncludeNodes"},
+		{"ExcludeLinks", IntentTypeExcludeLinks, "ExcludeLinks"},
+		{"IncludeLinks", IntentTypeIncludeLinks, "IncludeLinks"},
 		{"Unknown", IntentType(999), "Unknown"},
 	}
 
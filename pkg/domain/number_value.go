@@ -20,6 +20,9 @@ func NewNumberValue(valueType ValueType, numberValue *int32) (*NumberValue, erro
 	if numberValue == nil {
 		return nil, fmt.Errorf("Number value was not provided")
 	}
+	if valueType == ValueTypeTolerance {
+		return newToleranceValue(*numberValue)
+	}
 	numberValueInput := &NumberValueInput{
 		BaseValue:   *NewBaseValue(valueType),
 		NumberValue: *numberValue,
@@ -35,6 +38,16 @@ func NewNumberValue(valueType ValueType, numberValue *int32) (*NumberValue, erro
 	return newNumberValue, nil
 }
 
+func newToleranceValue(tolerance int32) (*NumberValue, error) {
+	if tolerance < 0 || tolerance > 100 {
+		return nil, fmt.Errorf("Tolerance value should be a percentage between 0 and 100")
+	}
+	return &NumberValue{
+		BaseValue:   *NewBaseValue(ValueTypeTolerance),
+		numberValue: tolerance,
+	}, nil
+}
+
 func (numberValue *NumberValue) GetNumberValue() int32 {
 	return numberValue.numberValue
 }
@@ -55,6 +55,18 @@ func TestNewNumberValue(t *testing.T) {
 			numberValue: proto.Int32(-1),
 			wantErr:     true,
 		},
+		{
+			name:        "Test NewNumberValue type tolerance zero",
+			valueType:   ValueTypeTolerance,
+			numberValue: proto.Int32(0),
+			wantErr:     false,
+		},
+		{
+			name:        "Test NewNumberValue type tolerance above 100 percent",
+			valueType:   ValueTypeTolerance,
+			numberValue: proto.Int32(101),
+			wantErr:     true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
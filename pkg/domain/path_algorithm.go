@@ -0,0 +1,22 @@
+package domain
+
+type PathAlgorithm int
+
+const (
+	PathAlgorithmDefault PathAlgorithm = iota
+	PathAlgorithmDijkstra
+	PathAlgorithmConstrained
+)
+
+func (pa PathAlgorithm) String() string {
+	switch pa {
+	case PathAlgorithmDefault:
+		return "Default"
+	case PathAlgorithmDijkstra:
+		return "Dijkstra"
+	case PathAlgorithmConstrained:
+		return "Constrained"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "testing"
+
+func TestPathAlgorithm_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    PathAlgorithm
+		expected string
+	}{
+		{"Default", PathAlgorithmDefault, "Default"},
+		{"Dijkstra", PathAlgorithmDijkstra, "Dijkstra"},
+		{"Constrained", PathAlgorithmConstrained, "Constrained"},
+		{"Unknown", PathAlgorithm(999), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.String(); got != tt.expected {
+				t.Errorf("PathAlgorithm.String() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+type SelectionPolicy int
+
+const (
+	SelectionPolicyNone SelectionPolicy = iota
+	SelectionPolicyWeighted
+	SelectionPolicyLexicographic
+	SelectionPolicyKnee
+)
+
+func (sp SelectionPolicy) String() string {
+	switch sp {
+	case SelectionPolicyNone:
+		return "None"
+	case SelectionPolicyWeighted:
+		return "Weighted"
+	case SelectionPolicyLexicographic:
+		return "Lexicographic"
+	case SelectionPolicyKnee:
+		return "Knee"
+	default:
+		return "Unknown"
+	}
+}
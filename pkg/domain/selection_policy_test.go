@@ -0,0 +1,25 @@
+package domain
+
+import "testing"
+
+func TestSelectionPolicy_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    SelectionPolicy
+		expected string
+	}{
+		{"None", SelectionPolicyNone, "None"},
+		{"Weighted", SelectionPolicyWeighted, "Weighted"},
+		{"Lexicographic", SelectionPolicyLexicographic, "Lexicographic"},
+		{"Knee", SelectionPolicyKnee, "Knee"},
+		{"Unknown", SelectionPolicy(999), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.String(); got != tt.expected {
+				t.Errorf("SelectionPolicy.String() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+type UpdateReason int
+
+const (
+	UpdateReasonInitial UpdateReason = iota
+	UpdateReasonNetworkChange
+	UpdateReasonPreemption
+	UpdateReasonOptimization
+	UpdateReasonDisplaced
+)
+
+func (reason UpdateReason) String() string {
+	switch reason {
+	case UpdateReasonInitial:
+		return "Initial"
+	case UpdateReasonNetworkChange:
+		return "Network Change"
+	case UpdateReasonPreemption:
+		return "Preemption"
+	case UpdateReasonOptimization:
+		return "Optimization"
+	case UpdateReasonDisplaced:
+		return "Displaced"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "testing"
+
+func TestUpdateReason_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    UpdateReason
+		expected string
+	}{
+		{"Initial", UpdateReasonInitial, "Initial"},
+		{"Network Change", UpdateReasonNetworkChange, "Network Change"},
+		{"Preemption", UpdateReasonPreemption, "Preemption"},
+		{"Optimization", UpdateReasonOptimization, "Optimization"},
+		{"Displaced", UpdateReasonDisplaced, "Displaced"},
+		{"Unknown", UpdateReason(999), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.String(); got != tt.expected {
+				t.Errorf("UpdateReason.String() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -8,6 +8,9 @@ const (
 	ValueTypeMaxValue
 	ValueTypeSFC
 	ValueTypeFlexAlgoNr
+	ValueTypeTolerance
+	ValueTypeNode
+	ValueTypeLink
 )
 
 func (vt ValueType) String() string {
@@ -22,6 +25,12 @@ func (vt ValueType) String() string {
 		return "SFC"
 	case ValueTypeFlexAlgoNr:
 		return "FlexAlgoNr"
+	case ValueTypeTolerance:
+		return "Tolerance"
+	case ValueTypeNode:
+		return "Node"
+	case ValueTypeLink:
+		return "Link"
 	default:
 		return "Unknown"
 	}
@@ -13,6 +13,9 @@ func TestValeType_String(t *testing.T) {
 		{"MaxValue", ValueTypeMaxValue, "MaxValue"},
 		{"SFC", ValueTypeSFC, "SFC"},
 		{"FlexAlgoNr", ValueTypeFlexAlgoNr, "FlexAlgoNr"},
+		{"Tolerance", ValueTypeTolerance, "Tolerance"},
+		{"Node", ValueTypeNode, "Node"},
+		{"Link", ValueTypeLink, "Link"},
 		{"Unknown", ValueType(999), "Unknown"},
 	}
 
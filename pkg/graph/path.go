@@ -16,4 +16,12 @@ type Path interface {
 	SetBottleneckValue(float64)
 	SetRouterServiceMap(map[string]string)
 	GetRouterServiceMap() map[string]string
+	SetServiceCost(float64)
+	GetServiceCost() float64
+	SetAlternativePaths([]Path)
+	GetAlternativePaths() []Path
+	SetBackupPath(Path)
+	GetBackupPath() Path
+	SetParetoPaths([]Path)
+	GetParetoPaths() []Path
 }
@@ -38,6 +38,34 @@ func (m *MockPath) EXPECT() *MockPathMockRecorder {
 	return m.recorder
 }
 
+// GetAlternativePaths mocks base method.
+func (m *MockPath) GetAlternativePaths() []Path {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlternativePaths")
+	ret0, _ := ret[0].([]Path)
+	return ret0
+}
+
+// GetAlternativePaths indicates an expected call of GetAlternativePaths.
+func (mr *MockPathMockRecorder) GetAlternativePaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlternativePaths", reflect.TypeOf((*MockPath)(nil).GetAlternativePaths))
+}
+
+// GetBackupPath mocks base method.
+func (m *MockPath) GetBackupPath() Path {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackupPath")
+	ret0, _ := ret[0].(Path)
+	return ret0
+}
+
+// GetBackupPath indicates an expected call of GetBackupPath.
+func (mr *MockPathMockRecorder) GetBackupPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupPath", reflect.TypeOf((*MockPath)(nil).GetBackupPath))
+}
+
 // GetBottleneckEdge mocks base method.
 func (m *MockPath) GetBottleneckEdge() Edge {
 	m.ctrl.T.Helper()
@@ -80,6 +108,20 @@ func (mr *MockPathMockRecorder) GetEdges() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEdges", reflect.TypeOf((*MockPath)(nil).GetEdges))
 }
 
+// GetParetoPaths mocks base method.
+func (m *MockPath) GetParetoPaths() []Path {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParetoPaths")
+	ret0, _ := ret[0].([]Path)
+	return ret0
+}
+
+// GetParetoPaths indicates an expected call of GetParetoPaths.
+func (mr *MockPathMockRecorder) GetParetoPaths() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParetoPaths", reflect.TypeOf((*MockPath)(nil).GetParetoPaths))
+}
+
 // GetRouterServiceMap mocks base method.
 func (m *MockPath) GetRouterServiceMap() map[string]string {
 	m.ctrl.T.Helper()
@@ -94,6 +136,20 @@ func (mr *MockPathMockRecorder) GetRouterServiceMap() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterServiceMap", reflect.TypeOf((*MockPath)(nil).GetRouterServiceMap))
 }
 
+// GetServiceCost mocks base method.
+func (m *MockPath) GetServiceCost() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceCost")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// GetServiceCost indicates an expected call of GetServiceCost.
+func (mr *MockPathMockRecorder) GetServiceCost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceCost", reflect.TypeOf((*MockPath)(nil).GetServiceCost))
+}
+
 // GetTotalCost mocks base method.
 func (m *MockPath) GetTotalCost() float64 {
 	m.ctrl.T.Helper()
@@ -150,6 +206,30 @@ func (mr *MockPathMockRecorder) GetTotalPacketLoss() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPacketLoss", reflect.TypeOf((*MockPath)(nil).GetTotalPacketLoss))
 }
 
+// SetAlternativePaths mocks base method.
+func (m *MockPath) SetAlternativePaths(arg0 []Path) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAlternativePaths", arg0)
+}
+
+// SetAlternativePaths indicates an expected call of SetAlternativePaths.
+func (mr *MockPathMockRecorder) SetAlternativePaths(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlternativePaths", reflect.TypeOf((*MockPath)(nil).SetAlternativePaths), arg0)
+}
+
+// SetBackupPath mocks base method.
+func (m *MockPath) SetBackupPath(arg0 Path) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBackupPath", arg0)
+}
+
+// SetBackupPath indicates an expected call of SetBackupPath.
+func (mr *MockPathMockRecorder) SetBackupPath(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackupPath", reflect.TypeOf((*MockPath)(nil).SetBackupPath), arg0)
+}
+
 // SetBottleneckEdge mocks base method.
 func (m *MockPath) SetBottleneckEdge(arg0 Edge) {
 	m.ctrl.T.Helper()
@@ -174,6 +254,18 @@ func (mr *MockPathMockRecorder) SetBottleneckValue(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBottleneckValue", reflect.TypeOf((*MockPath)(nil).SetBottleneckValue), arg0)
 }
 
+// SetParetoPaths mocks base method.
+func (m *MockPath) SetParetoPaths(arg0 []Path) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetParetoPaths", arg0)
+}
+
+// SetParetoPaths indicates an expected call of SetParetoPaths.
+func (mr *MockPathMockRecorder) SetParetoPaths(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParetoPaths", reflect.TypeOf((*MockPath)(nil).SetParetoPaths), arg0)
+}
+
 // SetRouterServiceMap mocks base method.
 func (m *MockPath) SetRouterServiceMap(arg0 map[string]string) {
 	m.ctrl.T.Helper()
@@ -186,6 +278,18 @@ func (mr *MockPathMockRecorder) SetRouterServiceMap(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRouterServiceMap", reflect.TypeOf((*MockPath)(nil).SetRouterServiceMap), arg0)
 }
 
+// SetServiceCost mocks base method.
+func (m *MockPath) SetServiceCost(arg0 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetServiceCost", arg0)
+}
+
+// SetServiceCost indicates an expected call of SetServiceCost.
+func (mr *MockPathMockRecorder) SetServiceCost(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceCost", reflect.TypeOf((*MockPath)(nil).SetServiceCost), arg0)
+}
+
 // SetTotalCost mocks base method.
 func (m *MockPath) SetTotalCost(arg0 float64) {
 	m.ctrl.T.Helper()
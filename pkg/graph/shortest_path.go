@@ -15,6 +15,10 @@ type ShortestPath struct {
 	bottleneckEdge   Edge
 	bottleneckValue  float64
 	routerServiceMap map[string]string
+	serviceCost      float64
+	alternativePaths []Path
+	backupPath       Path
+	paretoPaths      []Path
 }
 
 func NewShortestPath(edges []Edge, totalCost, delay, jitter, packetLoss, bottleNeckValue float64, bottleneckEdge Edge) *ShortestPath {
@@ -89,3 +93,36 @@ func (path *ShortestPath) SetRouterServiceMap(routerServiceMap map[string]string
 func (path *ShortestPath) GetRouterServiceMap() map[string]string {
 	return path.routerServiceMap
 }
+
+// the share of the total cost caused by the services of a service function chain rather than by the links
+func (path *ShortestPath) SetServiceCost(serviceCost float64) {
+	path.serviceCost = serviceCost
+}
+
+func (path *ShortestPath) GetServiceCost() float64 {
+	return path.serviceCost
+}
+
+func (path *ShortestPath) SetAlternativePaths(alternativePaths []Path) {
+	path.alternativePaths = alternativePaths
+}
+
+func (path *ShortestPath) GetAlternativePaths() []Path {
+	return path.alternativePaths
+}
+
+func (path *ShortestPath) SetBackupPath(backupPath Path) {
+	path.backupPath = backupPath
+}
+
+func (path *ShortestPath) GetBackupPath() Path {
+	return path.backupPath
+}
+
+func (path *ShortestPath) SetParetoPaths(paretoPaths []Path) {
+	path.paretoPaths = paretoPaths
+}
+
+func (path *ShortestPath) GetParetoPaths() []Path {
+	return path.paretoPaths
+}
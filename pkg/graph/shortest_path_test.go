@@ -463,6 +463,25 @@ func TestShortestPath_SetRouterServiceMap(t *testing.T) {
 	}
 }
 
+func TestShortestPath_SetServiceCost(t *testing.T) {
+	tests := []struct {
+		testName    string
+		serviceCost float64
+	}{
+		{
+			testName:    "TestShortestPath_SetServiceCost",
+			serviceCost: 1500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			shortestPath := NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
+			shortestPath.SetServiceCost(tt.serviceCost)
+			assert.Equal(t, tt.serviceCost, shortestPath.GetServiceCost())
+		})
+	}
+}
+
 func TestShortestPath_GetRouterServiceMap(t *testing.T) {
 	tests := []struct {
 		testName         string
@@ -486,3 +505,129 @@ func TestShortestPath_GetRouterServiceMap(t *testing.T) {
 		})
 	}
 }
+
+func TestShortestPath_SetAlternativePaths(t *testing.T) {
+	tests := []struct {
+		testName         string
+		alternativePaths []Path
+	}{
+		{
+			testName: "TestShortestPath_SetAlternativePaths",
+			alternativePaths: []Path{
+				NewShortestPath(nil, 10, 0, 0, 0, 0, nil),
+				NewShortestPath(nil, 20, 0, 0, 0, 0, nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			shortestPath := NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
+			shortestPath.SetAlternativePaths(tt.alternativePaths)
+			assert.Equal(t, tt.alternativePaths, shortestPath.alternativePaths)
+		})
+	}
+}
+
+func TestShortestPath_GetAlternativePaths(t *testing.T) {
+	tests := []struct {
+		testName         string
+		alternativePaths []Path
+	}{
+		{
+			testName: "TestShortestPath_GetAlternativePaths",
+			alternativePaths: []Path{
+				NewShortestPath(nil, 10, 0, 0, 0, 0, nil),
+				NewShortestPath(nil, 20, 0, 0, 0, 0, nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			shortestPath := NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
+			shortestPath.alternativePaths = tt.alternativePaths
+			assert.Equal(t, tt.alternativePaths, shortestPath.GetAlternativePaths())
+		})
+	}
+}
+
+func TestShortestPath_SetBackupPath(t *testing.T) {
+	tests := []struct {
+		testName   string
+		backupPath Path
+	}{
+		{
+			testName:   "TestShortestPath_SetBackupPath",
+			backupPath: NewShortestPath(nil, 10, 0, 0, 0, 0, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			shortestPath := NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
+			shortestPath.SetBackupPath(tt.backupPath)
+			assert.Equal(t, tt.backupPath, shortestPath.backupPath)
+		})
+	}
+}
+
+func TestShortestPath_GetBackupPath(t *testing.T) {
+	tests := []struct {
+		testName   string
+		backupPath Path
+	}{
+		{
+			testName:   "TestShortestPath_GetBackupPath",
+			backupPath: NewShortestPath(nil, 10, 0, 0, 0, 0, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			shortestPath := NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
+			shortestPath.backupPath = tt.backupPath
+			assert.Equal(t, tt.backupPath, shortestPath.GetBackupPath())
+		})
+	}
+}
+
+func TestShortestPath_SetParetoPaths(t *testing.T) {
+	tests := []struct {
+		testName    string
+		paretoPaths []Path
+	}{
+		{
+			testName: "TestShortestPath_SetParetoPaths",
+			paretoPaths: []Path{
+				NewShortestPath(nil, 10, 1000, 0, 0.01, 0, nil),
+				NewShortestPath(nil, 20, 2000, 0, 0.001, 0, nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			shortestPath := NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
+			shortestPath.SetParetoPaths(tt.paretoPaths)
+			assert.Equal(t, tt.paretoPaths, shortestPath.paretoPaths)
+		})
+	}
+}
+
+func TestShortestPath_GetParetoPaths(t *testing.T) {
+	tests := []struct {
+		testName    string
+		paretoPaths []Path
+	}{
+		{
+			testName: "TestShortestPath_GetParetoPaths",
+			paretoPaths: []Path{
+				NewShortestPath(nil, 10, 1000, 0, 0.01, 0, nil),
+				NewShortestPath(nil, 20, 2000, 0, 0.001, 0, nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			shortestPath := NewShortestPath(nil, 0, 0, 0, 0, 0, nil)
+			shortestPath.paretoPaths = tt.paretoPaths
+			assert.Equal(t, tt.paretoPaths, shortestPath.GetParetoPaths())
+		})
+	}
+}
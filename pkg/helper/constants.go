@@ -21,8 +21,11 @@ const (
 	PropertyPrefixLen                      = "PrefixLen"
 	PropertySrv6Sid                        = "Srv6Sid"
 	PropertySrAlgorithm                    = "SrAlgorithm"
+	PropertyNodeMsd                        = "NodeMsd"
 	PropertySrv6Locator                    = "Srv6Locator"
 	PropertySrv6EndpointBehavior           = "Srv6EndpointBehavior"
+	PropertySrv6SidStructure               = "Srv6SidStructure"
+	PropertySrv6EndXSid                    = "Srv6EndxSid"
 )
 
 type WeightKey string
@@ -39,4 +42,7 @@ const (
 	NormalizedLatencyKey    WeightKey = PropertyNormalizedUnidirLinkDelay
 	NormalizedJitterKey     WeightKey = PropertyNormalizedUnidirDelayVariation
 	NormalizedPacketLossKey WeightKey = PropertyNormalizedUnidirPacketLoss
+	// hop count and SID depth are no link properties, they are only used as max constraints
+	HopCountKey WeightKey = "HopCount"
+	SidDepthKey WeightKey = "SidDepth"
 )
@@ -70,3 +70,92 @@ var SkipTlsVerification bool = func() bool {
 	}
 	return false
 }()
+
+var ExactConstrainedPathSearch bool = func() bool {
+	if value, exists := os.LookupEnv("HAWKEYE_EXACT_CONSTRAINED_PATH_SEARCH"); exists {
+		if value == "false" || value == "FALSE" {
+			return false
+		}
+	}
+	return true
+}()
+
+var MinimizeSidList bool = func() bool {
+	if value, exists := os.LookupEnv("HAWKEYE_MINIMIZE_SID_LIST"); exists {
+		if value == "true" || value == "TRUE" {
+			return true
+		}
+	}
+	return false
+}()
+
+var CompressSidList bool = func() bool {
+	if value, exists := os.LookupEnv("HAWKEYE_COMPRESS_SID_LIST"); exists {
+		if value == "true" || value == "TRUE" {
+			return true
+		}
+	}
+	return false
+}()
+
+// share of the instance load in the cost of a service function chain, 0 selects service instances by path cost only,
+// 1 is excluded since the load is scaled by blend/(1-blend)
+var ServiceLoadBlend float64 = func() float64 {
+	if value, exists := os.LookupEnv("HAWKEYE_SERVICE_LOAD_BLEND"); exists {
+		if temp, err := strconv.ParseFloat(value, 64); err == nil && temp >= 0 && temp < 1 {
+			return temp
+		}
+		log.Warnf("Invalid HAWKEYE_SERVICE_LOAD_BLEND %q, the value must be at least 0 and below 1, using the default 0", value)
+	}
+	return 0
+}()
+
+// session cap of service instances which do not register a capacity, 0 if unlimited
+var ServiceSessionCap uint32 = func() uint32 {
+	if value, exists := os.LookupEnv("HAWKEYE_SERVICE_SESSION_CAP"); exists {
+		if temp, err := strconv.ParseUint(value, 10, 32); err == nil {
+			return uint32(temp)
+		}
+	}
+	return 0
+}()
+
+// path requests whose bandwidth demand can not be admitted are queued until bandwidth is released instead of being rejected
+var QueueUnadmittedRequests bool = func() bool {
+	if value, exists := os.LookupEnv("HAWKEYE_QUEUE_UNADMITTED_REQUESTS"); exists {
+		if value == "true" || value == "TRUE" {
+			return true
+		}
+	}
+	return false
+}()
+
+// interval of the periodic global optimization of all open sessions, 0 if only triggered by the operator
+var GlobalOptimizationInterval time.Duration = func() time.Duration {
+	if value, exists := os.LookupEnv("HAWKEYE_GLOBAL_OPTIMIZATION_INTERVAL"); exists {
+		if temp, err := strconv.ParseInt(value, 10, 64); err == nil && temp > 0 {
+			return time.Duration(temp) * time.Second
+		}
+	}
+	return 0
+}()
+
+// the periodic global optimization only reports the proposed moves without applying them
+var GlobalOptimizationDryRun bool = func() bool {
+	if value, exists := os.LookupEnv("HAWKEYE_GLOBAL_OPTIMIZATION_DRY_RUN"); exists {
+		if value == "true" || value == "TRUE" {
+			return true
+		}
+	}
+	return false
+}()
+
+// tie-breaking policy between paths of equal cost: lowest-router-id, fewest-hops or incumbent
+var TieBreakingPolicy string = func() string {
+	if value, exists := os.LookupEnv("HAWKEYE_TIE_BREAKING_POLICY"); exists {
+		if value == "lowest-router-id" || value == "fewest-hops" || value == "incumbent" {
+			return value
+		}
+	}
+	return "lowest-router-id"
+}()
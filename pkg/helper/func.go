@@ -5,13 +5,13 @@ import "github.com/hawkv6/hawkeye/pkg/logging"
 var log = logging.DefaultLogger.WithField("subsystem", subsystem)
 
 func GetLsNodeProperties() []string {
-	lsNodeProperties := []string{PropertyKey, PropertyIgpRouterId, PropertyName, PropertySrAlgorithm}
+	lsNodeProperties := []string{PropertyKey, PropertyIgpRouterId, PropertyName, PropertySrAlgorithm, PropertyNodeMsd}
 	log.Debugln("LsNode properties", lsNodeProperties)
 	return lsNodeProperties
 }
 
 func GetLsLinkProperties() []string {
-	lsLinkProperties := []string{PropertyKey, PropertyIgpRouterId, PropertyRemoteIgpRouterId, PropertyIgpMetric, PropertyUnidirLinkDelay, PropertyUnidirDelayVariation, PropertyMaxLinkBwKbps, PropertyUnidirAvailableBw, PropertyUnidirPacketLoss, PropertyUnidirBwUtilization, PropertyNormalizedUnidirLinkDelay, PropertyNormalizedUnidirDelayVariation, PropertyNormalizedUnidirPacketLoss}
+	lsLinkProperties := []string{PropertyKey, PropertyIgpRouterId, PropertyRemoteIgpRouterId, PropertyIgpMetric, PropertyUnidirLinkDelay, PropertyUnidirDelayVariation, PropertyMaxLinkBwKbps, PropertyUnidirAvailableBw, PropertyUnidirPacketLoss, PropertyUnidirBwUtilization, PropertyNormalizedUnidirLinkDelay, PropertyNormalizedUnidirDelayVariation, PropertyNormalizedUnidirPacketLoss, PropertySrv6EndXSid}
 	log.Debugln("LsLink properties", lsLinkProperties)
 	return lsLinkProperties
 }
@@ -23,7 +23,7 @@ func GetLsPrefixProperties() []string {
 }
 
 func GetLsSrv6SidsProperties() []string {
-	lsSrv6SidsProperties := []string{PropertyKey, PropertyIgpRouterId, PropertySrv6Sid, PropertySrv6EndpointBehavior}
+	lsSrv6SidsProperties := []string{PropertyKey, PropertyIgpRouterId, PropertySrv6Sid, PropertySrv6EndpointBehavior, PropertySrv6SidStructure}
 	log.Debugln("LsSrv6Sids properties", lsSrv6SidsProperties)
 	return lsSrv6SidsProperties
 }
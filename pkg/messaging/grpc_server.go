@@ -2,9 +2,11 @@ package messaging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"sync"
 
 	"github.com/hawkv6/hawkeye/pkg/adapter"
 	"github.com/hawkv6/hawkeye/pkg/api"
@@ -24,8 +26,10 @@ type GrpcMessagingServer struct {
 	pathRequestChan chan domain.PathRequest
 	pathResultChan  chan domain.PathResult
 	errorChan       chan error
-	internalChan    chan error
+	outboundQueues  map[api.IntentController_GetIntentPathServer]*outboundQueue
+	mu              sync.Mutex
 	stopChan        chan struct{}
+	quitChan        chan struct{}
 }
 
 func NewGrpcMessagingServer(adapter adapter.Adapter, config config.Config, messagingChannels MessagingChannels) *GrpcMessagingServer {
@@ -36,8 +40,10 @@ func NewGrpcMessagingServer(adapter adapter.Adapter, config config.Config, messa
 		pathRequestChan: messagingChannels.GetPathRequestChan(),
 		pathResultChan:  messagingChannels.GetPathResponseChan(),
 		errorChan:       messagingChannels.GetErrorChan(),
-		internalChan:    make(chan error),
+		outboundQueues:  make(map[api.IntentController_GetIntentPathServer]*outboundQueue),
+		mu:              sync.Mutex{},
 		stopChan:        make(chan struct{}),
+		quitChan:        make(chan struct{}),
 	}
 }
 
@@ -52,6 +58,7 @@ func (server *GrpcMessagingServer) Start() error {
 	grpcServer := grpc.NewServer()
 	api.RegisterIntentControllerServer(grpcServer, server)
 
+	go server.dispatchOutboundMessages()
 	go func() {
 		if err := grpcServer.Serve(listener); err != nil {
 			server.log.Fatalf("Error starting gRPC server %v", err)
@@ -60,20 +67,78 @@ func (server *GrpcMessagingServer) Start() error {
 
 	<-server.stopChan
 	grpcServer.GracefulStop()
+	close(server.quitChan)
 	return nil
 }
 
-func (server *GrpcMessagingServer) handleIncomingPathRequests(stream api.IntentController_GetIntentPathServer, peerInfo *peer.Peer, ctx context.Context) {
+func (server *GrpcMessagingServer) registerStream(stream api.IntentController_GetIntentPathServer) *outboundQueue {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	queue := newOutboundQueue()
+	server.outboundQueues[stream] = queue
+	return queue
+}
+
+func (server *GrpcMessagingServer) unregisterStream(stream api.IntentController_GetIntentPathServer) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	delete(server.outboundQueues, stream)
+}
+
+func (server *GrpcMessagingServer) getOutboundQueue(stream api.IntentController_GetIntentPathServer) *outboundQueue {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.outboundQueues[stream]
+}
+
+func (server *GrpcMessagingServer) dispatchPathResult(pathResult domain.PathResult) {
+	queue := server.getOutboundQueue(pathResult.GetStream())
+	if queue == nil {
+		server.log.Debugf("Stream of path request %s is closed, dropping path result", pathResult.Serialize())
+		return
+	}
+	queue.enqueuePathResult(pathResult)
+}
+
+func (server *GrpcMessagingServer) dispatchError(err error) {
+	var sessionError *domain.SessionError
+	if !errors.As(err, &sessionError) {
+		server.log.Errorln("Received error without session, dropping it: ", err)
+		return
+	}
+	queue := server.getOutboundQueue(sessionError.GetPathRequest().GetStream())
+	if queue == nil {
+		server.log.Debugf("Stream of path request %s is closed, dropping error: %s", sessionError.GetPathRequest().Serialize(), err)
+		return
+	}
+	queue.enqueueSessionError(sessionError)
+}
+
+// results and errors of all sessions are routed to the outbound queue of the stream which owns the session
+func (server *GrpcMessagingServer) dispatchOutboundMessages() {
+	for {
+		select {
+		case pathResult := <-server.pathResultChan:
+			server.dispatchPathResult(pathResult)
+		case err := <-server.errorChan:
+			server.dispatchError(err)
+		case <-server.quitChan:
+			return
+		}
+	}
+}
+
+func (server *GrpcMessagingServer) handleIncomingPathRequests(stream api.IntentController_GetIntentPathServer, peerInfo *peer.Peer, ctx context.Context, queue *outboundQueue, subscriptions *streamSubscriptions, internalChan chan error) {
 	for {
 		select {
 		case <-ctx.Done():
 			server.log.Debugln("Context cancelled, stopping receiving the stream")
 			return
 		default:
-			if err := server.processStream(stream, peerInfo, ctx); err != nil {
+			if err := server.processStream(stream, peerInfo, ctx, queue, subscriptions); err != nil {
 				if err != io.EOF {
 					server.log.Errorln("Error processing stream: ", err)
-					server.internalChan <- err
+					internalChan <- err
 				}
 				return
 			}
@@ -81,7 +146,29 @@ func (server *GrpcMessagingServer) handleIncomingPathRequests(stream api.IntentC
 	}
 }
 
-func (server *GrpcMessagingServer) processStream(stream api.IntentController_GetIntentPathServer, peerInfo *peer.Peer, ctx context.Context) error {
+// a cancel message closes the session of the stream, a modify message replaces the session by the path request of the message
+func (server *GrpcMessagingServer) processAction(apiRequest *api.PathRequest, subscriptions *streamSubscriptions) bool {
+	sessionId := apiRequest.GetSessionId()
+	switch apiRequest.GetAction() {
+	case api.PathRequestAction_PATH_REQUEST_ACTION_CANCEL:
+		if subscriptions.cancelSession(sessionId) {
+			server.log.Debugf("Session %s has been cancelled", sessionId)
+		} else {
+			server.log.Warnf("Session %s to cancel is unknown", sessionId)
+		}
+		return false
+	case api.PathRequestAction_PATH_REQUEST_ACTION_MODIFY:
+		if !subscriptions.cancelSession(sessionId) {
+			server.log.Warnf("Session %s to modify is unknown", sessionId)
+			return false
+		}
+		server.log.Debugf("Session %s is modified", sessionId)
+	}
+	return true
+}
+
+// an invalid path request only fails itself, its error is queued like a result and the stream stays open
+func (server *GrpcMessagingServer) processStream(stream api.IntentController_GetIntentPathServer, peerInfo *peer.Peer, ctx context.Context, queue *outboundQueue, subscriptions *streamSubscriptions) error {
 	apiRequest, err := stream.Recv()
 	if err != nil {
 		if err == io.EOF && peerInfo != nil {
@@ -93,14 +180,25 @@ func (server *GrpcMessagingServer) processStream(stream api.IntentController_Get
 	}
 
 	server.log.Debugln("Received request: ", apiRequest)
-	pathRequest, err := server.adapter.ConvertPathRequest(apiRequest, stream, ctx)
+	if apiRequest.GetAction() == api.PathRequestAction_PATH_REQUEST_ACTION_CANCEL {
+		server.processAction(apiRequest, subscriptions)
+		return nil
+	}
+	requestCtx := subscriptions.newRequestContext(ctx)
+	pathRequest, err := server.adapter.ConvertPathRequest(apiRequest, stream, requestCtx)
 	if err != nil {
 		server.log.Errorln("Error converting PathRequest: ", err)
-		return err
+		subscriptions.cancelRequest(requestCtx)
+		queue.enqueueRequestError(server.adapter.ConvertRequestError(apiRequest, err))
+		return nil
+	}
+	// the session to modify is only cancelled once the path request replacing it is valid
+	if !server.processAction(apiRequest, subscriptions) {
+		subscriptions.cancelRequest(requestCtx)
+		return nil
 	}
 
 	server.pathRequestChan <- pathRequest
-	go server.handleIntentPathResponse(stream, ctx)
 	return nil
 }
 
@@ -110,11 +208,17 @@ func (server *GrpcMessagingServer) GetIntentPath(stream api.IntentController_Get
 	if ok {
 		server.log.Debugln("Received Stream from: ", peerInfo.Addr)
 	}
-	go server.handleIncomingPathRequests(stream, peerInfo, ctx)
+	// a stream carries any number of path requests, their results are sent by a single sender in the order they are queued
+	queue := server.registerStream(stream)
+	defer server.unregisterStream(stream)
+	subscriptions := newStreamSubscriptions()
+	internalChan := make(chan error, 2)
+	go server.handleIncomingPathRequests(stream, peerInfo, ctx, queue, subscriptions, internalChan)
+	go server.handleIntentPathResponse(stream, ctx, queue, subscriptions, internalChan)
 	select {
 	case <-ctx.Done():
 		return nil
-	case err := <-server.internalChan:
+	case err := <-internalChan:
 		return err
 	}
 }
@@ -130,19 +234,64 @@ func (server *GrpcMessagingServer) processPathResult(stream api.IntentController
 	return nil
 }
 
-func (server *GrpcMessagingServer) handleIntentPathResponse(stream api.IntentController_GetIntentPathServer, ctx context.Context) {
+// results of cancelled path requests are dropped, the session of every other result can be cancelled by the client
+func (server *GrpcMessagingServer) isCancelled(pathResult domain.PathResult, subscriptions *streamSubscriptions) bool {
+	if pathResult == nil {
+		return false
+	}
+	if pathResult.GetContext().Err() != nil {
+		server.log.Debugf("Path request %s has been cancelled, dropping path result", pathResult.Serialize())
+		return true
+	}
+	subscriptions.assignSession(pathResult.GetSessionId(), pathResult.GetContext())
+	return false
+}
+
+// the error of a cancelled path request is dropped, a path request which failed without session is finished by its error
+func (server *GrpcMessagingServer) processSessionError(stream api.IntentController_GetIntentPathServer, sessionError *domain.SessionError, subscriptions *streamSubscriptions) error {
+	pathRequest := sessionError.GetPathRequest()
+	if pathRequest.GetContext().Err() != nil {
+		server.log.Debugf("Path request %s has been cancelled, dropping error: %s", pathRequest.Serialize(), sessionError)
+		return nil
+	}
+	server.log.Warnf("Sending error of path request %s: %s", pathRequest.Serialize(), sessionError)
+	if err := stream.Send(server.adapter.ConvertSessionError(sessionError)); err != nil {
+		return fmt.Errorf("error sending message: %w", err)
+	}
+	if pathRequest.GetSessionId() == "" {
+		subscriptions.cancelRequest(pathRequest.GetContext())
+	}
+	return nil
+}
+
+func (server *GrpcMessagingServer) processOutboundMessage(stream api.IntentController_GetIntentPathServer, message outboundMessage, subscriptions *streamSubscriptions) error {
+	if message.sessionError != nil {
+		return server.processSessionError(stream, message.sessionError, subscriptions)
+	}
+	if message.requestError != nil {
+		if err := stream.Send(message.requestError); err != nil {
+			return fmt.Errorf("error sending message: %w", err)
+		}
+		return nil
+	}
+	if server.isCancelled(message.pathResult, subscriptions) {
+		return nil
+	}
+	return server.processPathResult(stream, message.pathResult)
+}
+
+// only a failure to send closes the stream, errors of single path requests are sent like path results
+func (server *GrpcMessagingServer) handleIntentPathResponse(stream api.IntentController_GetIntentPathServer, ctx context.Context, queue *outboundQueue, subscriptions *streamSubscriptions, internalChan chan error) {
 	for {
 		select {
-		case pathResult := <-server.pathResultChan:
-			if err := server.processPathResult(stream, pathResult); err != nil {
-				server.log.Errorln("Error in processPathResult: ", err)
-				server.internalChan <- err
-				return
+		case <-queue.signalChan:
+			for _, message := range queue.dequeue() {
+				if err := server.processOutboundMessage(stream, message, subscriptions); err != nil {
+					server.log.Errorln("Error in processOutboundMessage: ", err)
+					internalChan <- err
+					return
+				}
 			}
-		case err := <-server.errorChan:
-			server.log.Errorln("Received error: ", err)
-			server.internalChan <- err
-			return
 		case <-ctx.Done():
 			server.log.Debugln("Context cancelled, stopping handleIntentPathResponse")
 			return
@@ -10,6 +10,7 @@ import (
 	"github.com/hawkv6/hawkeye/pkg/adapter"
 	"github.com/hawkv6/hawkeye/pkg/api"
 	"github.com/hawkv6/hawkeye/pkg/config"
+	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -139,7 +140,7 @@ func TestGrpcMessagingServer_handleIncomingPathRequests(t *testing.T) {
 				cancel()
 			}
 			go func() {
-				server.handleIncomingPathRequests(stream, nil, ctx)
+				server.handleIncomingPathRequests(stream, nil, ctx, newOutboundQueue(), newStreamSubscriptions(), make(chan error, 1))
 			}()
 			time.Sleep(100 * time.Millisecond)
 		})
@@ -148,32 +149,59 @@ func TestGrpcMessagingServer_handleIncomingPathRequests(t *testing.T) {
 
 func TestGrpcMessagingServer_processStream(t *testing.T) {
 	tests := []struct {
-		name           string
-		wantReceiveErr bool
-		receiveErr     error
-		wantConvertErr bool
+		name                 string
+		receiveErr           error
+		action               api.PathRequestAction
+		sessionId            string
+		convertErr           bool
+		wantErr              bool
+		wantPathRequest      bool
+		wantRequestError     bool
+		wantSessionCancelled bool
 	}{
 		{
-			name:           "TestGrpcMessagingServer_processStream no error",
-			wantReceiveErr: false,
-			wantConvertErr: false,
+			name:            "TestGrpcMessagingServer_processStream no error",
+			wantPathRequest: true,
 		},
 		{
-			name:           "TestGrpcMessagingServer_processStream receive error EOF",
-			wantReceiveErr: true,
-			wantConvertErr: false,
-			receiveErr:     io.EOF,
+			name:       "TestGrpcMessagingServer_processStream receive error EOF",
+			receiveErr: io.EOF,
+			wantErr:    true,
 		},
 		{
-			name:           "TestGrpcMessagingServer_processStream error not EOF",
-			wantReceiveErr: true,
-			wantConvertErr: false,
-			receiveErr:     assert.AnError,
+			name:       "TestGrpcMessagingServer_processStream error not EOF",
+			receiveErr: assert.AnError,
+			wantErr:    true,
 		},
 		{
-			name:           "TestGrpcMessagingServer_processStream convert error",
-			wantReceiveErr: false,
-			wantConvertErr: true,
+			name:             "TestGrpcMessagingServer_processStream convert error keeps the stream open",
+			convertErr:       true,
+			wantRequestError: true,
+		},
+		{
+			name:                 "TestGrpcMessagingServer_processStream cancel session",
+			action:               api.PathRequestAction_PATH_REQUEST_ACTION_CANCEL,
+			sessionId:            "1",
+			wantSessionCancelled: true,
+		},
+		{
+			name:                 "TestGrpcMessagingServer_processStream modify session",
+			action:               api.PathRequestAction_PATH_REQUEST_ACTION_MODIFY,
+			sessionId:            "1",
+			wantPathRequest:      true,
+			wantSessionCancelled: true,
+		},
+		{
+			name:             "TestGrpcMessagingServer_processStream modify session with invalid path request keeps the session",
+			action:           api.PathRequestAction_PATH_REQUEST_ACTION_MODIFY,
+			sessionId:        "1",
+			convertErr:       true,
+			wantRequestError: true,
+		},
+		{
+			name:      "TestGrpcMessagingServer_processStream modify unknown session",
+			action:    api.PathRequestAction_PATH_REQUEST_ACTION_MODIFY,
+			sessionId: "2",
 		},
 	}
 	for _, tt := range tests {
@@ -184,33 +212,111 @@ func TestGrpcMessagingServer_processStream(t *testing.T) {
 			channels := NewPathMessagingChannels()
 			server := NewGrpcMessagingServer(adapter, config, channels)
 			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
 			stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
-			stream.EXPECT().Context().Return(ctx).AnyTimes()
-			if tt.wantReceiveErr {
-				stream.EXPECT().Recv().Return(nil, tt.receiveErr).AnyTimes()
+			apiRequest := &api.PathRequest{Action: tt.action, SessionId: tt.sessionId}
+			if tt.receiveErr != nil {
+				stream.EXPECT().Recv().Return(nil, tt.receiveErr)
 			} else {
-				stream.EXPECT().Recv().Return(&api.PathRequest{}, nil).AnyTimes()
+				stream.EXPECT().Recv().Return(apiRequest, nil)
 			}
-			if tt.wantConvertErr {
-				adapter.EXPECT().ConvertPathRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError).AnyTimes()
-			} else {
-				adapter.EXPECT().ConvertPathRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			pathRequest := domain.NewMockPathRequest(gomock.NewController(t))
+			requestError := &api.PathResult{ErrorMessage: assert.AnError.Error()}
+			if tt.convertErr {
+				adapter.EXPECT().ConvertPathRequest(apiRequest, stream, gomock.Any()).Return(nil, assert.AnError)
+				adapter.EXPECT().ConvertRequestError(apiRequest, assert.AnError).Return(requestError)
+			} else if tt.receiveErr == nil && tt.action != api.PathRequestAction_PATH_REQUEST_ACTION_CANCEL {
+				adapter.EXPECT().ConvertPathRequest(apiRequest, stream, gomock.Any()).Return(pathRequest, nil)
 			}
-			wg := sync.WaitGroup{}
-			wg.Add(1)
-			go func() {
-				err := server.processStream(stream, nil, ctx)
-				if (err != nil) != (tt.wantReceiveErr || tt.wantConvertErr) {
-					t.Errorf("GrpcMessagingServer.processStream() error = %v, wantReceiveErr %v, wantConvertErr %v", err, tt.wantReceiveErr, tt.wantConvertErr)
-				}
-				wg.Done()
-			}()
-			time.Sleep(100 * time.Millisecond)
+			queue := newOutboundQueue()
+			subscriptions := newStreamSubscriptions()
+			sessionCtx := subscriptions.newRequestContext(ctx)
+			subscriptions.assignSession("1", sessionCtx)
+
+			errChan := make(chan error, 1)
 			go func() {
-				<-server.pathRequestChan
+				errChan <- server.processStream(stream, nil, ctx, queue, subscriptions)
 			}()
-			cancel()
-			wg.Wait()
+			if tt.wantPathRequest {
+				assert.Equal(t, pathRequest, <-server.pathRequestChan)
+			}
+			err := <-errChan
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcMessagingServer.processStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRequestError {
+				assert.Equal(t, []outboundMessage{{requestError: requestError}}, queue.dequeue())
+			} else {
+				assert.Empty(t, queue.dequeue())
+			}
+			assert.Equal(t, tt.wantSessionCancelled, sessionCtx.Err() != nil)
+			// only the context of a forwarded path request is kept besides the context of the session
+			wantContexts := 1
+			if tt.wantSessionCancelled {
+				wantContexts = 0
+			}
+			if tt.wantPathRequest {
+				wantContexts++
+			}
+			assert.Len(t, subscriptions.cancelFuncs, wantContexts)
+		})
+	}
+}
+
+func TestGrpcMessagingServer_processAction(t *testing.T) {
+	tests := []struct {
+		name          string
+		action        api.PathRequestAction
+		sessionId     string
+		wantProcess   bool
+		wantCancelled bool
+	}{
+		{
+			name:          "TestGrpcMessagingServer_processAction new path request",
+			action:        api.PathRequestAction_PATH_REQUEST_ACTION_UNSPECIFIED,
+			wantProcess:   true,
+			wantCancelled: false,
+		},
+		{
+			name:          "TestGrpcMessagingServer_processAction cancel session",
+			action:        api.PathRequestAction_PATH_REQUEST_ACTION_CANCEL,
+			sessionId:     "1",
+			wantProcess:   false,
+			wantCancelled: true,
+		},
+		{
+			name:          "TestGrpcMessagingServer_processAction cancel unknown session",
+			action:        api.PathRequestAction_PATH_REQUEST_ACTION_CANCEL,
+			sessionId:     "2",
+			wantProcess:   false,
+			wantCancelled: false,
+		},
+		{
+			name:          "TestGrpcMessagingServer_processAction modify session",
+			action:        api.PathRequestAction_PATH_REQUEST_ACTION_MODIFY,
+			sessionId:     "1",
+			wantProcess:   true,
+			wantCancelled: true,
+		},
+		{
+			name:          "TestGrpcMessagingServer_processAction modify unknown session",
+			action:        api.PathRequestAction_PATH_REQUEST_ACTION_MODIFY,
+			sessionId:     "2",
+			wantProcess:   false,
+			wantCancelled: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := config.NewMockConfig(gomock.NewController(t))
+			config.EXPECT().GetGrpcPort().Return(uint16(10000)).AnyTimes()
+			server := NewGrpcMessagingServer(adapter.NewMockAdapter(gomock.NewController(t)), config, NewPathMessagingChannels())
+			subscriptions := newStreamSubscriptions()
+			ctx := subscriptions.newRequestContext(context.Background())
+			subscriptions.assignSession("1", ctx)
+			apiRequest := &api.PathRequest{Action: tt.action, SessionId: tt.sessionId}
+			assert.Equal(t, tt.wantProcess, server.processAction(apiRequest, subscriptions))
+			assert.Equal(t, tt.wantCancelled, ctx.Err() != nil)
 		})
 	}
 }
@@ -267,22 +373,32 @@ func TestGrpcMessagingServer_handleIntentPathResponse(t *testing.T) {
 	tests := []struct {
 		name           string
 		wantProcessErr bool
-		wantOtherErr   bool
+		wantSessionErr bool
+		wantRequestErr bool
+		wantCancelled  bool
 	}{
 		{
 			name:           "TestGrpcMessagingServer_handleIntentPathResponse success",
 			wantProcessErr: false,
-			wantOtherErr:   false,
+			wantSessionErr: false,
+		},
+		{
+			name:          "TestGrpcMessagingServer_handleIntentPathResponse cancelled path request",
+			wantCancelled: true,
 		},
 		{
 			name:           "TestGrpcMessagingServer_handleIntentPathResponse process error",
 			wantProcessErr: true,
-			wantOtherErr:   false,
+			wantSessionErr: false,
 		},
 		{
-			name:           "TestGrpcMessagingServer_handleIntentPathResponse other error",
+			name:           "TestGrpcMessagingServer_handleIntentPathResponse session error keeps the stream open",
 			wantProcessErr: false,
-			wantOtherErr:   true,
+			wantSessionErr: true,
+		},
+		{
+			name:           "TestGrpcMessagingServer_handleIntentPathResponse request error keeps the stream open",
+			wantRequestErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -293,23 +409,148 @@ func TestGrpcMessagingServer_handleIntentPathResponse(t *testing.T) {
 			channels := NewPathMessagingChannels()
 			server := NewGrpcMessagingServer(adapter, config, channels)
 			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
 			stream := api.NewMockIntentController_GetIntentPathServer(gomock.NewController(t))
 			stream.EXPECT().Context().Return(ctx).AnyTimes()
+			queue := newOutboundQueue()
+			subscriptions := newStreamSubscriptions()
+			internalChan := make(chan error, 1)
 			go func() {
-				server.handleIntentPathResponse(stream, ctx)
+				server.handleIntentPathResponse(stream, ctx, queue, subscriptions, internalChan)
 			}()
-			if !tt.wantProcessErr && !tt.wantOtherErr {
-				adapter.EXPECT().ConvertPathResult(gomock.Any()).Return(&api.PathResult{}, nil).AnyTimes()
-				stream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
-				channels.GetPathResponseChan() <- nil
+			if tt.wantCancelled {
+				cancelledCtx, cancelRequest := context.WithCancel(context.Background())
+				cancelRequest()
+				pathResult := domain.NewMockPathResult(gomock.NewController(t))
+				pathResult.EXPECT().GetContext().Return(cancelledCtx).AnyTimes()
+				pathResult.EXPECT().Serialize().Return("").AnyTimes()
+				queue.enqueuePathResult(pathResult)
+				time.Sleep(100 * time.Millisecond)
+				assert.Len(t, internalChan, 0)
+			} else if tt.wantRequestErr {
+				requestError := &api.PathResult{RequestId: "invalid", ErrorMessage: assert.AnError.Error()}
+				stream.EXPECT().Send(requestError).Return(nil)
+				queue.enqueueRequestError(requestError)
+				time.Sleep(100 * time.Millisecond)
+				assert.Len(t, internalChan, 0)
+			} else if !tt.wantProcessErr && !tt.wantSessionErr {
+				adapter.EXPECT().ConvertPathResult(gomock.Any()).Return(&api.PathResult{}, nil).Times(2)
+				stream.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+				queue.enqueuePathResult(nil)
+				queue.enqueuePathResult(nil)
+				time.Sleep(100 * time.Millisecond)
+				assert.Len(t, internalChan, 0)
 			} else if tt.wantProcessErr {
 				adapter.EXPECT().ConvertPathResult(gomock.Any()).Return(nil, assert.AnError).AnyTimes()
-				channels.GetPathResponseChan() <- nil
-			} else if tt.wantOtherErr {
+				queue.enqueuePathResult(nil)
+				assert.Error(t, <-internalChan)
+			} else if tt.wantSessionErr {
+				failedCtx := subscriptions.newRequestContext(ctx)
+				failedRequest := domain.NewMockPathRequest(gomock.NewController(t))
+				failedRequest.EXPECT().GetContext().Return(failedCtx).AnyTimes()
+				failedRequest.EXPECT().GetSessionId().Return("").AnyTimes()
+				failedRequest.EXPECT().Serialize().Return("2001:db8::1,2001:db8::3,LowLatency").AnyTimes()
+				sessionError := domain.NewSessionError(failedRequest, assert.AnError)
+				siblingCtx := subscriptions.newRequestContext(ctx)
+				siblingResult := domain.NewMockPathResult(gomock.NewController(t))
+				siblingResult.EXPECT().GetContext().Return(siblingCtx).AnyTimes()
+				siblingResult.EXPECT().GetSessionId().Return("1").AnyTimes()
+				apiError := &api.PathResult{RequestId: "failed", ErrorMessage: assert.AnError.Error()}
+				apiResult := &api.PathResult{RequestId: "sibling", SessionId: "1"}
+				adapter.EXPECT().ConvertSessionError(sessionError).Return(apiError)
+				adapter.EXPECT().ConvertPathResult(siblingResult).Return(apiResult, nil).Times(3)
+				gomock.InOrder(
+					stream.EXPECT().Send(apiResult).Return(nil),
+					stream.EXPECT().Send(apiError).Return(nil),
+					stream.EXPECT().Send(apiResult).Return(nil).Times(2),
+				)
+				queue.enqueuePathResult(siblingResult)
+				queue.enqueueSessionError(sessionError)
+				queue.enqueuePathResult(siblingResult)
+				time.Sleep(100 * time.Millisecond)
+				assert.Error(t, failedCtx.Err())
+				queue.enqueuePathResult(siblingResult)
+				time.Sleep(100 * time.Millisecond)
+				assert.Len(t, internalChan, 0)
+				assert.NoError(t, siblingCtx.Err())
+			}
+		})
+	}
+}
+
+func TestGrpcMessagingServer_dispatchOutboundMessages(t *testing.T) {
+	tests := []struct {
+		name       string
+		sendError  bool
+		wrapError  bool
+		registered bool
+	}{
+		{
+			name:       "TestGrpcMessagingServer_dispatchOutboundMessages path result to owning stream",
+			registered: true,
+		},
+		{
+			name:       "TestGrpcMessagingServer_dispatchOutboundMessages session error to owning stream",
+			sendError:  true,
+			wrapError:  true,
+			registered: true,
+		},
+		{
+			name:       "TestGrpcMessagingServer_dispatchOutboundMessages error without session is dropped",
+			sendError:  true,
+			wrapError:  false,
+			registered: true,
+		},
+		{
+			name:       "TestGrpcMessagingServer_dispatchOutboundMessages path result of closed stream is dropped",
+			registered: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			config := config.NewMockConfig(controller)
+			config.EXPECT().GetGrpcPort().Return(uint16(10000)).AnyTimes()
+			channels := NewPathMessagingChannels()
+			server := NewGrpcMessagingServer(adapter.NewMockAdapter(controller), config, channels)
+			ownStream := api.NewMockIntentController_GetIntentPathServer(controller)
+			otherStream := api.NewMockIntentController_GetIntentPathServer(controller)
+			otherQueue := server.registerStream(otherStream)
+			var ownQueue *outboundQueue
+			if tt.registered {
+				ownQueue = server.registerStream(ownStream)
+			}
+			pathRequest := domain.NewMockPathRequest(controller)
+			pathRequest.EXPECT().GetStream().Return(ownStream).AnyTimes()
+			pathRequest.EXPECT().Serialize().Return("2001:db8::1,2001:db8::2,LowLatency").AnyTimes()
+			pathResult := domain.NewMockPathResult(controller)
+			pathResult.EXPECT().GetStream().Return(ownStream).AnyTimes()
+			pathResult.EXPECT().Serialize().Return("2001:db8::1,2001:db8::2,LowLatency").AnyTimes()
+
+			go server.dispatchOutboundMessages()
+			defer close(server.quitChan)
+			if !tt.sendError {
+				channels.GetPathResponseChan() <- pathResult
+			} else if tt.wrapError {
+				channels.GetErrorChan() <- domain.NewSessionError(pathRequest, assert.AnError)
+			} else {
 				channels.GetErrorChan() <- assert.AnError
 			}
-			time.Sleep(100 * time.Millisecond)
-			cancel()
+			time.Sleep(50 * time.Millisecond)
+
+			assert.Empty(t, otherQueue.dequeue())
+			if !tt.registered {
+				return
+			}
+			ownMessages := ownQueue.dequeue()
+			if !tt.sendError {
+				assert.Equal(t, []outboundMessage{{pathResult: pathResult}}, ownMessages)
+			} else if tt.wrapError {
+				assert.Len(t, ownMessages, 1)
+				assert.ErrorIs(t, ownMessages[0].sessionError, assert.AnError)
+			} else {
+				assert.Empty(t, ownMessages)
+			}
 		})
 	}
 }
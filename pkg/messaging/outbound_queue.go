@@ -0,0 +1,67 @@
+package messaging
+
+import (
+	"sync"
+
+	"github.com/hawkv6/hawkeye/pkg/api"
+	"github.com/hawkv6/hawkeye/pkg/domain"
+)
+
+// message of the outbound queue, either a path result, the error of a path request or its session
+// or the error result of a path request which could not be converted
+type outboundMessage struct {
+	pathResult   domain.PathResult
+	sessionError *domain.SessionError
+	requestError *api.PathResult
+}
+
+// outbound queue of a stream, the results and errors of all sessions of the stream are queued in order
+// so a slow stream does not block the delivery to other streams
+type outboundQueue struct {
+	mu         sync.Mutex
+	messages   []outboundMessage
+	signalChan chan struct{}
+}
+
+func newOutboundQueue() *outboundQueue {
+	return &outboundQueue{
+		messages:   make([]outboundMessage, 0),
+		signalChan: make(chan struct{}, 1),
+	}
+}
+
+// the sender is only signaled once for several queued messages
+func (queue *outboundQueue) signal() {
+	select {
+	case queue.signalChan <- struct{}{}:
+	default:
+	}
+}
+
+func (queue *outboundQueue) enqueue(message outboundMessage) {
+	queue.mu.Lock()
+	queue.messages = append(queue.messages, message)
+	queue.mu.Unlock()
+	queue.signal()
+}
+
+func (queue *outboundQueue) enqueuePathResult(pathResult domain.PathResult) {
+	queue.enqueue(outboundMessage{pathResult: pathResult})
+}
+
+// an error only concerns its path request, the stream stays open for the other path requests
+func (queue *outboundQueue) enqueueSessionError(sessionError *domain.SessionError) {
+	queue.enqueue(outboundMessage{sessionError: sessionError})
+}
+
+func (queue *outboundQueue) enqueueRequestError(requestError *api.PathResult) {
+	queue.enqueue(outboundMessage{requestError: requestError})
+}
+
+func (queue *outboundQueue) dequeue() []outboundMessage {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	messages := queue.messages
+	queue.messages = make([]outboundMessage, 0)
+	return messages
+}
@@ -0,0 +1,65 @@
+package messaging
+
+import (
+	"testing"
+
+	"github.com/hawkv6/hawkeye/pkg/api"
+	"github.com/hawkv6/hawkeye/pkg/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboundQueue_dequeue(t *testing.T) {
+	tests := []struct {
+		name          string
+		resultCount   int
+		errorCount    int
+		requestErrors bool
+	}{
+		{
+			name:        "Test dequeue path results in order",
+			resultCount: 3,
+		},
+		{
+			name:        "Test dequeue path results and session errors in order",
+			resultCount: 2,
+			errorCount:  2,
+		},
+		{
+			name:          "Test dequeue path results and request errors in order",
+			resultCount:   2,
+			errorCount:    2,
+			requestErrors: true,
+		},
+		{
+			name:        "Test dequeue empty queue",
+			resultCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := newOutboundQueue()
+			messages := make([]outboundMessage, 0, tt.resultCount+tt.errorCount)
+			for index := 0; index < tt.resultCount; index++ {
+				pathResult := domain.NewMockPathResult(gomock.NewController(t))
+				messages = append(messages, outboundMessage{pathResult: pathResult})
+				queue.enqueuePathResult(pathResult)
+				if index < tt.errorCount && tt.requestErrors {
+					requestError := &api.PathResult{ErrorMessage: assert.AnError.Error()}
+					messages = append(messages, outboundMessage{requestError: requestError})
+					queue.enqueueRequestError(requestError)
+				} else if index < tt.errorCount {
+					sessionError := domain.NewSessionError(domain.NewMockPathRequest(gomock.NewController(t)), assert.AnError)
+					messages = append(messages, outboundMessage{sessionError: sessionError})
+					queue.enqueueSessionError(sessionError)
+				}
+			}
+			if len(messages) > 0 {
+				<-queue.signalChan
+			}
+			assert.Len(t, queue.signalChan, 0)
+			assert.Equal(t, messages, queue.dequeue())
+			assert.Empty(t, queue.dequeue())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package messaging
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// subscriptions of a stream, every path request of the stream gets its own context
+// so the client can cancel a single session by its session identifier
+type streamSubscriptions struct {
+	mu          sync.Mutex
+	cancelFuncs map[context.Context]context.CancelFunc
+	sessions    map[string][]context.Context
+}
+
+func newStreamSubscriptions() *streamSubscriptions {
+	return &streamSubscriptions{
+		cancelFuncs: make(map[context.Context]context.CancelFunc),
+		sessions:    make(map[string][]context.Context),
+	}
+}
+
+// the context of a path request is cancelled with its session or when the stream is closed
+func (subscriptions *streamSubscriptions) newRequestContext(parent context.Context) context.Context {
+	subscriptions.mu.Lock()
+	defer subscriptions.mu.Unlock()
+	ctx, cancel := context.WithCancel(parent)
+	subscriptions.cancelFuncs[ctx] = cancel
+	return ctx
+}
+
+// the session identifier is only known once the first path result of the path request is sent
+func (subscriptions *streamSubscriptions) assignSession(sessionId string, ctx context.Context) {
+	subscriptions.mu.Lock()
+	defer subscriptions.mu.Unlock()
+	if _, ok := subscriptions.cancelFuncs[ctx]; !ok || sessionId == "" {
+		return
+	}
+	if !slices.Contains(subscriptions.sessions[sessionId], ctx) {
+		subscriptions.sessions[sessionId] = append(subscriptions.sessions[sessionId], ctx)
+	}
+}
+
+// all path requests of the stream belonging to the session are cancelled, false if the session is unknown
+func (subscriptions *streamSubscriptions) cancelSession(sessionId string) bool {
+	subscriptions.mu.Lock()
+	defer subscriptions.mu.Unlock()
+	contexts, ok := subscriptions.sessions[sessionId]
+	if !ok {
+		return false
+	}
+	for _, ctx := range contexts {
+		subscriptions.cancelFuncs[ctx]()
+		delete(subscriptions.cancelFuncs, ctx)
+	}
+	delete(subscriptions.sessions, sessionId)
+	return true
+}
+
+// a path request which failed without session is finished, its context is released
+func (subscriptions *streamSubscriptions) cancelRequest(ctx context.Context) {
+	subscriptions.mu.Lock()
+	defer subscriptions.mu.Unlock()
+	if cancel, ok := subscriptions.cancelFuncs[ctx]; ok {
+		cancel()
+		delete(subscriptions.cancelFuncs, ctx)
+	}
+}
@@ -0,0 +1,100 @@
+package messaging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamSubscriptions_cancelSession(t *testing.T) {
+	tests := []struct {
+		name             string
+		assignedSession  string
+		cancelledSession string
+		wantCancelled    bool
+	}{
+		{
+			name:             "Test cancelSession of assigned session",
+			assignedSession:  "1",
+			cancelledSession: "1",
+			wantCancelled:    true,
+		},
+		{
+			name:             "Test cancelSession of unknown session",
+			assignedSession:  "1",
+			cancelledSession: "2",
+			wantCancelled:    false,
+		},
+		{
+			name:             "Test cancelSession without session identifier",
+			assignedSession:  "",
+			cancelledSession: "",
+			wantCancelled:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			subscriptions := newStreamSubscriptions()
+			ctx := subscriptions.newRequestContext(parent)
+			otherCtx := subscriptions.newRequestContext(parent)
+			subscriptions.assignSession(tt.assignedSession, ctx)
+			subscriptions.assignSession(tt.assignedSession, ctx)
+
+			assert.Equal(t, tt.wantCancelled, subscriptions.cancelSession(tt.cancelledSession))
+			assert.Equal(t, tt.wantCancelled, ctx.Err() != nil)
+			assert.NoError(t, otherCtx.Err())
+
+			// a cancelled path request is not assigned to its session again
+			subscriptions.assignSession(tt.assignedSession, ctx)
+			assert.Equal(t, !tt.wantCancelled && tt.assignedSession != "", subscriptions.cancelSession(tt.assignedSession))
+		})
+	}
+}
+
+func TestStreamSubscriptions_newRequestContext(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Test newRequestContext cancelled with the stream",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, cancel := context.WithCancel(context.Background())
+			subscriptions := newStreamSubscriptions()
+			ctx := subscriptions.newRequestContext(parent)
+			assert.NoError(t, ctx.Err())
+			cancel()
+			assert.Error(t, ctx.Err())
+		})
+	}
+}
+
+func TestStreamSubscriptions_cancelRequest(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Test cancelRequest of failed path request",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			subscriptions := newStreamSubscriptions()
+			ctx := subscriptions.newRequestContext(parent)
+			otherCtx := subscriptions.newRequestContext(parent)
+			subscriptions.cancelRequest(ctx)
+			assert.Error(t, ctx.Err())
+			assert.NoError(t, otherCtx.Err())
+			assert.NotContains(t, subscriptions.cancelFuncs, ctx)
+			subscriptions.assignSession("1", ctx)
+			assert.False(t, subscriptions.cancelSession("1"))
+		})
+	}
+}
@@ -40,8 +40,14 @@ func (processor *LinkEventProcessor) getCurrentLinkWeights(link domain.Link) map
 	}
 }
 
+func (processor *LinkEventProcessor) storeAdjacencySids(link domain.Link) {
+	processor.log.Debugf("Store End.X SIDs %v of link %s in cache", link.GetAdjacencySids(), link.GetKey())
+	processor.cache.StoreAdjacencySids(link.GetKey(), link.GetAdjacencySids())
+}
+
 func (processor *LinkEventProcessor) deleteEdge(key string) bool {
 	processor.log.Debugln("Delete edge with key: ", key)
+	processor.cache.RemoveAdjacencySids(key)
 	if processor.graph.EdgeExists(key) {
 		edge := processor.graph.GetEdge(key)
 		processor.log.Debugf("Delete edge with key %s from graph between %s and %s", key, edge.From().GetName(), edge.To().GetName())
@@ -78,6 +84,7 @@ func (processor *LinkEventProcessor) getOrCreateNode(nodeIgpRouterId string) gra
 
 func (processor *LinkEventProcessor) addLinkToGraph(link domain.Link) error {
 	key := link.GetKey()
+	processor.storeAdjacencySids(link)
 	if !processor.graph.EdgeExists(key) {
 		weights := processor.getCurrentLinkWeights(link)
 		for weightKey, value := range weights {
@@ -108,6 +115,7 @@ func (processor *LinkEventProcessor) setEdgeWeight(edge graph.Edge, key helper.W
 func (processor *LinkEventProcessor) updateLinkInGraph(link domain.Link) error {
 	key := link.GetKey()
 	processor.log.Debugln("Updating link in graph with key: ", key)
+	processor.storeAdjacencySids(link)
 	edge := processor.graph.GetEdge(key)
 	if edge == nil {
 		processor.log.Debugf("Link with key %s does not exist in graph, create it", key)
@@ -127,6 +127,41 @@ func TestLinkEventProcessor_deleteEdge(t *testing.T) {
 	}
 }
 
+func TestLinkEventProcessor_storeAdjacencySids(t *testing.T) {
+	tests := []struct {
+		name          string
+		adjacencySids map[uint32]string
+		deleteEdge    bool
+		want          string
+	}{
+		{
+			name:          "TestLinkEventProcessor_storeAdjacencySids End.X SID stored",
+			adjacencySids: map[uint32]string{0: "fc00:0:b:e000::"},
+			want:          "fc00:0:b:e000::",
+		},
+		{
+			name:          "TestLinkEventProcessor_storeAdjacencySids End.X SID removed with edge",
+			adjacencySids: map[uint32]string{0: "fc00:0:b:e000::"},
+			deleteEdge:    true,
+			want:          "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inMemoryCache := cache.NewInMemoryCache()
+			processor := NewLinkEventProcessor(graph.NewNetworkGraph(), inMemoryCache)
+			link, err := domain.NewDomainLink(proto.String("2_0_2_0_0000.0000.000b_2001:db8:b6::b_0000.0000.0006_2001:db8:b6::6"), proto.String("0000.0000.000b"), proto.String("0000.0000.0006"), proto.Uint32(10), proto.Uint32(2000), proto.Uint32(100), proto.Uint64(1000000), proto.Uint32(99766), proto.Uint32(234), proto.Float64(3.0059316283477027), proto.Float64(0.05), proto.Float64(0.016452169298129225), proto.Float64(0.01))
+			assert.NoError(t, err)
+			link.SetAdjacencySids(tt.adjacencySids)
+			assert.NoError(t, processor.addLinkToGraph(link))
+			if tt.deleteEdge {
+				assert.True(t, processor.deleteEdge(link.GetKey()))
+			}
+			assert.Equal(t, tt.want, inMemoryCache.GetAdjacencySid(link.GetKey(), 0))
+		})
+	}
+}
+
 func TestLinkEventProcessor_addEdgeToGraph(t *testing.T) {
 	tests := []struct {
 		name    string
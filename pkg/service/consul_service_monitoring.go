@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strconv"
 	"sync"
 
 	"github.com/go-playground/validator"
 	"github.com/hashicorp/consul/api"
 	"github.com/hawkv6/hawkeye/pkg/cache"
+	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/hawkv6/hawkeye/pkg/helper"
 	"github.com/hawkv6/hawkeye/pkg/logging"
 	"github.com/sirupsen/logrus"
@@ -93,6 +96,7 @@ func (monitor *ConsulServiceMonitor) deleteServiceEntry(serviceId, serviceType s
 	defer monitor.mu.Unlock()
 	service := monitor.services[serviceType][serviceId]
 	monitor.removeServiceSidInCache(serviceType, service.prefixSid)
+	monitor.removeServiceMetadataInCache(service.prefixSid)
 	delete(monitor.services[serviceType], serviceId)
 	monitor.log.Debugf("Service %s deleted", serviceId)
 }
@@ -117,6 +121,61 @@ func (monitor *ConsulServiceMonitor) getKnownServices(serviceEntries []*api.Serv
 	return knownServices, serviceType, nil
 }
 
+func (monitor *ConsulServiceMonitor) removeServiceMetadataInCache(prefixSid string) {
+	monitor.cache.Lock()
+	monitor.cache.RemoveServiceMetadata(prefixSid)
+	monitor.cache.Unlock()
+}
+
+func (monitor *ConsulServiceMonitor) parseMetadataValue(serviceId string, meta map[string]string, key string, defaultValue float64) float64 {
+	value, ok := meta[key]
+	if !ok {
+		return defaultValue
+	}
+	parsedValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		monitor.log.Warnf("Invalid %s %s for service %s, using default %g", key, value, serviceId, defaultValue)
+		return defaultValue
+	}
+	return parsedValue
+}
+
+// load in percent, weight, capacity in sessions, delay and jitter in microseconds and loss in percent are optional,
+// instances without them are treated as idle, unlimited and without processing impact
+func (monitor *ConsulServiceMonitor) getServiceMetadata(serviceId string, meta map[string]string) domain.ServiceMetadata {
+	load := monitor.parseMetadataValue(serviceId, meta, "load", 0)
+	weight := monitor.parseMetadataValue(serviceId, meta, "weight", 1)
+	capacity := monitor.parseMetadataValue(serviceId, meta, "capacity", 0)
+	if capacity < 0 {
+		monitor.log.Warnf("Invalid capacity %g for service %s, using unlimited capacity", capacity, serviceId)
+		capacity = 0
+	}
+	delay := monitor.parseMetadataValue(serviceId, meta, "delay", 0)
+	jitter := monitor.parseMetadataValue(serviceId, meta, "jitter", 0)
+	packetLoss := monitor.parseMetadataValue(serviceId, meta, "loss", 0)
+	metadata, err := domain.NewDomainServiceMetadata(load, weight, uint32(capacity), delay, jitter, packetLoss)
+	if err != nil {
+		monitor.log.Warnf("Invalid metadata for service %s, using defaults: %v", serviceId, err)
+		metadata, _ = domain.NewDomainServiceMetadata(0, 1, 0, 0, 0, 0)
+	}
+	return metadata
+}
+
+func (monitor *ConsulServiceMonitor) updateServiceMetadata(serviceId, serviceType string, metadata domain.ServiceMetadata) {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	service, ok := monitor.services[serviceType][serviceId]
+	if !ok || reflect.DeepEqual(service.metadata, metadata) {
+		return
+	}
+	service.metadata = metadata
+	monitor.cache.Lock()
+	monitor.cache.StoreServiceMetadata(service.prefixSid, metadata)
+	monitor.cache.Unlock()
+	monitor.needsUpdate = true
+	monitor.log.Debugf("Service %s metadata updated - load: %g, weight: %g, capacity: %d, delay: %g, jitter: %g, loss: %g", serviceId, metadata.GetLoad(), metadata.GetWeight(), metadata.GetCapacity(), metadata.GetDelay(), metadata.GetJitter(), metadata.GetPacketLoss())
+}
+
 func (monitor *ConsulServiceMonitor) processServiceEntries(serviceEntries []*api.ServiceEntry, knownServices map[string]bool, serviceType string) {
 	for _, serviceEntry := range serviceEntries {
 		prefixSid := serviceEntry.Service.Meta["sid"]
@@ -130,6 +189,7 @@ func (monitor *ConsulServiceMonitor) processServiceEntries(serviceEntries []*api
 			} else {
 				knownServices[serviceId] = true
 			}
+			monitor.updateServiceMetadata(serviceId, serviceType, monitor.getServiceMetadata(serviceId, serviceEntry.Service.Meta))
 		}
 	}
 }
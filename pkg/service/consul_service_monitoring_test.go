@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hawkv6/hawkeye/pkg/cache"
+	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -140,6 +141,7 @@ func TestConsulServiceMOnitor_deleteServiceEntry(t *testing.T) {
 			cacheMock.EXPECT().Lock().Return().AnyTimes()
 			cacheMock.EXPECT().Unlock().Return().AnyTimes()
 			cacheMock.EXPECT().RemoveServiceSid(gomock.Any(), gomock.Any()).Return().AnyTimes()
+			cacheMock.EXPECT().RemoveServiceMetadata(gomock.Any()).Return().AnyTimes()
 			serviceMonitor.services = make(map[string]map[string]*ConcreteService)
 			serviceMonitor.services[serviceType] = make(map[string]*ConcreteService)
 			serviceMonitor.services[serviceType][serviceId] = NewConcreteService(serviceType, serviceId, serviceSid, true)
@@ -170,6 +172,7 @@ func TestConsulServiceMonitor_deleteServiceEntries(t *testing.T) {
 			cacheMock.EXPECT().Lock().Return().AnyTimes()
 			cacheMock.EXPECT().Unlock().Return().AnyTimes()
 			cacheMock.EXPECT().RemoveServiceSid(gomock.Any(), gomock.Any()).Return().AnyTimes()
+			cacheMock.EXPECT().RemoveServiceMetadata(gomock.Any()).Return().AnyTimes()
 			serviceMonitor.services = make(map[string]map[string]*ConcreteService)
 			serviceMonitor.services["fw"] = make(map[string]*ConcreteService)
 			serviceMonitor.services["fw"]["SERA-1"] = NewConcreteService(serviceType, "SERA-1", "fc:0:2f::", true)
@@ -256,6 +259,7 @@ func TestConsulServiceMonitor_processServiceEntries(t *testing.T) {
 			cacheMock.EXPECT().Lock().Return().AnyTimes()
 			cacheMock.EXPECT().Unlock().Return().AnyTimes()
 			cacheMock.EXPECT().StoreServiceSid(gomock.Any(), gomock.Any()).Return().AnyTimes()
+			cacheMock.EXPECT().StoreServiceMetadata(gomock.Any(), gomock.Any()).Return().AnyTimes()
 			var knownServices map[string]bool
 			if tt.knownService {
 				knownServices = map[string]bool{
@@ -290,6 +294,137 @@ func TestConsulServiceMonitor_processServiceEntries(t *testing.T) {
 	}
 }
 
+func TestConsulServiceMonitor_getServiceMetadata(t *testing.T) {
+	tests := []struct {
+		name         string
+		meta         map[string]string
+		wantLoad     float64
+		wantWeight   float64
+		wantCapacity uint32
+		wantDelay    float64
+		wantJitter   float64
+		wantLoss     float64
+	}{
+		{
+			name:         "TestConsulServiceMonitor_getServiceMetadata without metadata",
+			meta:         map[string]string{"sid": "fc:0:2f::"},
+			wantLoad:     0,
+			wantWeight:   1,
+			wantCapacity: 0,
+		},
+		{
+			name:         "TestConsulServiceMonitor_getServiceMetadata with metadata",
+			meta:         map[string]string{"sid": "fc:0:2f::", "load": "40", "weight": "2", "capacity": "100"},
+			wantLoad:     40,
+			wantWeight:   2,
+			wantCapacity: 100,
+		},
+		{
+			name:         "TestConsulServiceMonitor_getServiceMetadata with invalid load",
+			meta:         map[string]string{"sid": "fc:0:2f::", "load": "high", "capacity": "100"},
+			wantLoad:     0,
+			wantWeight:   1,
+			wantCapacity: 100,
+		},
+		{
+			name:         "TestConsulServiceMonitor_getServiceMetadata with out of range load",
+			meta:         map[string]string{"sid": "fc:0:2f::", "load": "150", "capacity": "100"},
+			wantLoad:     0,
+			wantWeight:   1,
+			wantCapacity: 0,
+		},
+		{
+			name:         "TestConsulServiceMonitor_getServiceMetadata with processing metrics",
+			meta:         map[string]string{"sid": "fc:0:2f::", "delay": "3000", "jitter": "200", "loss": "0.1"},
+			wantLoad:     0,
+			wantWeight:   1,
+			wantCapacity: 0,
+			wantDelay:    3000,
+			wantJitter:   200,
+			wantLoss:     0.1,
+		},
+		{
+			name:         "TestConsulServiceMonitor_getServiceMetadata with negative delay",
+			meta:         map[string]string{"sid": "fc:0:2f::", "delay": "-3000", "jitter": "200"},
+			wantLoad:     0,
+			wantWeight:   1,
+			wantCapacity: 0,
+		},
+		{
+			name:         "TestConsulServiceMonitor_getServiceMetadata with negative capacity",
+			meta:         map[string]string{"sid": "fc:0:2f::", "capacity": "-1"},
+			wantLoad:     0,
+			wantWeight:   1,
+			wantCapacity: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMonitor, err := NewConsulServiceMonitor(cache.NewMockCache(gomock.NewController(t)), make(chan struct{}), "localhost")
+			assert.Nil(t, err)
+			metadata := serviceMonitor.getServiceMetadata("SERA-1", tt.meta)
+			assert.Equal(t, tt.wantLoad, metadata.GetLoad())
+			assert.Equal(t, tt.wantWeight, metadata.GetWeight())
+			assert.Equal(t, tt.wantCapacity, metadata.GetCapacity())
+			assert.Equal(t, tt.wantDelay, metadata.GetDelay())
+			assert.Equal(t, tt.wantJitter, metadata.GetJitter())
+			assert.Equal(t, tt.wantLoss, metadata.GetPacketLoss())
+		})
+	}
+}
+
+func TestConsulServiceMonitor_updateServiceMetadata(t *testing.T) {
+	serviceId := "SERA-1"
+	serviceType := "fw"
+	prefixSid := "fc:0:2f::"
+	tests := []struct {
+		name            string
+		knownService    bool
+		changed         bool
+		wantNeedsUpdate bool
+	}{
+		{
+			name:            "TestConsulServiceMonitor_updateServiceMetadata unknown service",
+			knownService:    false,
+			wantNeedsUpdate: false,
+		},
+		{
+			name:            "TestConsulServiceMonitor_updateServiceMetadata changed metadata",
+			knownService:    true,
+			changed:         true,
+			wantNeedsUpdate: true,
+		},
+		{
+			name:            "TestConsulServiceMonitor_updateServiceMetadata unchanged metadata",
+			knownService:    true,
+			changed:         false,
+			wantNeedsUpdate: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheMock := cache.NewMockCache(gomock.NewController(t))
+			serviceMonitor, err := NewConsulServiceMonitor(cacheMock, make(chan struct{}), "localhost")
+			assert.Nil(t, err)
+			metadata, err := domain.NewDomainServiceMetadata(40, 1, 100, 0, 0, 0)
+			assert.Nil(t, err)
+			if tt.knownService {
+				serviceMonitor.services[serviceType] = map[string]*ConcreteService{serviceId: NewConcreteService(serviceType, serviceId, prefixSid, true)}
+				if !tt.changed {
+					serviceMonitor.services[serviceType][serviceId].metadata = metadata
+				}
+			}
+			if tt.wantNeedsUpdate {
+				cacheMock.EXPECT().Lock().Return()
+				cacheMock.EXPECT().Unlock().Return()
+				cacheMock.EXPECT().StoreServiceMetadata(prefixSid, metadata).Return()
+			}
+			serviceMonitor.updateServiceMetadata(serviceId, serviceType, metadata)
+			assert.Equal(t, tt.wantNeedsUpdate, serviceMonitor.needsUpdate)
+		})
+	}
+}
+
 func TestConsulServiceMonitor_validateServiceEntries(t *testing.T) {
 	tests := []struct {
 		name           string
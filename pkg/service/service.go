@@ -1,5 +1,7 @@
 package service
 
+import "github.com/hawkv6/hawkeye/pkg/domain"
+
 const Subsystem = "service"
 
 type Service interface {
@@ -7,6 +9,7 @@ type Service interface {
 	GetId() string
 	GetSid() string
 	IsHealty() bool
+	GetMetadata() domain.ServiceMetadata
 }
 
 type ConcreteService struct {
@@ -14,6 +17,7 @@ type ConcreteService struct {
 	serviceId   string
 	prefixSid   string
 	healthy     bool
+	metadata    domain.ServiceMetadata
 }
 
 func NewConcreteService(serviceType, id, sid string, healthy bool) *ConcreteService {
@@ -39,3 +43,7 @@ func (service *ConcreteService) GetSid() string {
 func (service *ConcreteService) IsHealty() bool {
 	return service.healthy
 }
+
+func (service *ConcreteService) GetMetadata() domain.ServiceMetadata {
+	return service.metadata
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"testing"
 
+	"github.com/hawkv6/hawkeye/pkg/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,3 +85,23 @@ func TestConcreteService_IsHealthy(t *testing.T) {
 		})
 	}
 }
+
+func TestConcreteService_GetMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "TestConcreteService_GetMetadata",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewConcreteService("servicetype", "serviceId", "sid", true)
+			assert.Nil(t, service.GetMetadata())
+			metadata, err := domain.NewDomainServiceMetadata(40, 1, 100, 0, 0, 0)
+			assert.NoError(t, err)
+			service.metadata = metadata
+			assert.Equal(t, metadata, service.GetMetadata())
+		})
+	}
+}